@@ -12,6 +12,7 @@ import (
 	"github.com/steadybit/extension-jvm/extjvm/jvmhttp"
 	extension_kit "github.com/steadybit/extension-kit"
 	"github.com/steadybit/extension-kit/extutil"
+	"strings"
 	"time"
 )
 
@@ -27,6 +28,78 @@ var (
 		Required:     new(true),
 		Advanced:     new(true),
 	}
+	exceptionClassAttribute = action_kit_api.ActionParameter{
+		Name:        "exceptionClass",
+		Label:       "Exception Class",
+		Description: new("Fully qualified name of the exception to throw, e.g. 'java.net.SocketTimeoutException'. If the class can't be instantiated, a RuntimeException is thrown instead."),
+		Type:        action_kit_api.ActionParameterTypeString,
+		Advanced:    new(true),
+	}
+	exceptionMessageAttribute = action_kit_api.ActionParameter{
+		Name:        "exceptionMessage",
+		Label:       "Exception Message",
+		Description: new("The message of the thrown exception."),
+		Type:        action_kit_api.ActionParameterTypeString,
+		Advanced:    new(true),
+	}
+	delayDistributionAttribute = action_kit_api.ActionParameter{
+		Name:         "delayDistribution",
+		Label:        "Delay Distribution",
+		Description:  new("How should the delay be distributed? The delay is used as lower bound (uniform), mean (normal), median (long-tail) or start value (ramp). Jitter can only be added to a fixed delay."),
+		Type:         action_kit_api.ActionParameterTypeString,
+		DefaultValue: new("FIXED"),
+		Advanced:     new(true),
+		Options: new([]action_kit_api.ParameterOption{
+			action_kit_api.ExplicitParameterOption{
+				Label: "Fixed",
+				Value: "FIXED",
+			},
+			action_kit_api.ExplicitParameterOption{
+				Label: "Uniform",
+				Value: "UNIFORM",
+			},
+			action_kit_api.ExplicitParameterOption{
+				Label: "Normal",
+				Value: "NORMAL",
+			},
+			action_kit_api.ExplicitParameterOption{
+				Label: "Long-Tail",
+				Value: "LONG_TAIL",
+			},
+			action_kit_api.ExplicitParameterOption{
+				Label: "Ramp",
+				Value: "RAMP",
+			},
+		}),
+	}
+	delayMaxAttribute = action_kit_api.ActionParameter{
+		Name:        "delayMax",
+		Label:       "Maximum Delay",
+		Description: new("Upper bound of the uniform distribution."),
+		Type:        action_kit_api.ActionParameterTypeDuration,
+		Advanced:    new(true),
+	}
+	delayStddevAttribute = action_kit_api.ActionParameter{
+		Name:        "delayStddev",
+		Label:       "Delay Standard Deviation",
+		Description: new("Standard deviation of the normal distribution."),
+		Type:        action_kit_api.ActionParameterTypeDuration,
+		Advanced:    new(true),
+	}
+	delayP99Attribute = action_kit_api.ActionParameter{
+		Name:        "delayP99",
+		Label:       "Delay 99th Percentile",
+		Description: new("99th percentile of the long-tail distribution."),
+		Type:        action_kit_api.ActionParameterTypeDuration,
+		Advanced:    new(true),
+	}
+	delayRampTargetAttribute = action_kit_api.ActionParameter{
+		Name:        "delayRampTarget",
+		Label:       "Delay Ramp Target",
+		Description: new("Delay reached at the end of the attack duration for the ramp distribution."),
+		Type:        action_kit_api.ActionParameterTypeDuration,
+		Advanced:    new(true),
+	}
 	targetSelectionTemplates = []action_kit_api.TargetSelectionTemplate{
 		{
 			Label:       "instance name",
@@ -50,6 +123,8 @@ type javaagentAction struct {
 	description    action_kit_api.ActionDescription
 	configProvider func(request action_kit_api.PrepareActionRequestBody) (map[string]any, error)
 	facade         jvm.JavaFacade
+	// prepareCheck optionally inspects the target JVM for the given config and returns messages to report or an error to fail the preparation.
+	prepareCheck func(ctx context.Context, facade jvm.JavaFacade, javaVm jvm.JavaVm, config map[string]any) ([]action_kit_api.Message, error)
 }
 
 var (
@@ -65,7 +140,7 @@ func (j *javaagentAction) NewEmptyState() JavaagentActionState {
 	return JavaagentActionState{}
 }
 
-func (j *javaagentAction) Prepare(_ context.Context, state *JavaagentActionState, request action_kit_api.PrepareActionRequestBody) (*action_kit_api.PrepareResult, error) {
+func (j *javaagentAction) Prepare(ctx context.Context, state *JavaagentActionState, request action_kit_api.PrepareActionRequestBody) (*action_kit_api.PrepareResult, error) {
 	if duration, err := extractDuration(request); err == nil {
 		state.Duration = duration
 	} else {
@@ -100,10 +175,21 @@ func (j *javaagentAction) Prepare(_ context.Context, state *JavaagentActionState
 
 	state.ValidateAdviceApplied = extutil.ToBool(request.Config["validate"])
 
+	var result *action_kit_api.PrepareResult
+	if j.prepareCheck != nil {
+		messages, err := j.prepareCheck(ctx, j.facade, javaVm, config)
+		if err != nil {
+			return nil, err
+		}
+		if len(messages) > 0 {
+			result = &action_kit_api.PrepareResult{Messages: &messages}
+		}
+	}
+
 	callbackUrl, attackEndpointPort := startAttackEndpoint(javaVm, state.ConfigJson)
 	state.EndpointPort = attackEndpointPort
 	state.CallbackUrl = callbackUrl
-	return nil, nil
+	return result, nil
 }
 
 func startAttackEndpoint(javaVm jvm.JavaVm, configJson string) (string, int) {
@@ -172,6 +258,65 @@ func extractPid(request action_kit_api.PrepareActionRequestBody) (int32, error)
 	return extutil.ToInt32(pids[0]), nil
 }
 
+// extractDelayDistribution returns the distribution config for the delay actions or nil for a fixed delay.
+func extractDelayDistribution(request action_kit_api.PrepareActionRequestBody) (map[string]any, error) {
+	distribution := extutil.ToString(request.Config["delayDistribution"])
+	delay := extutil.ToUInt64(request.Config["delay"])
+
+	if distribution == "" || distribution == "FIXED" {
+		return nil, nil
+	}
+	if extutil.ToBool(request.Config["delayJitter"]) {
+		return nil, fmt.Errorf("jitter is not supported for the delay distribution '%s'", distribution)
+	}
+
+	switch distribution {
+	case "UNIFORM":
+		delayMax := extutil.ToUInt64(request.Config["delayMax"])
+		if delayMax < delay {
+			return nil, errors.New("maximum delay must not be less than the delay")
+		}
+		return map[string]any{"type": distribution, "min": delay, "max": delayMax}, nil
+	case "NORMAL":
+		stddev := extutil.ToUInt64(request.Config["delayStddev"])
+		if stddev == 0 {
+			return nil, errors.New("delay standard deviation is required")
+		}
+		return map[string]any{"type": distribution, "mean": delay, "stddev": stddev}, nil
+	case "LONG_TAIL":
+		p99 := extutil.ToUInt64(request.Config["delayP99"])
+		if delay == 0 || p99 < delay {
+			return nil, errors.New("delay 99th percentile must not be less than the delay and the delay must be greater than 0")
+		}
+		return map[string]any{"type": distribution, "p50": delay, "p99": p99}, nil
+	case "RAMP":
+		return map[string]any{"type": distribution, "from": delay, "to": extutil.ToUInt64(request.Config["delayRampTarget"])}, nil
+	default:
+		return nil, fmt.Errorf("unsupported delay distribution '%s'", distribution)
+	}
+}
+
+func extractException(request action_kit_api.PrepareActionRequestBody, config map[string]any) {
+	if exceptionClass := strings.TrimSpace(extutil.ToString(request.Config["exceptionClass"])); exceptionClass != "" {
+		config["exceptionClass"] = exceptionClass
+	}
+	if exceptionMessage := extutil.ToString(request.Config["exceptionMessage"]); exceptionMessage != "" {
+		config["exceptionMessage"] = exceptionMessage
+	}
+}
+
+// checkExceptionClassLoaded warns if the configured exception class is not loaded in the target JVM, as it might not be instantiable.
+func checkExceptionClassLoaded(_ context.Context, facade jvm.JavaFacade, javaVm jvm.JavaVm, config map[string]any) ([]action_kit_api.Message, error) {
+	exceptionClass, ok := config["exceptionClass"].(string)
+	if !ok || facade.HasClassLoaded(javaVm, exceptionClass) {
+		return nil, nil
+	}
+	return []action_kit_api.Message{{
+		Level:   extutil.Ptr(action_kit_api.Warn),
+		Message: fmt.Sprintf("Exception class %s is not loaded in JVM with PID %d. A RuntimeException is thrown instead if it can't be loaded.", exceptionClass, javaVm.Pid()),
+	}}, nil
+}
+
 var (
 	attackStartTimeout = 10 * time.Second
 )
@@ -6,10 +6,12 @@ import (
 	"github.com/steadybit/action-kit/go/action_kit_api/v2"
 	"github.com/steadybit/extension-jvm/extjvm/jvm"
 	"github.com/steadybit/extension-jvm/extjvm/jvm/starttime"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"io"
 	"os/exec"
 	"strconv"
+	"testing"
 	"time"
 )
 
@@ -196,3 +198,57 @@ func (f *FakeJvm) getTarget() action_kit_api.Target {
 func (f *FakeJvm) stop() error {
 	return f.p.Kill()
 }
+
+func Test_extractDelayDistribution(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      map[string]any
+		wanted      map[string]any
+		wantedError string
+	}{
+		{
+			name:   "fixed",
+			config: map[string]any{"delay": "500", "delayDistribution": "FIXED"},
+		},
+		{
+			name:   "normal",
+			config: map[string]any{"delay": "500", "delayDistribution": "NORMAL", "delayStddev": "100"},
+			wanted: map[string]any{"type": "NORMAL", "mean": uint64(500), "stddev": uint64(100)},
+		},
+		{
+			name:   "ramp",
+			config: map[string]any{"delay": "0", "delayDistribution": "RAMP", "delayRampTarget": "3000"},
+			wanted: map[string]any{"type": "RAMP", "from": uint64(0), "to": uint64(3000)},
+		},
+		{
+			name:        "uniform with maximum below delay",
+			config:      map[string]any{"delay": "500", "delayDistribution": "UNIFORM", "delayMax": "100"},
+			wantedError: "maximum delay must not be less than the delay",
+		},
+		{
+			name:        "normal with jitter",
+			config:      map[string]any{"delay": "500", "delayDistribution": "NORMAL", "delayStddev": "100", "delayJitter": "true"},
+			wantedError: "jitter is not supported for the delay distribution 'NORMAL'",
+		},
+		{
+			name:   "fixed with jitter",
+			config: map[string]any{"delay": "500", "delayDistribution": "FIXED", "delayJitter": "true"},
+		},
+		{
+			name:        "unsupported",
+			config:      map[string]any{"delay": "500", "delayDistribution": "POISSON"},
+			wantedError: "unsupported delay distribution 'POISSON'",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			distribution, err := extractDelayDistribution(action_kit_api.PrepareActionRequestBody{Config: tt.config})
+			if tt.wantedError != "" {
+				assert.EqualError(t, err, tt.wantedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wanted, distribution)
+		})
+	}
+}
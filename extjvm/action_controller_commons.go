@@ -5,10 +5,13 @@ import (
 	"fmt"
 	"github.com/rs/zerolog/log"
 	"github.com/steadybit/action-kit/go/action_kit_api/v2"
+	"github.com/steadybit/extension-jvm/extjvm/utils"
 	"github.com/steadybit/extension-kit/extutil"
 	"slices"
 )
 
+const controllerTargetQuery = `(instance.type="spring" AND spring-instance.mvc-mapping IS PRESENT) OR quarkus-instance.endpoint IS PRESENT OR micronaut-instance.endpoint IS PRESENT OR jaxrs-instance.endpoint IS PRESENT`
+
 var (
 	patternAttribute = action_kit_api.ActionParameter{
 		Name:        "pattern",
@@ -20,6 +23,15 @@ var (
 			action_kit_api.ParameterOptionsFromTargetAttribute{
 				Attribute: "spring-instance.mvc-mapping",
 			},
+			action_kit_api.ParameterOptionsFromTargetAttribute{
+				Attribute: "quarkus-instance.endpoint",
+			},
+			action_kit_api.ParameterOptionsFromTargetAttribute{
+				Attribute: "micronaut-instance.endpoint",
+			},
+			action_kit_api.ParameterOptionsFromTargetAttribute{
+				Attribute: "jaxrs-instance.endpoint",
+			},
 		}),
 	}
 	methodAttribute = action_kit_api.ActionParameter{
@@ -40,6 +52,20 @@ var (
 		DefaultValue: new("*"),
 		Options:      methodsOptions,
 	}
+	requestHeaderNameAttribute = action_kit_api.ActionParameter{
+		Name:        "requestHeaderName",
+		Label:       "Request Header",
+		Description: new("Only attack requests carrying this header, e.g. a chaos header or 'Cookie' for a test-user cookie."),
+		Type:        action_kit_api.ActionParameterTypeString,
+		Advanced:    new(true),
+	}
+	requestHeaderValueAttribute = action_kit_api.ActionParameter{
+		Name:        "requestHeaderValue",
+		Label:       "Request Header Value",
+		Description: new("Regular expression the header value has to match. If empty, the presence of the header is sufficient."),
+		Type:        action_kit_api.ActionParameterTypeString,
+		Advanced:    new(true),
+	}
 	methodsOptions = new([]action_kit_api.ParameterOption{
 		action_kit_api.ExplicitParameterOption{
 			Label: "Any",
@@ -80,8 +106,33 @@ var (
 	})
 )
 
+// endpointActionDescription derives the description of an action attacking a single Spring endpoint from the given
+// controller action. The request mapping and http method are then taken from the target.
+func endpointActionDescription(description action_kit_api.ActionDescription, id string, label string) action_kit_api.ActionDescription {
+	description.Id = id
+	description.Label = label
+	description.TargetSelection = new(action_kit_api.TargetSelection{
+		TargetType:         springEndpointTargetType,
+		SelectionTemplates: new(targetSelectionTemplates),
+	})
+	description.Parameters = slices.DeleteFunc(slices.Clone(description.Parameters), func(parameter action_kit_api.ActionParameter) bool {
+		return parameter.Name == patternAttribute.Name || parameter.Name == methodAttribute.Name || parameter.Name == methodsAttribute.Name
+	})
+	return description
+}
+
+func endpointAttribute(request action_kit_api.PrepareActionRequestBody, attribute string) string {
+	if request.Target != nil && len(request.Target.Attributes[attribute]) > 0 {
+		return request.Target.Attributes[attribute][0]
+	}
+	return ""
+}
+
 func extractPattern(request action_kit_api.PrepareActionRequestBody) (string, error) {
 	pattern := extutil.ToString(request.Config["pattern"])
+	if pattern == "" {
+		pattern = endpointAttribute(request, "spring-endpoint.pattern")
+	}
 	if pattern == "" {
 		return "", errors.New("pattern is required")
 	}
@@ -98,6 +149,9 @@ func extractMethods(request action_kit_api.PrepareActionRequestBody) ([]string,
 	}
 
 	httpMethods = append(httpMethods, extutil.ToStringArray(request.Config["methods"])...)
+	if method := endpointAttribute(request, "spring-endpoint.method"); len(httpMethods) == 0 && method != "" {
+		httpMethods = append(httpMethods, method)
+	}
 	if len(httpMethods) == 0 {
 		return httpMethods, errors.New("pattern is required")
 	}
@@ -105,33 +159,47 @@ func extractMethods(request action_kit_api.PrepareActionRequestBody) ([]string,
 	return httpMethods, nil
 }
 
-func extractHandlerMethods(spring *SpringDiscovery, request action_kit_api.PrepareActionRequestBody) ([]string, error) {
+func extractRequestHeaderCondition(request action_kit_api.PrepareActionRequestBody) (map[string]any, error) {
+	name := extutil.ToString(request.Config["requestHeaderName"])
+	value := extutil.ToString(request.Config["requestHeaderValue"])
+	if name == "" {
+		if value != "" {
+			return nil, errors.New("request header name is required when a header value is given")
+		}
+		return nil, nil
+	}
+
+	return map[string]any{
+		"name":  name,
+		"value": value,
+	}, nil
+}
+
+// extractHandlerMethods returns the handler methods of the mappings matching the pattern and http methods of the
+// request and, if any WebFlux router function matches, the router function condition to attack.
+func extractHandlerMethods(spring *SpringDiscovery, frameworks *FrameworkDiscovery, request action_kit_api.PrepareActionRequestBody) ([]string, map[string]any, error) {
 	pattern, err := extractPattern(request)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	methods, err := extractMethods(request)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	pid, err := extractPid(request)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	application := spring.findApplication(pid)
-	if application == nil {
-		return nil, errors.New("spring instance not found")
-	}
-
-	if application.MvcMappings == nil {
-		return nil, errors.New("spring MVC mappings not found")
+	mappings, err := findControllerMappings(spring, frameworks, pid)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	relevantMappings := make([]SpringMvcMapping, 0)
-	for _, mapping := range application.MvcMappings {
+	for _, mapping := range mappings {
 		if !slices.Contains(mapping.Patterns, pattern) {
 			continue
 		}
@@ -148,8 +216,41 @@ func extractHandlerMethods(spring *SpringDiscovery, request action_kit_api.Prepa
 	}
 
 	configMethods := make([]string, 0)
+	var routerFunction map[string]any
 	for _, m := range relevantMappings {
-		configMethods = append(configMethods, fmt.Sprintf("%s#%s", m.HandlerClass, m.HandlerName))
+		if m.RouterFunction {
+			routerFunction = map[string]any{
+				"methods": methods,
+				"pattern": pattern,
+			}
+			continue
+		}
+		configMethods = utils.AppendIfMissing(configMethods, fmt.Sprintf("%s#%s", m.HandlerClass, m.HandlerName))
+	}
+	return configMethods, routerFunction, nil
+}
+
+// findControllerMappings returns the Spring MVC mappings and the endpoints of the other frameworks discovered in the JVM.
+func findControllerMappings(spring *SpringDiscovery, frameworks *FrameworkDiscovery, pid int32) ([]SpringMvcMapping, error) {
+	var applications []FrameworkApplication
+	if frameworks != nil {
+		applications = frameworks.findApplications(pid)
+	}
+
+	application := spring.findApplication(pid)
+	if application == nil && len(applications) == 0 {
+		return nil, errors.New("spring or framework instance not found")
+	}
+
+	var mappings []SpringMvcMapping
+	if application != nil {
+		mappings = append(mappings, application.MvcMappings...)
+	}
+	for _, app := range applications {
+		mappings = append(mappings, app.Endpoints...)
+	}
+	if mappings == nil {
+		return nil, errors.New("spring MVC mappings or framework endpoints not found")
 	}
-	return configMethods, nil
+	return mappings, nil
 }
@@ -14,11 +14,20 @@ import (
 	"github.com/steadybit/extension-kit/extutil"
 )
 
-func NewControllerDelay(facade jvm.JavaFacade, spring *SpringDiscovery) action_kit_sdk.Action[JavaagentActionState] {
+func NewControllerDelay(facade jvm.JavaFacade, spring *SpringDiscovery, frameworks *FrameworkDiscovery) action_kit_sdk.Action[JavaagentActionState] {
 	return &javaagentAction{
 		pluginJar:      "attack-java-javaagent.jar",
 		description:    controllerDelayDescribe(),
-		configProvider: controllerDelayConfigProvider(spring),
+		configProvider: controllerDelayConfigProvider(spring, frameworks),
+		facade:         facade,
+	}
+}
+
+func NewSpringEndpointDelay(facade jvm.JavaFacade, spring *SpringDiscovery) action_kit_sdk.Action[JavaagentActionState] {
+	return &javaagentAction{
+		pluginJar:      "attack-java-javaagent.jar",
+		description:    endpointActionDescription(controllerDelayDescribe(), ActionIDPrefix+".spring-endpoint-delay-attack", "Spring Endpoint Delay"),
+		configProvider: controllerDelayConfigProvider(spring, nil),
 		facade:         facade,
 	}
 }
@@ -26,13 +35,13 @@ func NewControllerDelay(facade jvm.JavaFacade, spring *SpringDiscovery) action_k
 func controllerDelayDescribe() action_kit_api.ActionDescription {
 	return action_kit_api.ActionDescription{
 		Id:          ActionIDPrefix + ".spring-mvc-delay-attack",
-		Label:       "Spring Controller Delay",
-		Description: "Delay a Spring MVC controller http response by the given duration.",
+		Label:       "Controller Delay",
+		Description: "Delay the http response of a Spring MVC or WebFlux endpoint, a Quarkus or Micronaut controller or a JAX-RS resource by the given duration. Reactive and asynchronous responses are delayed without blocking, blocking responses are not delayed on event-loop threads.",
 		Version:     extbuild.GetSemverVersionStringOrUnknown(),
 		Icon:        new(controllerDelayIcon),
 		TargetSelection: new(action_kit_api.TargetSelection{
 			TargetType:         targetType,
-			TargetQuery:        new(`instance.type="spring" AND spring-instance.mvc-mapping IS PRESENT`),
+			TargetQuery:        new(controllerTargetQuery),
 			SelectionTemplates: new(targetSelectionTemplates),
 		}),
 		Technology: new("JVM"),
@@ -55,6 +64,8 @@ func controllerDelayDescribe() action_kit_api.ActionDescription {
 			patternAttribute,
 			methodAttribute,
 			methodsAttribute,
+			requestHeaderNameAttribute,
+			requestHeaderValueAttribute,
 			{
 				Name:         "delay",
 				Label:        "Delay",
@@ -79,29 +90,53 @@ func controllerDelayDescribe() action_kit_api.ActionDescription {
 				Required:     new(true),
 				Advanced:     new(true),
 			},
+			delayDistributionAttribute,
+			delayMaxAttribute,
+			delayStddevAttribute,
+			delayP99Attribute,
+			delayRampTargetAttribute,
 		},
 		Stop: new(action_kit_api.MutatingEndpointReference{}),
 	}
 }
 
-func controllerDelayConfigProvider(s *SpringDiscovery) func(request action_kit_api.PrepareActionRequestBody) (map[string]any, error) {
+func controllerDelayConfigProvider(s *SpringDiscovery, frameworks *FrameworkDiscovery) func(request action_kit_api.PrepareActionRequestBody) (map[string]any, error) {
 	return func(request action_kit_api.PrepareActionRequestBody) (map[string]any, error) {
 		duration, err := extractDuration(request)
 		if err != nil {
 			return nil, err
 		}
 
-		handlerMethods, err := extractHandlerMethods(s, request)
+		handlerMethods, routerFunction, err := extractHandlerMethods(s, frameworks, request)
 		if err != nil {
 			return nil, err
 		}
 
-		return map[string]any{
+		config := map[string]any{
 			"attack-class": "com.steadybit.attacks.javaagent.instrumentation.JavaMethodDelayInstrumentation",
 			"duration":     int(duration / time.Millisecond),
 			"delay":        extutil.ToUInt64(request.Config["delay"]),
 			"delayJitter":  extutil.ToBool(request.Config["delayJitter"]),
 			"methods":      handlerMethods,
-		}, nil
+			// blocking an event-loop thread would delay all requests handled by it, not only the attacked ones
+			"skipOnEventLoop": true,
+		}
+		if routerFunction != nil {
+			config["routerFunction"] = routerFunction
+		}
+
+		if delayDistribution, err := extractDelayDistribution(request); err != nil {
+			return nil, err
+		} else if delayDistribution != nil {
+			config["delayDistribution"] = delayDistribution
+		}
+
+		if requestHeader, err := extractRequestHeaderCondition(request); err != nil {
+			return nil, err
+		} else if requestHeader != nil {
+			config["requestHeader"] = requestHeader
+		}
+
+		return config, nil
 	}
 }
@@ -55,7 +55,7 @@ func Test_controllerDelay_Prepare(t *testing.T) {
 			},
 
 			wantedState: &JavaagentActionState{
-				ConfigJson: "{\"attack-class\":\"com.steadybit.attacks.javaagent.instrumentation.JavaMethodDelayInstrumentation\",\"delay\":500,\"delayJitter\":true,\"duration\":10000,\"methods\":[\"com.steadybit.demo.CustomerController#customers\"]}",
+				ConfigJson: "{\"attack-class\":\"com.steadybit.attacks.javaagent.instrumentation.JavaMethodDelayInstrumentation\",\"delay\":500,\"delayJitter\":true,\"duration\":10000,\"methods\":[\"com.steadybit.demo.CustomerController#customers\"],\"skipOnEventLoop\":true}",
 			},
 		},
 		{
@@ -74,11 +74,32 @@ func Test_controllerDelay_Prepare(t *testing.T) {
 			},
 
 			wantedState: &JavaagentActionState{
-				ConfigJson: "{\"attack-class\":\"com.steadybit.attacks.javaagent.instrumentation.JavaMethodDelayInstrumentation\",\"delay\":500,\"delayJitter\":true,\"duration\":10000,\"methods\":[\"com.steadybit.demo.CustomerController#customers\"]}",
+				ConfigJson: "{\"attack-class\":\"com.steadybit.attacks.javaagent.instrumentation.JavaMethodDelayInstrumentation\",\"delay\":500,\"delayJitter\":true,\"duration\":10000,\"methods\":[\"com.steadybit.demo.CustomerController#customers\"],\"skipOnEventLoop\":true}",
+			},
+		},
+		{
+			name: "Should return config with request header condition",
+			requestBody: action_kit_api.PrepareActionRequestBody{
+				Config: map[string]any{
+					"action":             "prepare",
+					"pattern":            "/customers",
+					"methods":            []any{"GET"},
+					"duration":           "10000",
+					"delay":              "500",
+					"delayJitter":        "false",
+					"requestHeaderName":  "X-Chaos",
+					"requestHeaderValue": "enabled",
+				},
+				ExecutionId: uuid.New(),
+				Target:      new(fake.getTarget()),
+			},
+
+			wantedState: &JavaagentActionState{
+				ConfigJson: "{\"attack-class\":\"com.steadybit.attacks.javaagent.instrumentation.JavaMethodDelayInstrumentation\",\"delay\":500,\"delayJitter\":false,\"duration\":10000,\"methods\":[\"com.steadybit.demo.CustomerController#customers\"],\"requestHeader\":{\"name\":\"X-Chaos\",\"value\":\"enabled\"},\"skipOnEventLoop\":true}",
 			},
 		},
 	}
-	action := NewControllerDelay(facade, spring)
+	action := NewControllerDelay(facade, spring, &FrameworkDiscovery{})
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -97,3 +118,181 @@ func Test_controllerDelay_Prepare(t *testing.T) {
 		})
 	}
 }
+
+func Test_controllerDelay_Prepare_request_header_value_without_name(t *testing.T) {
+	facade := &mockJavaFacade{}
+	spring := &SpringDiscovery{}
+
+	fake, err := facade.startFakeJvm()
+	require.NoError(t, err)
+	defer func(fake *FakeJvm) {
+		_ = fake.stop()
+	}(fake)
+
+	spring.applications.Store(fake.Pid(), SpringApplication{
+		Name: "customers",
+		Pid:  fake.Pid(),
+		MvcMappings: []SpringMvcMapping{
+			{
+				Methods:      []string{"GET"},
+				Patterns:     []string{"/customers"},
+				HandlerClass: "com.steadybit.demo.CustomerController",
+				HandlerName:  "customers",
+			},
+		},
+	})
+
+	action := NewControllerDelay(facade, spring, &FrameworkDiscovery{})
+	state := action.NewEmptyState()
+	_, err = action.Prepare(context.Background(), &state, action_kit_api.PrepareActionRequestBody{
+		Config: map[string]any{
+			"action":             "prepare",
+			"pattern":            "/customers",
+			"methods":            []any{"GET"},
+			"duration":           "10000",
+			"delay":              "500",
+			"requestHeaderValue": "enabled",
+		},
+		ExecutionId: uuid.New(),
+		Target:      new(fake.getTarget()),
+	})
+	assert.ErrorContains(t, err, "request header name is required")
+}
+
+func Test_controllerDelay_Prepare_framework_endpoints(t *testing.T) {
+	facade := &mockJavaFacade{}
+	frameworks := &FrameworkDiscovery{}
+
+	fake, err := facade.startFakeJvm()
+	require.NoError(t, err)
+	defer func(fake *FakeJvm) {
+		_ = fake.stop()
+	}(fake)
+
+	frameworks.applications.Store(frameworkApplicationKey{Pid: fake.Pid(), Framework: "quarkus"}, FrameworkApplication{
+		Name:      "orders",
+		Pid:       fake.Pid(),
+		Framework: "quarkus",
+		Endpoints: []SpringMvcMapping{
+			{
+				Methods:      []string{"GET"},
+				Patterns:     []string{"/orders/{id}"},
+				HandlerClass: "com.steadybit.demo.OrderResource",
+				HandlerName:  "order",
+			},
+		},
+	})
+
+	action := NewControllerDelay(facade, &SpringDiscovery{}, frameworks)
+	state := action.NewEmptyState()
+	_, err = action.Prepare(context.Background(), &state, action_kit_api.PrepareActionRequestBody{
+		Config: map[string]any{
+			"action":   "prepare",
+			"pattern":  "/orders/{id}",
+			"methods":  []any{"GET"},
+			"duration": "10000",
+			"delay":    "500",
+		},
+		ExecutionId: uuid.New(),
+		Target:      new(fake.getTarget()),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "{\"attack-class\":\"com.steadybit.attacks.javaagent.instrumentation.JavaMethodDelayInstrumentation\",\"delay\":500,\"delayJitter\":false,\"duration\":10000,\"methods\":[\"com.steadybit.demo.OrderResource#order\"],\"skipOnEventLoop\":true}", state.ConfigJson)
+}
+
+func Test_controllerDelay_Prepare_router_function(t *testing.T) {
+	facade := &mockJavaFacade{}
+	spring := &SpringDiscovery{}
+
+	fake, err := facade.startFakeJvm()
+	require.NoError(t, err)
+	defer func(fake *FakeJvm) {
+		_ = fake.stop()
+	}(fake)
+
+	spring.applications.Store(fake.Pid(), SpringApplication{
+		Name: "customers",
+		Pid:  fake.Pid(),
+		MvcMappings: []SpringMvcMapping{
+			{
+				Methods:        []string{"GET"},
+				Patterns:       []string{"/customers"},
+				HandlerClass:   "com.steadybit.demo.CustomerRoutes$$Lambda$123/0x0000000800c4b840",
+				RouterFunction: true,
+			},
+		},
+	})
+
+	action := NewControllerDelay(facade, spring, &FrameworkDiscovery{})
+	state := action.NewEmptyState()
+	_, err = action.Prepare(context.Background(), &state, action_kit_api.PrepareActionRequestBody{
+		Config: map[string]any{
+			"action":   "prepare",
+			"pattern":  "/customers",
+			"methods":  []any{"GET"},
+			"duration": "10000",
+			"delay":    "500",
+		},
+		ExecutionId: uuid.New(),
+		Target:      new(fake.getTarget()),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "{\"attack-class\":\"com.steadybit.attacks.javaagent.instrumentation.JavaMethodDelayInstrumentation\",\"delay\":500,\"delayJitter\":false,\"duration\":10000,\"methods\":[],\"routerFunction\":{\"methods\":[\"GET\"],\"pattern\":\"/customers\"},\"skipOnEventLoop\":true}", state.ConfigJson)
+}
+
+func Test_springEndpointDelay_Prepare(t *testing.T) {
+	facade := &mockJavaFacade{}
+	spring := &SpringDiscovery{}
+
+	fake, err := facade.startFakeJvm()
+	require.NoError(t, err)
+	defer func(fake *FakeJvm) {
+		_ = fake.stop()
+	}(fake)
+
+	spring.applications.Store(fake.Pid(), SpringApplication{
+		Name: "customers",
+		Pid:  fake.Pid(),
+		MvcMappings: []SpringMvcMapping{
+			{
+				Methods:      []string{"GET"},
+				Patterns:     []string{"/customers"},
+				HandlerClass: "com.steadybit.demo.CustomerController",
+				HandlerName:  "customers",
+			},
+			{
+				Methods:      []string{"POST"},
+				Patterns:     []string{"/customers"},
+				HandlerClass: "com.steadybit.demo.CustomerController",
+				HandlerName:  "createCustomer",
+			},
+		},
+	})
+
+	target := fake.getTarget()
+	target.Attributes["spring-endpoint.pattern"] = []string{"/customers"}
+	target.Attributes["spring-endpoint.method"] = []string{"POST"}
+
+	action := NewSpringEndpointDelay(facade, spring)
+	state := action.NewEmptyState()
+	_, err = action.Prepare(context.Background(), &state, action_kit_api.PrepareActionRequestBody{
+		Config: map[string]any{
+			"action":   "prepare",
+			"duration": "10000",
+			"delay":    "500",
+		},
+		ExecutionId: uuid.New(),
+		Target:      &target,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "{\"attack-class\":\"com.steadybit.attacks.javaagent.instrumentation.JavaMethodDelayInstrumentation\",\"delay\":500,\"delayJitter\":false,\"duration\":10000,\"methods\":[\"com.steadybit.demo.CustomerController#createCustomer\"],\"skipOnEventLoop\":true}", state.ConfigJson)
+
+	description := action.Describe()
+	assert.Equal(t, springEndpointTargetType, description.TargetSelection.TargetType)
+	for _, parameter := range description.Parameters {
+		assert.NotEqual(t, "pattern", parameter.Name)
+	}
+}
@@ -14,25 +14,36 @@ import (
 	"github.com/steadybit/extension-kit/extutil"
 )
 
-func NewControllerException(facade jvm.JavaFacade, spring *SpringDiscovery) action_kit_sdk.Action[JavaagentActionState] {
+func NewControllerException(facade jvm.JavaFacade, spring *SpringDiscovery, frameworks *FrameworkDiscovery) action_kit_sdk.Action[JavaagentActionState] {
 	return &javaagentAction{
 		pluginJar:      "attack-java-javaagent.jar",
 		description:    controllerExceptionDescribe(),
-		configProvider: controllerExceptionConfigProvider(spring),
+		configProvider: controllerExceptionConfigProvider(spring, frameworks),
 		facade:         facade,
+		prepareCheck:   checkExceptionClassLoaded,
+	}
+}
+
+func NewSpringEndpointException(facade jvm.JavaFacade, spring *SpringDiscovery) action_kit_sdk.Action[JavaagentActionState] {
+	return &javaagentAction{
+		pluginJar:      "attack-java-javaagent.jar",
+		description:    endpointActionDescription(controllerExceptionDescribe(), ActionIDPrefix+".spring-endpoint-exception-attack", "Spring Endpoint Exception"),
+		configProvider: controllerExceptionConfigProvider(spring, nil),
+		facade:         facade,
+		prepareCheck:   checkExceptionClassLoaded,
 	}
 }
 
 func controllerExceptionDescribe() action_kit_api.ActionDescription {
 	return action_kit_api.ActionDescription{
 		Id:          ActionIDPrefix + ".spring-mvc-exception-attack",
-		Label:       "Spring Controller Exception",
-		Description: "Throw an exception in an Spring MVC controller method",
+		Label:       "Controller Exception",
+		Description: "Throw an exception in a Spring MVC or WebFlux endpoint, a Quarkus or Micronaut controller or a JAX-RS resource method",
 		Version:     extbuild.GetSemverVersionStringOrUnknown(),
 		Icon:        new(controllerExceptionIcon),
 		TargetSelection: new(action_kit_api.TargetSelection{
 			TargetType:         targetType,
-			TargetQuery:        new(`instance.type="spring" AND spring-instance.mvc-mapping IS PRESENT`),
+			TargetQuery:        new(controllerTargetQuery),
 			SelectionTemplates: new(targetSelectionTemplates),
 		}),
 		Technology: new("JVM"),
@@ -55,6 +66,8 @@ func controllerExceptionDescribe() action_kit_api.ActionDescription {
 			patternAttribute,
 			methodAttribute,
 			methodsAttribute,
+			requestHeaderNameAttribute,
+			requestHeaderValueAttribute,
 			{
 				Name:         "duration",
 				Label:        "Duration",
@@ -64,28 +77,43 @@ func controllerExceptionDescribe() action_kit_api.ActionDescription {
 				Required:     new(true),
 			},
 			erroneousCallRate,
+			exceptionClassAttribute,
+			exceptionMessageAttribute,
 		},
 		Stop: new(action_kit_api.MutatingEndpointReference{}),
 	}
 }
 
-func controllerExceptionConfigProvider(spring *SpringDiscovery) func(request action_kit_api.PrepareActionRequestBody) (map[string]any, error) {
+func controllerExceptionConfigProvider(spring *SpringDiscovery, frameworks *FrameworkDiscovery) func(request action_kit_api.PrepareActionRequestBody) (map[string]any, error) {
 	return func(request action_kit_api.PrepareActionRequestBody) (map[string]any, error) {
 		duration, err := extractDuration(request)
 		if err != nil {
 			return nil, err
 		}
 
-		handlerMethods, err := extractHandlerMethods(spring, request)
+		handlerMethods, routerFunction, err := extractHandlerMethods(spring, frameworks, request)
 		if err != nil {
 			return nil, err
 		}
 
-		return map[string]any{
+		config := map[string]any{
 			"attack-class":      "com.steadybit.attacks.javaagent.instrumentation.JavaMethodExceptionInstrumentation",
 			"duration":          int(duration / time.Millisecond),
 			"erroneousCallRate": extutil.ToInt(request.Config["erroneousCallRate"]),
 			"methods":           handlerMethods,
-		}, nil
+		}
+		if routerFunction != nil {
+			config["routerFunction"] = routerFunction
+		}
+
+		extractException(request, config)
+
+		if requestHeader, err := extractRequestHeaderCondition(request); err != nil {
+			return nil, err
+		} else if requestHeader != nil {
+			config["requestHeader"] = requestHeader
+		}
+
+		return config, nil
 	}
 }
@@ -74,8 +74,28 @@ func Test_controlleException_Prepare(t *testing.T) {
 				ConfigJson: "{\"attack-class\":\"com.steadybit.attacks.javaagent.instrumentation.JavaMethodExceptionInstrumentation\",\"duration\":10000,\"erroneousCallRate\":75,\"methods\":[\"com.steadybit.demo.CustomerController#customers\"]}",
 			},
 		},
+		{
+			name: "Should return config with request header condition",
+			requestBody: action_kit_api.PrepareActionRequestBody{
+				Config: map[string]any{
+					"action":             "prepare",
+					"pattern":            "/customers",
+					"methods":            []any{"GET"},
+					"duration":           "10000",
+					"erroneousCallRate":  75,
+					"requestHeaderName":  "X-Chaos",
+					"requestHeaderValue": "enabled",
+				},
+				ExecutionId: uuid.New(),
+				Target:      new(fake.getTarget()),
+			},
+
+			wantedState: &JavaagentActionState{
+				ConfigJson: "{\"attack-class\":\"com.steadybit.attacks.javaagent.instrumentation.JavaMethodExceptionInstrumentation\",\"duration\":10000,\"erroneousCallRate\":75,\"methods\":[\"com.steadybit.demo.CustomerController#customers\"],\"requestHeader\":{\"name\":\"X-Chaos\",\"value\":\"enabled\"}}",
+			},
+		},
 	}
-	action := NewControllerException(facade, spring)
+	action := NewControllerException(facade, spring, &FrameworkDiscovery{})
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			//Given
@@ -0,0 +1,86 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjvm
+
+import (
+	"time"
+
+	"github.com/steadybit/action-kit/go/action_kit_api/v2"
+	"github.com/steadybit/action-kit/go/action_kit_sdk"
+	"github.com/steadybit/extension-jvm/extjvm/jvm"
+	"github.com/steadybit/extension-kit/extbuild"
+	"github.com/steadybit/extension-kit/extutil"
+)
+
+func NewGrpcClientStatus(facade jvm.JavaFacade) action_kit_sdk.Action[JavaagentActionState] {
+	return &javaagentAction{
+		pluginJar:      "attack-java-javaagent.jar",
+		description:    grpcClientStatusDescribe(),
+		configProvider: grpcClientStatusConfigProvider,
+		facade:         facade,
+	}
+}
+
+func grpcClientStatusDescribe() action_kit_api.ActionDescription {
+	return action_kit_api.ActionDescription{
+		Id:          ActionIDPrefix + ".grpc-client-status-attack",
+		Label:       "gRPC Client Status",
+		Description: "Fail the gRPC calls of the client (grpc-java) to the given service and method with the given status code.",
+		Version:     extbuild.GetSemverVersionStringOrUnknown(),
+		Icon:        new(grpcStatusIcon),
+		TargetSelection: new(action_kit_api.TargetSelection{
+			TargetType:         targetType,
+			TargetQuery:        new(`grpc.client-service IS PRESENT`),
+			SelectionTemplates: new(targetSelectionTemplates),
+		}),
+		Technology: new("JVM"),
+
+		// To clarify the purpose of the action, you can set a kind.
+		//   Attack: Will cause harm to targets
+		//   Check: Will perform checks on the targets
+		//   LoadTest: Will perform load tests on the targets
+		//   Other
+		Kind: action_kit_api.Attack,
+
+		// How the action is controlled over time.
+		//   External: The agent takes care and calls stop then the time has passed. Requires a duration parameter. Use this when the duration is known in advance.
+		//   Internal: The action has to implement the status endpoint to signal when the action is done. Use this when the duration is not known in advance.
+		//   Instantaneous: The action is done immediately. Use this for actions that happen immediately, e.g. a reboot.
+		TimeControl: action_kit_api.TimeControlExternal,
+
+		// The parameters for the action
+		Parameters: []action_kit_api.ActionParameter{
+			grpcServiceAttribute("grpc.client-service"),
+			grpcMethodAttribute,
+			{
+				Name:         "duration",
+				Label:        "Duration",
+				Description:  new("How long should the calls fail?"),
+				Type:         action_kit_api.ActionParameterTypeDuration,
+				DefaultValue: new("30s"),
+				Required:     new(true),
+			},
+			grpcStatusAttribute,
+			erroneousCallRate,
+		},
+		Stop: new(action_kit_api.MutatingEndpointReference{}),
+	}
+}
+
+func grpcClientStatusConfigProvider(request action_kit_api.PrepareActionRequestBody) (map[string]any, error) {
+	duration, err := extractDuration(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"attack-class":      "com.steadybit.attacks.javaagent.instrumentation.GrpcClientStatusInstrumentation",
+		"duration":          int(duration / time.Millisecond),
+		"erroneousCallRate": extutil.ToInt(request.Config["erroneousCallRate"]),
+		"method":            extutil.ToString(request.Config["method"]),
+		"service":           extutil.ToString(request.Config["service"]),
+		"status":            extutil.ToString(request.Config["status"]),
+	}, nil
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjvm
+
+import (
+	"github.com/steadybit/action-kit/go/action_kit_api/v2"
+)
+
+var (
+	grpcMethodAttribute = action_kit_api.ActionParameter{
+		Name:         "method",
+		Label:        "Method",
+		Description:  new("Which method of the service should be attacked? Use '*' for all methods."),
+		Type:         action_kit_api.ActionParameterTypeString,
+		DefaultValue: new("*"),
+		Required:     new(true),
+	}
+	grpcStatusAttribute = action_kit_api.ActionParameter{
+		Name:         "status",
+		Label:        "Status",
+		Description:  new("Which gRPC status code should the calls fail with?"),
+		Type:         action_kit_api.ActionParameterTypeString,
+		DefaultValue: new("UNAVAILABLE"),
+		Required:     new(true),
+		Options: new([]action_kit_api.ParameterOption{
+			action_kit_api.ExplicitParameterOption{
+				Label: "UNAVAILABLE",
+				Value: "UNAVAILABLE",
+			},
+			action_kit_api.ExplicitParameterOption{
+				Label: "DEADLINE_EXCEEDED",
+				Value: "DEADLINE_EXCEEDED",
+			},
+			action_kit_api.ExplicitParameterOption{
+				Label: "INTERNAL",
+				Value: "INTERNAL",
+			},
+			action_kit_api.ExplicitParameterOption{
+				Label: "RESOURCE_EXHAUSTED",
+				Value: "RESOURCE_EXHAUSTED",
+			},
+			action_kit_api.ExplicitParameterOption{
+				Label: "UNKNOWN",
+				Value: "UNKNOWN",
+			},
+			action_kit_api.ExplicitParameterOption{
+				Label: "CANCELLED",
+				Value: "CANCELLED",
+			},
+			action_kit_api.ExplicitParameterOption{
+				Label: "ABORTED",
+				Value: "ABORTED",
+			},
+			action_kit_api.ExplicitParameterOption{
+				Label: "NOT_FOUND",
+				Value: "NOT_FOUND",
+			},
+			action_kit_api.ExplicitParameterOption{
+				Label: "PERMISSION_DENIED",
+				Value: "PERMISSION_DENIED",
+			},
+			action_kit_api.ExplicitParameterOption{
+				Label: "UNAUTHENTICATED",
+				Value: "UNAUTHENTICATED",
+			},
+		}),
+	}
+)
+
+// grpcServiceAttribute offers the services of the given target attribute, `grpc.service` for the services
+// implemented by the target and `grpc.client-service` for the services called by it.
+func grpcServiceAttribute(attribute string) action_kit_api.ActionParameter {
+	return action_kit_api.ActionParameter{
+		Name:         "service",
+		Label:        "Service",
+		Description:  new("Which service should be attacked?"),
+		Type:         action_kit_api.ActionParameterTypeString,
+		DefaultValue: new("*"),
+		Required:     new(true),
+		Options: new([]action_kit_api.ParameterOption{
+			action_kit_api.ExplicitParameterOption{
+				Label: "Any",
+				Value: "*",
+			},
+			action_kit_api.ParameterOptionsFromTargetAttribute{
+				Attribute: attribute,
+			},
+		}),
+	}
+}
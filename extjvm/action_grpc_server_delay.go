@@ -0,0 +1,114 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjvm
+
+import (
+	"time"
+
+	"github.com/steadybit/action-kit/go/action_kit_api/v2"
+	"github.com/steadybit/action-kit/go/action_kit_sdk"
+	"github.com/steadybit/extension-jvm/extjvm/jvm"
+	"github.com/steadybit/extension-kit/extbuild"
+	"github.com/steadybit/extension-kit/extutil"
+)
+
+func NewGrpcServerDelay(facade jvm.JavaFacade) action_kit_sdk.Action[JavaagentActionState] {
+	return &javaagentAction{
+		pluginJar:      "attack-java-javaagent.jar",
+		description:    grpcServerDelayDescribe(),
+		configProvider: grpcServerDelayConfigProvider,
+		facade:         facade,
+	}
+}
+
+func grpcServerDelayDescribe() action_kit_api.ActionDescription {
+	return action_kit_api.ActionDescription{
+		Id:          ActionIDPrefix + ".grpc-server-delay-attack",
+		Label:       "gRPC Server Delay",
+		Description: "Delay the gRPC calls received by the server (grpc-java) for the given service and method by the given duration, before the service implementation is invoked.",
+		Version:     extbuild.GetSemverVersionStringOrUnknown(),
+		Icon:        new(grpcDelayIcon),
+		TargetSelection: new(action_kit_api.TargetSelection{
+			TargetType:         targetType,
+			TargetQuery:        new(`grpc.service IS PRESENT`),
+			SelectionTemplates: new(targetSelectionTemplates),
+		}),
+		Technology: new("JVM"),
+
+		// To clarify the purpose of the action, you can set a kind.
+		//   Attack: Will cause harm to targets
+		//   Check: Will perform checks on the targets
+		//   LoadTest: Will perform load tests on the targets
+		//   Other
+		Kind: action_kit_api.Attack,
+
+		// How the action is controlled over time.
+		//   External: The agent takes care and calls stop then the time has passed. Requires a duration parameter. Use this when the duration is known in advance.
+		//   Internal: The action has to implement the status endpoint to signal when the action is done. Use this when the duration is not known in advance.
+		//   Instantaneous: The action is done immediately. Use this for actions that happen immediately, e.g. a reboot.
+		TimeControl: action_kit_api.TimeControlExternal,
+
+		// The parameters for the action
+		Parameters: []action_kit_api.ActionParameter{
+			grpcServiceAttribute("grpc.service"),
+			grpcMethodAttribute,
+			{
+				Name:         "duration",
+				Label:        "Duration",
+				Description:  new("How long should the calls be attacked?"),
+				Type:         action_kit_api.ActionParameterTypeDuration,
+				DefaultValue: new("30s"),
+				Required:     new(true),
+			},
+			{
+				Name:         "delay",
+				Label:        "Delay",
+				Description:  new("How long should the calls be delayed?"),
+				Type:         action_kit_api.ActionParameterTypeDuration,
+				DefaultValue: new("500ms"),
+				Required:     new(true),
+			},
+			{
+				Name:         "delayJitter",
+				Label:        "Jitter",
+				Description:  new("Add random +/-30% jitter to the delay?"),
+				Type:         action_kit_api.ActionParameterTypeBoolean,
+				DefaultValue: new("false"),
+				Required:     new(true),
+				Advanced:     new(true),
+			},
+			delayDistributionAttribute,
+			delayMaxAttribute,
+			delayStddevAttribute,
+			delayP99Attribute,
+			delayRampTargetAttribute,
+		},
+		Stop: new(action_kit_api.MutatingEndpointReference{}),
+	}
+}
+
+func grpcServerDelayConfigProvider(request action_kit_api.PrepareActionRequestBody) (map[string]any, error) {
+	duration, err := extractDuration(request)
+	if err != nil {
+		return nil, err
+	}
+
+	config := map[string]any{
+		"attack-class": "com.steadybit.attacks.javaagent.instrumentation.GrpcServerDelayInstrumentation",
+		"duration":     int(duration / time.Millisecond),
+		"delay":        extutil.ToUInt64(request.Config["delay"]),
+		"delayJitter":  extutil.ToBool(request.Config["delayJitter"]),
+		"method":       extutil.ToString(request.Config["method"]),
+		"service":      extutil.ToString(request.Config["service"]),
+	}
+
+	if delayDistribution, err := extractDelayDistribution(request); err != nil {
+		return nil, err
+	} else if delayDistribution != nil {
+		config["delayDistribution"] = delayDistribution
+	}
+
+	return config, nil
+}
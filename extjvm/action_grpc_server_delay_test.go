@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: MIT
+// SPDX-FileCopyrightText: 2026 Steadybit GmbH
+
+package extjvm
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/steadybit/action-kit/go/action_kit_api/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func Test_Grpc_Server_Delay_Prepare(t *testing.T) {
+	facade := &mockJavaFacade{}
+	fake, err := facade.startFakeJvm()
+	require.NoError(t, err)
+	defer func(fake *FakeJvm) {
+		_ = fake.stop()
+	}(fake)
+
+	tests := []struct {
+		name        string
+		requestBody action_kit_api.PrepareActionRequestBody
+		wantedState *JavaagentActionState
+	}{
+		{
+			name: "Should return config",
+			requestBody: action_kit_api.PrepareActionRequestBody{
+				Config: map[string]any{
+					"action":      "prepare",
+					"service":     "com.example.OrderService",
+					"method":      "PlaceOrder",
+					"duration":    "10000",
+					"delay":       "500",
+					"delayJitter": "false",
+				},
+				ExecutionId: uuid.New(),
+				Target:      new(fake.getTarget()),
+			},
+
+			wantedState: &JavaagentActionState{
+				ConfigJson: "{\"attack-class\":\"com.steadybit.attacks.javaagent.instrumentation.GrpcServerDelayInstrumentation\",\"delay\":500,\"delayJitter\":false,\"duration\":10000,\"method\":\"PlaceOrder\",\"service\":\"com.example.OrderService\"}",
+			},
+		},
+	}
+	action := NewGrpcServerDelay(facade)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			//Given
+			state := action.NewEmptyState()
+			request := tt.requestBody
+
+			//When
+			_, err := action.Prepare(context.Background(), &state, request)
+			assert.NoError(t, err)
+
+			//Then
+			if tt.wantedState != nil {
+				assert.Equal(t, tt.wantedState.ConfigJson, state.ConfigJson)
+			}
+		})
+	}
+}
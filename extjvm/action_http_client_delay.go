@@ -77,6 +77,11 @@ func httpClientDelayDescribe() action_kit_api.ActionDescription {
 				Required:     new(true),
 				Advanced:     new(true),
 			},
+			delayDistributionAttribute,
+			delayMaxAttribute,
+			delayStddevAttribute,
+			delayP99Attribute,
+			delayRampTargetAttribute,
 			{
 				Name:        "httpMethods",
 				Label:       "Http Methods",
@@ -126,7 +131,7 @@ func httpClientDelayConfigProvider(request action_kit_api.PrepareActionRequestBo
 		return nil, err
 	}
 
-	return map[string]any{
+	config := map[string]any{
 		"attack-class": "com.steadybit.attacks.javaagent.instrumentation.SpringHttpClientDelayInstrumentation",
 		"duration":     int(duration / time.Millisecond),
 		"delay":        extutil.ToUInt64(request.Config["delay"]),
@@ -134,5 +139,13 @@ func httpClientDelayConfigProvider(request action_kit_api.PrepareActionRequestBo
 		"httpMethods":  extutil.ToStringArray(request.Config["httpMethods"]),
 		"hostAddress":  extutil.ToString(request.Config["hostAddress"]),
 		"urlPath":      extutil.ToString(request.Config["urlPath"]),
-	}, nil
+	}
+
+	if delayDistribution, err := extractDelayDistribution(request); err != nil {
+		return nil, err
+	} else if delayDistribution != nil {
+		config["delayDistribution"] = delayDistribution
+	}
+
+	return config, nil
 }
@@ -46,6 +46,28 @@ func Test_http_Client_Delay_Prepare(t *testing.T) {
 				ConfigJson: "{\"attack-class\":\"com.steadybit.attacks.javaagent.instrumentation.SpringHttpClientDelayInstrumentation\",\"delay\":500,\"delayJitter\":true,\"duration\":10000,\"hostAddress\":\"*\",\"httpMethods\":[\"GET\"],\"urlPath\":\"/test\"}",
 			},
 		},
+		{
+			name: "Should return config with long-tail delay distribution",
+			requestBody: action_kit_api.PrepareActionRequestBody{
+				Config: map[string]any{
+					"action":            "prepare",
+					"httpMethods":       []any{"GET"},
+					"hostAddress":       "*",
+					"urlPath":           "/test",
+					"duration":          "10000",
+					"delay":             "100",
+					"delayJitter":       "false",
+					"delayDistribution": "LONG_TAIL",
+					"delayP99":          "2000",
+				},
+				ExecutionId: uuid.New(),
+				Target:      new(fake.getTarget()),
+			},
+
+			wantedState: &JavaagentActionState{
+				ConfigJson: "{\"attack-class\":\"com.steadybit.attacks.javaagent.instrumentation.SpringHttpClientDelayInstrumentation\",\"delay\":100,\"delayDistribution\":{\"p50\":100,\"p99\":2000,\"type\":\"LONG_TAIL\"},\"delayJitter\":false,\"duration\":10000,\"hostAddress\":\"*\",\"httpMethods\":[\"GET\"],\"urlPath\":\"/test\"}",
+			},
+		},
 	}
 	action := NewHttpClientDelay(facade)
 	for _, tt := range tests {
@@ -0,0 +1,124 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjvm
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/steadybit/action-kit/go/action_kit_api/v2"
+	"github.com/steadybit/action-kit/go/action_kit_sdk"
+	"github.com/steadybit/extension-jvm/extjvm/jvm"
+	"github.com/steadybit/extension-kit/extbuild"
+	"github.com/steadybit/extension-kit/extutil"
+)
+
+func NewJavaCpuBurn(facade jvm.JavaFacade) action_kit_sdk.Action[JavaagentActionState] {
+	return &javaagentAction{
+		pluginJar:      "attack-java-javaagent.jar",
+		description:    cpuBurnDescribe(),
+		configProvider: cpuBurnConfigProvider,
+		facade:         facade,
+	}
+}
+
+func cpuBurnDescribe() action_kit_api.ActionDescription {
+	return action_kit_api.ActionDescription{
+		Id:          ActionIDPrefix + ".java-cpu-burn-attack",
+		Label:       "Java CPU Burn",
+		Description: "Consume CPU within the JVM using the given number of threads.",
+		Version:     extbuild.GetSemverVersionStringOrUnknown(),
+		Icon:        new(javaCpuBurnIcon),
+		TargetSelection: new(action_kit_api.TargetSelection{
+			TargetType:         targetType,
+			SelectionTemplates: new(targetSelectionTemplates),
+		}),
+		Technology: new("JVM"),
+
+		// To clarify the purpose of the action, you can set a kind.
+		//   Attack: Will cause harm to targets
+		//   Check: Will perform checks on the targets
+		//   LoadTest: Will perform load tests on the targets
+		//   Other
+		Kind: action_kit_api.Attack,
+
+		// How the action is controlled over time.
+		//   External: The agent takes care and calls stop then the time has passed. Requires a duration parameter. Use this when the duration is known in advance.
+		//   Internal: The action has to implement the status endpoint to signal when the action is done. Use this when the duration is not known in advance.
+		//   Instantaneous: The action is done immediately. Use this for actions that happen immediately, e.g. a reboot.
+		TimeControl: action_kit_api.TimeControlExternal,
+
+		// The parameters for the action
+		Parameters: []action_kit_api.ActionParameter{
+			{
+				Name:         "threads",
+				Label:        "Threads",
+				Description:  new("How many threads should consume CPU? Use 0 for one thread per available processor of the JVM."),
+				Type:         action_kit_api.ActionParameterTypeInteger,
+				DefaultValue: new("1"),
+				MinValue:     new(0),
+				Required:     new(true),
+			},
+			{
+				Name:         "utilization",
+				Label:        "Utilization",
+				Description:  new("How much of a CPU should each thread consume?"),
+				Type:         action_kit_api.ActionParameterTypePercentage,
+				DefaultValue: new("100"),
+				MinValue:     new(1),
+				MaxValue:     new(100),
+				Required:     new(true),
+			},
+			{
+				Name:         "duration",
+				Label:        "Duration",
+				Description:  new("How long should the CPU be consumed?"),
+				Type:         action_kit_api.ActionParameterTypeDuration,
+				DefaultValue: new("30s"),
+				Required:     new(true),
+			},
+			{
+				Name:         "threadNamePrefix",
+				Label:        "Thread Name Prefix",
+				Description:  new("Prefix for the names of the threads, which makes them identifiable in thread dumps and profilers."),
+				Type:         action_kit_api.ActionParameterTypeString,
+				DefaultValue: new("steadybit-cpu-burn"),
+				Advanced:     new(true),
+			},
+		},
+		Stop: new(action_kit_api.MutatingEndpointReference{}),
+	}
+}
+
+func cpuBurnConfigProvider(request action_kit_api.PrepareActionRequestBody) (map[string]any, error) {
+	duration, err := extractDuration(request)
+	if err != nil {
+		return nil, err
+	}
+
+	threads := extutil.ToInt(request.Config["threads"])
+	if threads < 0 {
+		return nil, errors.New("threads must not be negative")
+	}
+
+	utilization := extutil.ToInt(request.Config["utilization"])
+	if utilization <= 0 || utilization > 100 {
+		return nil, errors.New("utilization must be between 1 and 100 percent")
+	}
+
+	config := map[string]any{
+		"attack-class": "com.steadybit.attacks.javaagent.stress.CpuBurnAttack",
+		"duration":     int(duration / time.Millisecond),
+		"threads":      threads,
+		"utilization":  utilization,
+	}
+
+	if prefix := strings.TrimSpace(extutil.ToString(request.Config["threadNamePrefix"])); prefix != "" {
+		config["threadNamePrefix"] = prefix
+	}
+
+	return config, nil
+}
@@ -0,0 +1,80 @@
+package extjvm
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/steadybit/action-kit/go/action_kit_api/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func Test_Java_Cpu_Burn_Prepare(t *testing.T) {
+	facade := &mockJavaFacade{}
+	fake, err := facade.startFakeJvm()
+	require.NoError(t, err)
+	defer func(fake *FakeJvm) {
+		_ = fake.stop()
+	}(fake)
+
+	tests := []struct {
+		name        string
+		requestBody action_kit_api.PrepareActionRequestBody
+		wantedState *JavaagentActionState
+		wantedError string
+	}{
+		{
+			name: "Should return config",
+			requestBody: action_kit_api.PrepareActionRequestBody{
+				Config: map[string]any{
+					"action":           "prepare",
+					"threads":          2,
+					"utilization":      80,
+					"threadNamePrefix": "chaos",
+					"duration":         "10000",
+				},
+				ExecutionId: uuid.New(),
+				Target:      new(fake.getTarget()),
+			},
+
+			wantedState: &JavaagentActionState{
+				ConfigJson: "{\"attack-class\":\"com.steadybit.attacks.javaagent.stress.CpuBurnAttack\",\"duration\":10000,\"threadNamePrefix\":\"chaos\",\"threads\":2,\"utilization\":80}",
+			},
+		},
+		{
+			name: "Should fail for invalid utilization",
+			requestBody: action_kit_api.PrepareActionRequestBody{
+				Config: map[string]any{
+					"action":      "prepare",
+					"threads":     1,
+					"utilization": 0,
+					"duration":    "10000",
+				},
+				ExecutionId: uuid.New(),
+				Target:      new(fake.getTarget()),
+			},
+			wantedError: "utilization must be between 1 and 100 percent",
+		},
+	}
+	action := NewJavaCpuBurn(facade)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			//Given
+			state := action.NewEmptyState()
+			request := tt.requestBody
+
+			//When
+			_, err := action.Prepare(context.Background(), &state, request)
+			if tt.wantedError != "" {
+				assert.EqualError(t, err, tt.wantedError)
+				return
+			}
+			assert.NoError(t, err)
+
+			//Then
+			if tt.wantedState != nil {
+				assert.Equal(t, tt.wantedState.ConfigJson, state.ConfigJson)
+			}
+		})
+	}
+}
@@ -0,0 +1,199 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjvm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/steadybit/action-kit/go/action_kit_api/v2"
+	"github.com/steadybit/action-kit/go/action_kit_sdk"
+	"github.com/steadybit/extension-jvm/extjvm/jvm"
+	"github.com/steadybit/extension-kit/extbuild"
+	"github.com/steadybit/extension-kit/extutil"
+)
+
+const megabyte = 1024 * 1024
+
+// readHeapSize returns the maximum and the currently used heap in bytes.
+var readHeapSize = func(ctx context.Context, javaVm jvm.JavaVm) (int64, int64, error) {
+	data, err := jvm.ReadHsperfdata(ctx, javaVm)
+	if err != nil {
+		return 0, 0, err
+	}
+	if maxHeap := data.MaxHeapSize(); maxHeap > 0 {
+		return maxHeap, data.UsedHeapSize(), nil
+	}
+	return 0, 0, errors.New("hsperfdata contains no heap capacity")
+}
+
+func NewJavaHeapFill(facade jvm.JavaFacade) action_kit_sdk.Action[JavaagentActionState] {
+	return &javaagentAction{
+		pluginJar:      "attack-java-javaagent.jar",
+		description:    heapFillDescribe(),
+		configProvider: heapFillConfigProvider,
+		facade:         facade,
+		prepareCheck:   checkHeapFill,
+	}
+}
+
+func heapFillDescribe() action_kit_api.ActionDescription {
+	return action_kit_api.ActionDescription{
+		Id:          ActionIDPrefix + ".java-heap-fill-attack",
+		Label:       "Java Heap Fill",
+		Description: "Fill the heap of the JVM or stress the garbage collector with a high allocation rate.",
+		Version:     extbuild.GetSemverVersionStringOrUnknown(),
+		Icon:        new(javaHeapFillIcon),
+		TargetSelection: new(action_kit_api.TargetSelection{
+			TargetType:         targetType,
+			SelectionTemplates: new(targetSelectionTemplates),
+		}),
+		Technology: new("JVM"),
+
+		// To clarify the purpose of the action, you can set a kind.
+		//   Attack: Will cause harm to targets
+		//   Check: Will perform checks on the targets
+		//   LoadTest: Will perform load tests on the targets
+		//   Other
+		Kind: action_kit_api.Attack,
+
+		// How the action is controlled over time.
+		//   External: The agent takes care and calls stop then the time has passed. Requires a duration parameter. Use this when the duration is known in advance.
+		//   Internal: The action has to implement the status endpoint to signal when the action is done. Use this when the duration is not known in advance.
+		//   Instantaneous: The action is done immediately. Use this for actions that happen immediately, e.g. a reboot.
+		TimeControl: action_kit_api.TimeControlExternal,
+
+		// The parameters for the action
+		Parameters: []action_kit_api.ActionParameter{
+			{
+				Name:         "mode",
+				Label:        "Mode",
+				Description:  new("Should the memory be retained until the end of the attack or allocated and released continuously to stress the garbage collector?"),
+				Type:         action_kit_api.ActionParameterTypeString,
+				DefaultValue: new("FILL"),
+				Required:     new(true),
+				Options: new([]action_kit_api.ParameterOption{
+					action_kit_api.ExplicitParameterOption{
+						Label: "Fill heap",
+						Value: "FILL",
+					},
+					action_kit_api.ExplicitParameterOption{
+						Label: "Allocation rate",
+						Value: "ALLOCATION_RATE",
+					},
+				}),
+			},
+			{
+				Name:         "heapPercentage",
+				Label:        "Heap Usage",
+				Description:  new("Up to which usage of the maximum heap should the heap be filled? Memory already in use counts towards it. Ignored if a size is given."),
+				Type:         action_kit_api.ActionParameterTypePercentage,
+				DefaultValue: new("80"),
+				MinValue:     new(1),
+				MaxValue:     new(100),
+			},
+			{
+				Name:        "size",
+				Label:       "Size (MB)",
+				Description: new("How many megabytes should be filled?"),
+				Type:        action_kit_api.ActionParameterTypeInteger,
+				MinValue:    new(0),
+			},
+			{
+				Name:         "allocationRate",
+				Label:        "Allocation Rate (MB/s)",
+				Description:  new("How many megabytes per second should be allocated in the allocation rate mode?"),
+				Type:         action_kit_api.ActionParameterTypeInteger,
+				DefaultValue: new("100"),
+				MinValue:     new(1),
+				Advanced:     new(true),
+			},
+			{
+				Name:         "duration",
+				Label:        "Duration",
+				Description:  new("How long should the memory pressure be applied?"),
+				Type:         action_kit_api.ActionParameterTypeDuration,
+				DefaultValue: new("30s"),
+				Required:     new(true),
+			},
+		},
+		Stop: new(action_kit_api.MutatingEndpointReference{}),
+	}
+}
+
+func heapFillConfigProvider(request action_kit_api.PrepareActionRequestBody) (map[string]any, error) {
+	duration, err := extractDuration(request)
+	if err != nil {
+		return nil, err
+	}
+
+	config := map[string]any{
+		"attack-class": "com.steadybit.attacks.javaagent.stress.HeapFillAttack",
+		"duration":     int(duration / time.Millisecond),
+	}
+
+	switch mode := extutil.ToString(request.Config["mode"]); mode {
+	case "", "FILL":
+		config["mode"] = "FILL"
+		if size := extutil.ToInt64(request.Config["size"]); size > 0 {
+			config["bytes"] = size * megabyte
+		} else {
+			percentage := extutil.ToInt(request.Config["heapPercentage"])
+			if percentage <= 0 || percentage > 100 {
+				return nil, errors.New("heap usage must be between 1 and 100 percent")
+			}
+			config["heapPercentage"] = percentage
+		}
+	case "ALLOCATION_RATE":
+		config["mode"] = mode
+		allocationRate := extutil.ToInt64(request.Config["allocationRate"])
+		if allocationRate <= 0 {
+			return nil, errors.New("allocation rate must be greater than 0")
+		}
+		config["allocationRate"] = allocationRate * megabyte
+	default:
+		return nil, fmt.Errorf("unsupported mode '%s'", mode)
+	}
+
+	return config, nil
+}
+
+func checkHeapFill(ctx context.Context, _ jvm.JavaFacade, javaVm jvm.JavaVm, config map[string]any) ([]action_kit_api.Message, error) {
+	if config["mode"] != "FILL" {
+		return nil, nil
+	}
+
+	maxHeap, usedHeap, err := readHeapSize(ctx, javaVm)
+	if err != nil {
+		return []action_kit_api.Message{{
+			Level:   extutil.Ptr(action_kit_api.Warn),
+			Message: fmt.Sprintf("Could not read maximum heap size of JVM with PID %d: %s", javaVm.Pid(), err),
+		}}, nil
+	}
+
+	if b, ok := config["bytes"].(int64); ok {
+		if b >= maxHeap {
+			return nil, fmt.Errorf("size of %d MB exceeds the maximum heap of %d MB", b/megabyte, maxHeap/megabyte)
+		}
+		return []action_kit_api.Message{{
+			Level:   extutil.Ptr(action_kit_api.Info),
+			Message: fmt.Sprintf("Filling %d MB of the maximum heap of %d MB, %d MB are in use", b/megabyte, maxHeap/megabyte, usedHeap/megabyte),
+		}}, nil
+	}
+
+	percentage := int64(extutil.ToInt(config["heapPercentage"]))
+	if target := maxHeap * percentage / 100; usedHeap < target {
+		return []action_kit_api.Message{{
+			Level:   extutil.Ptr(action_kit_api.Info),
+			Message: fmt.Sprintf("Filling the heap up to %d%% (%d MB) of the maximum heap of %d MB, %d MB are in use", percentage, target/megabyte, maxHeap/megabyte, usedHeap/megabyte),
+		}}, nil
+	}
+	return []action_kit_api.Message{{
+		Level:   extutil.Ptr(action_kit_api.Warn),
+		Message: fmt.Sprintf("Heap usage of %d MB already exceeds %d%% of the maximum heap of %d MB, nothing will be filled", usedHeap/megabyte, percentage, maxHeap/megabyte),
+	}}, nil
+}
@@ -0,0 +1,148 @@
+package extjvm
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/steadybit/action-kit/go/action_kit_api/v2"
+	"github.com/steadybit/extension-jvm/extjvm/jvm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func Test_Java_Heap_Fill_Prepare(t *testing.T) {
+	facade := &mockJavaFacade{}
+	fake, err := facade.startFakeJvm()
+	require.NoError(t, err)
+	defer func(fake *FakeJvm) {
+		_ = fake.stop()
+	}(fake)
+
+	original := readHeapSize
+	readHeapSize = func(_ context.Context, _ jvm.JavaVm) (int64, int64, error) {
+		return 1024 * megabyte, 256 * megabyte, nil
+	}
+	defer func() { readHeapSize = original }()
+
+	tests := []struct {
+		name          string
+		requestBody   action_kit_api.PrepareActionRequestBody
+		wantedState   *JavaagentActionState
+		wantedMessage string
+		wantedError   string
+	}{
+		{
+			name: "Should return config for heap percentage",
+			requestBody: action_kit_api.PrepareActionRequestBody{
+				Config: map[string]any{
+					"action":         "prepare",
+					"mode":           "FILL",
+					"heapPercentage": 50,
+					"duration":       "10000",
+				},
+				ExecutionId: uuid.New(),
+				Target:      new(fake.getTarget()),
+			},
+
+			wantedState: &JavaagentActionState{
+				ConfigJson: "{\"attack-class\":\"com.steadybit.attacks.javaagent.stress.HeapFillAttack\",\"duration\":10000,\"heapPercentage\":50,\"mode\":\"FILL\"}",
+			},
+			wantedMessage: "Filling the heap up to 50% (512 MB) of the maximum heap of 1024 MB, 256 MB are in use",
+		},
+		{
+			name: "Should return config for absolute size",
+			requestBody: action_kit_api.PrepareActionRequestBody{
+				Config: map[string]any{
+					"action":         "prepare",
+					"mode":           "FILL",
+					"heapPercentage": 50,
+					"size":           256,
+					"duration":       "10000",
+				},
+				ExecutionId: uuid.New(),
+				Target:      new(fake.getTarget()),
+			},
+
+			wantedState: &JavaagentActionState{
+				ConfigJson: "{\"attack-class\":\"com.steadybit.attacks.javaagent.stress.HeapFillAttack\",\"bytes\":268435456,\"duration\":10000,\"mode\":\"FILL\"}",
+			},
+			wantedMessage: "Filling 256 MB of the maximum heap of 1024 MB, 256 MB are in use",
+		},
+		{
+			name: "Should warn if heap usage exceeds percentage",
+			requestBody: action_kit_api.PrepareActionRequestBody{
+				Config: map[string]any{
+					"action":         "prepare",
+					"mode":           "FILL",
+					"heapPercentage": 20,
+					"duration":       "10000",
+				},
+				ExecutionId: uuid.New(),
+				Target:      new(fake.getTarget()),
+			},
+
+			wantedState: &JavaagentActionState{
+				ConfigJson: "{\"attack-class\":\"com.steadybit.attacks.javaagent.stress.HeapFillAttack\",\"duration\":10000,\"heapPercentage\":20,\"mode\":\"FILL\"}",
+			},
+			wantedMessage: "Heap usage of 256 MB already exceeds 20% of the maximum heap of 1024 MB, nothing will be filled",
+		},
+		{
+			name: "Should return config for allocation rate",
+			requestBody: action_kit_api.PrepareActionRequestBody{
+				Config: map[string]any{
+					"action":         "prepare",
+					"mode":           "ALLOCATION_RATE",
+					"allocationRate": 200,
+					"duration":       "10000",
+				},
+				ExecutionId: uuid.New(),
+				Target:      new(fake.getTarget()),
+			},
+
+			wantedState: &JavaagentActionState{
+				ConfigJson: "{\"allocationRate\":209715200,\"attack-class\":\"com.steadybit.attacks.javaagent.stress.HeapFillAttack\",\"duration\":10000,\"mode\":\"ALLOCATION_RATE\"}",
+			},
+		},
+		{
+			name: "Should fail if size exceeds max heap",
+			requestBody: action_kit_api.PrepareActionRequestBody{
+				Config: map[string]any{
+					"action":   "prepare",
+					"mode":     "FILL",
+					"size":     2048,
+					"duration": "10000",
+				},
+				ExecutionId: uuid.New(),
+				Target:      new(fake.getTarget()),
+			},
+			wantedError: "size of 2048 MB exceeds the maximum heap of 1024 MB",
+		},
+	}
+	action := NewJavaHeapFill(facade)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			//Given
+			state := action.NewEmptyState()
+			request := tt.requestBody
+
+			//When
+			result, err := action.Prepare(context.Background(), &state, request)
+			if tt.wantedError != "" {
+				assert.EqualError(t, err, tt.wantedError)
+				return
+			}
+			assert.NoError(t, err)
+
+			//Then
+			if tt.wantedState != nil {
+				assert.Equal(t, tt.wantedState.ConfigJson, state.ConfigJson)
+			}
+			if tt.wantedMessage != "" {
+				require.NotNil(t, result)
+				assert.Equal(t, tt.wantedMessage, (*result.Messages)[0].Message)
+			} else {
+				assert.Nil(t, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjvm
+
+import (
+	"time"
+
+	"github.com/steadybit/action-kit/go/action_kit_api/v2"
+	"github.com/steadybit/action-kit/go/action_kit_sdk"
+	"github.com/steadybit/extension-jvm/extjvm/jvm"
+	"github.com/steadybit/extension-kit/extbuild"
+	"github.com/steadybit/extension-kit/extutil"
+)
+
+func NewJavaHttpClientDelay(facade jvm.JavaFacade) action_kit_sdk.Action[JavaagentActionState] {
+	return &javaagentAction{
+		pluginJar:      "attack-java-javaagent.jar",
+		description:    javaHttpClientDelayDescribe(),
+		configProvider: javaHttpClientDelayConfigProvider,
+		facade:         facade,
+	}
+}
+
+func javaHttpClientDelayDescribe() action_kit_api.ActionDescription {
+	return action_kit_api.ActionDescription{
+		Id:          ActionIDPrefix + ".java-httpclient-delay-attack",
+		Label:       "Java Http Client Delay",
+		Description: "Delays a synchronous call of the JDK HttpClient, OkHttp or Apache HttpClient 5 by the given duration.",
+		Version:     extbuild.GetSemverVersionStringOrUnknown(),
+		Icon:        new(springHttpDelayIcon),
+		TargetSelection: new(action_kit_api.TargetSelection{
+			TargetType:         targetType,
+			TargetQuery:        new(`instance.type="java"`),
+			SelectionTemplates: new(targetSelectionTemplates),
+		}),
+		Technology: new("JVM"),
+
+		// To clarify the purpose of the action, you can set a kind.
+		//   Attack: Will cause harm to targets
+		//   Check: Will perform checks on the targets
+		//   LoadTest: Will perform load tests on the targets
+		//   Other
+		Kind: action_kit_api.Attack,
+
+		// How the action is controlled over time.
+		//   External: The agent takes care and calls stop then the time has passed. Requires a duration parameter. Use this when the duration is known in advance.
+		//   Internal: The action has to implement the status endpoint to signal when the action is done. Use this when the duration is not known in advance.
+		//   Instantaneous: The action is done immediately. Use this for actions that happen immediately, e.g. a reboot.
+		TimeControl: action_kit_api.TimeControlExternal,
+
+		// The parameters for the action
+		Parameters: []action_kit_api.ActionParameter{
+			{
+				Name:         "duration",
+				Label:        "Duration",
+				Description:  new("How long should the delay be inflicted?"),
+				Type:         action_kit_api.ActionParameterTypeDuration,
+				DefaultValue: new("30s"),
+				Required:     new(true),
+			},
+			{
+				Name:         "delay",
+				Label:        "Delay",
+				Description:  new("How long should the call be delayed?"),
+				Type:         action_kit_api.ActionParameterTypeDuration,
+				DefaultValue: new("500ms"),
+				Required:     new(true),
+			},
+			{
+				Name:         "delayJitter",
+				Label:        "Jitter",
+				Description:  new("Add random +/-30% jitter to response delay?"),
+				Type:         action_kit_api.ActionParameterTypeBoolean,
+				DefaultValue: new("false"),
+				Required:     new(true),
+				Advanced:     new(true),
+			},
+			delayDistributionAttribute,
+			delayMaxAttribute,
+			delayStddevAttribute,
+			delayP99Attribute,
+			delayRampTargetAttribute,
+			{
+				Name:        "httpMethods",
+				Label:       "Http Methods",
+				Description: new("Which HTTP methods should be attacked?"),
+				Type:        action_kit_api.ActionParameterTypeStringArray,
+				Required:    new(false),
+				Advanced:    new(true),
+				Options:     methodsOptions,
+			},
+			{
+				Name:         "hostAddress",
+				Label:        "Host Address",
+				Description:  new("Request to which host address should be attacked?"),
+				Type:         action_kit_api.ActionParameterTypeString,
+				DefaultValue: new("*"),
+				Required:     new(false),
+				Advanced:     new(true),
+				OptionsOnly:  new(false),
+				Options: new([]action_kit_api.ParameterOption{
+					action_kit_api.ExplicitParameterOption{
+						Label: "Any",
+						Value: "*",
+					},
+					action_kit_api.ParameterOptionsFromTargetAttribute{
+						Attribute: "java-instance.http-outgoing-calls",
+					},
+				}),
+			},
+			{
+				Name:         "urlPath",
+				Label:        "Path Pattern",
+				Description:  new("Which URL path should be attacked? Supports Ant-style path patterns (e.g. /api/**)."),
+				Type:         action_kit_api.ActionParameterTypeString,
+				DefaultValue: new("/**"),
+				Required:     new(false),
+				Advanced:     new(true),
+			},
+		},
+		Stop: new(action_kit_api.MutatingEndpointReference{}),
+	}
+
+}
+
+func javaHttpClientDelayConfigProvider(request action_kit_api.PrepareActionRequestBody) (map[string]any, error) {
+	duration, err := extractDuration(request)
+	if err != nil {
+		return nil, err
+	}
+
+	config := map[string]any{
+		"attack-class": "com.steadybit.attacks.javaagent.instrumentation.JavaHttpClientDelayInstrumentation",
+		"duration":     int(duration / time.Millisecond),
+		"delay":        extutil.ToUInt64(request.Config["delay"]),
+		"delayJitter":  extutil.ToBool(request.Config["delayJitter"]),
+		"httpMethods":  extutil.ToStringArray(request.Config["httpMethods"]),
+		"hostAddress":  extutil.ToString(request.Config["hostAddress"]),
+		"urlPath":      extutil.ToString(request.Config["urlPath"]),
+	}
+
+	if delayDistribution, err := extractDelayDistribution(request); err != nil {
+		return nil, err
+	} else if delayDistribution != nil {
+		config["delayDistribution"] = delayDistribution
+	}
+
+	return config, nil
+}
@@ -0,0 +1,179 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjvm
+
+import (
+	"time"
+
+	"github.com/steadybit/action-kit/go/action_kit_api/v2"
+	"github.com/steadybit/action-kit/go/action_kit_sdk"
+	"github.com/steadybit/extension-jvm/extjvm/jvm"
+	"github.com/steadybit/extension-kit/extbuild"
+	"github.com/steadybit/extension-kit/extutil"
+)
+
+func NewJavaHttpClientStatus(facade jvm.JavaFacade) action_kit_sdk.Action[JavaagentActionState] {
+	return &javaagentAction{
+		pluginJar:      "attack-java-javaagent.jar",
+		description:    javaHttpClientStatusDescribe(),
+		configProvider: javaHttpClientStatusConfigProvider,
+		facade:         facade,
+	}
+}
+
+func javaHttpClientStatusDescribe() action_kit_api.ActionDescription {
+	return action_kit_api.ActionDescription{
+		Id:          ActionIDPrefix + ".java-httpclient-status-attack",
+		Label:       "Java Http Client Status",
+		Description: "Returns the given status code for a synchronous call of the JDK HttpClient, OkHttp or Apache HttpClient 5. The original call is not executed.",
+		Version:     extbuild.GetSemverVersionStringOrUnknown(),
+		Icon:        new(springHttpStatusIcon),
+		TargetSelection: new(action_kit_api.TargetSelection{
+			TargetType:         targetType,
+			TargetQuery:        new(`instance.type="java"`),
+			SelectionTemplates: new(targetSelectionTemplates),
+		}),
+		Technology: new("JVM"),
+
+		// To clarify the purpose of the action, you can set a kind.
+		//   Attack: Will cause harm to targets
+		//   Check: Will perform checks on the targets
+		//   LoadTest: Will perform load tests on the targets
+		//   Other
+		Kind: action_kit_api.Attack,
+
+		// How the action is controlled over time.
+		//   External: The agent takes care and calls stop then the time has passed. Requires a duration parameter. Use this when the duration is known in advance.
+		//   Internal: The action has to implement the status endpoint to signal when the action is done. Use this when the duration is not known in advance.
+		//   Instantaneous: The action is done immediately. Use this for actions that happen immediately, e.g. a reboot.
+		TimeControl: action_kit_api.TimeControlExternal,
+
+		// The parameters for the action
+		Parameters: []action_kit_api.ActionParameter{
+			{
+				Name:         "duration",
+				Label:        "Duration",
+				Description:  new("How long should the calls be attacked?"),
+				Type:         action_kit_api.ActionParameterTypeDuration,
+				DefaultValue: new("30s"),
+				Required:     new(true),
+			},
+			{
+				Name:        "httpMethods",
+				Label:       "Http Methods",
+				Description: new("Which HTTP methods should be attacked?"),
+				Type:        action_kit_api.ActionParameterTypeStringArray,
+				Required:    new(false),
+				Advanced:    new(true),
+				Options:     methodsOptions,
+			},
+			{
+				Name:         "hostAddress",
+				Label:        "Host Address",
+				Description:  new("Request to which host address should be attacked?"),
+				Type:         action_kit_api.ActionParameterTypeString,
+				DefaultValue: new("*"),
+				Required:     new(false),
+				Advanced:     new(true),
+				OptionsOnly:  new(false),
+				Options: new([]action_kit_api.ParameterOption{
+					action_kit_api.ExplicitParameterOption{
+						Label: "Any",
+						Value: "*",
+					},
+					action_kit_api.ParameterOptionsFromTargetAttribute{
+						Attribute: "java-instance.http-outgoing-calls",
+					},
+				}),
+			},
+			{
+				Name:         "urlPath",
+				Label:        "Path Pattern",
+				Description:  new("Which URL path should be attacked? Supports Ant-style path patterns (e.g. /api/**)."),
+				Type:         action_kit_api.ActionParameterTypeString,
+				DefaultValue: new("/**"),
+				Required:     new(false),
+				Advanced:     new(true),
+			},
+			{
+				Name:        "failureCauses",
+				Label:       "Failure Types",
+				Description: new("What HTTP client behavior should be simulated? If multiple are selected, one will be chosen randomly for every request."),
+				Type:        action_kit_api.ActionParameterTypeStringArray,
+				Required:    new(false),
+				Advanced:    new(true),
+				Options: new([]action_kit_api.ParameterOption{
+					action_kit_api.ExplicitParameterOption{
+						Label: "Protocol & Network Errors",
+						Value: "ERROR",
+					},
+					action_kit_api.ExplicitParameterOption{
+						Label: "Request Timeouts",
+						Value: "TIMEOUT",
+					},
+					action_kit_api.ExplicitParameterOption{
+						Label: "Response with 500 status code",
+						Value: "HTTP_500",
+					},
+					action_kit_api.ExplicitParameterOption{
+						Label: "Response with 502 status code",
+						Value: "HTTP_502",
+					},
+					action_kit_api.ExplicitParameterOption{
+						Label: "Response with 503 status code",
+						Value: "HTTP_503",
+					},
+					action_kit_api.ExplicitParameterOption{
+						Label: "Response with 504 status code",
+						Value: "HTTP_504",
+					},
+					action_kit_api.ExplicitParameterOption{
+						Label: "Response with a random 5XX status code",
+						Value: "HTTP_5XX",
+					},
+					action_kit_api.ExplicitParameterOption{
+						Label: "Response with 400 status code",
+						Value: "HTTP_400",
+					},
+					action_kit_api.ExplicitParameterOption{
+						Label: "Response with 403 status code",
+						Value: "HTTP_403",
+					},
+					action_kit_api.ExplicitParameterOption{
+						Label: "Response with 404 status code",
+						Value: "HTTP_404",
+					},
+					action_kit_api.ExplicitParameterOption{
+						Label: "Response with 429 status code",
+						Value: "HTTP_429",
+					},
+					action_kit_api.ExplicitParameterOption{
+						Label: "Response with a random 4XX status code",
+						Value: "HTTP_4XX",
+					},
+				}),
+			},
+			erroneousCallRate,
+		},
+		Stop: new(action_kit_api.MutatingEndpointReference{}),
+	}
+}
+
+func javaHttpClientStatusConfigProvider(request action_kit_api.PrepareActionRequestBody) (map[string]any, error) {
+	duration, err := extractDuration(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"attack-class":      "com.steadybit.attacks.javaagent.instrumentation.JavaHttpClientStatusInstrumentation",
+		"duration":          int(duration / time.Millisecond),
+		"erroneousCallRate": extutil.ToInt(request.Config["erroneousCallRate"]),
+		"httpMethods":       extutil.ToStringArray(request.Config["httpMethods"]),
+		"hostAddress":       extutil.ToString(request.Config["hostAddress"]),
+		"urlPath":           extutil.ToString(request.Config["urlPath"]),
+		"failureCauses":     extutil.ToStringArray(request.Config["failureCauses"]),
+	}, nil
+}
@@ -0,0 +1,84 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjvm
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/steadybit/action-kit/go/action_kit_api/v2"
+	"github.com/steadybit/extension-kit/extutil"
+)
+
+var (
+	argumentConditionAttribute = action_kit_api.ActionParameter{
+		Name:         "argumentCondition",
+		Label:        "Argument Condition",
+		Description:  new("Only attack calls where the selected argument matches the given value."),
+		Type:         action_kit_api.ActionParameterTypeString,
+		DefaultValue: new("*"),
+		Advanced:     new(true),
+		Options: new([]action_kit_api.ParameterOption{
+			action_kit_api.ExplicitParameterOption{
+				Label: "Any",
+				Value: "*",
+			},
+			action_kit_api.ExplicitParameterOption{
+				Label: "Equals",
+				Value: "EQUALS",
+			},
+			action_kit_api.ExplicitParameterOption{
+				Label: "Matches Regex",
+				Value: "REGEX",
+			},
+		}),
+	}
+	argumentIndexAttribute = action_kit_api.ActionParameter{
+		Name:         "argumentIndex",
+		Label:        "Argument Index",
+		Description:  new("Which argument should be matched? The first argument has the index 0."),
+		Type:         action_kit_api.ActionParameterTypeInteger,
+		DefaultValue: new("0"),
+		MinValue:     new(0),
+		Advanced:     new(true),
+	}
+	argumentPropertyPathAttribute = action_kit_api.ActionParameter{
+		Name:        "argumentPropertyPath",
+		Label:       "Argument Property Path",
+		Description: new("Optional property path navigated on the argument before matching, e.g. 'tenant.id'. No expressions are supported, each dot separated segment is resolved using a getter, method, field or map key."),
+		Type:        action_kit_api.ActionParameterTypeString,
+		Advanced:    new(true),
+	}
+	argumentValueAttribute = action_kit_api.ActionParameter{
+		Name:        "argumentValue",
+		Label:       "Argument Value",
+		Description: new("The value the string representation of the argument is compared to."),
+		Type:        action_kit_api.ActionParameterTypeString,
+		Advanced:    new(true),
+	}
+)
+
+func extractArgumentMatcher(request action_kit_api.PrepareActionRequestBody) (map[string]any, error) {
+	condition := extutil.ToString(request.Config["argumentCondition"])
+	if condition == "" || condition == "*" {
+		return nil, nil
+	}
+
+	if condition != "EQUALS" && condition != "REGEX" {
+		return nil, fmt.Errorf("unsupported argument condition '%s'", condition)
+	}
+
+	index := extutil.ToInt(request.Config["argumentIndex"])
+	if index < 0 {
+		return nil, errors.New("argument index must not be negative")
+	}
+
+	return map[string]any{
+		"index":        index,
+		"propertyPath": extutil.ToString(request.Config["argumentPropertyPath"]),
+		"condition":    condition,
+		"value":        extutil.ToString(request.Config["argumentValue"]),
+	}, nil
+}
@@ -91,6 +91,15 @@ func methodDelayDescribe() action_kit_api.ActionDescription {
 				Required:     new(true),
 				Advanced:     new(true),
 			},
+			delayDistributionAttribute,
+			delayMaxAttribute,
+			delayStddevAttribute,
+			delayP99Attribute,
+			delayRampTargetAttribute,
+			argumentConditionAttribute,
+			argumentIndexAttribute,
+			argumentPropertyPathAttribute,
+			argumentValueAttribute,
 			{
 				Name:         "validate",
 				Label:        "Validate class and method name",
@@ -114,11 +123,25 @@ func methodDelayConfigProvider(request action_kit_api.PrepareActionRequestBody)
 	className := extutil.ToString(request.Config["className"])
 	methodName := extutil.ToString(request.Config["methodName"])
 
-	return map[string]any{
+	config := map[string]any{
 		"attack-class": "com.steadybit.attacks.javaagent.instrumentation.JavaMethodDelayInstrumentation",
 		"duration":     int(duration / time.Millisecond),
 		"delay":        extutil.ToUInt64(request.Config["delay"]),
 		"delayJitter":  extutil.ToBool(request.Config["delayJitter"]),
 		"methods":      []string{fmt.Sprintf("%s#%s", className, methodName)},
-	}, nil
+	}
+
+	if delayDistribution, err := extractDelayDistribution(request); err != nil {
+		return nil, err
+	} else if delayDistribution != nil {
+		config["delayDistribution"] = delayDistribution
+	}
+
+	if argumentMatcher, err := extractArgumentMatcher(request); err != nil {
+		return nil, err
+	} else if argumentMatcher != nil {
+		config["argumentMatcher"] = argumentMatcher
+	}
+
+	return config, nil
 }
@@ -43,6 +43,54 @@ func Test_Java_Method_Delay_Prepare(t *testing.T) {
 				ConfigJson:            "{\"attack-class\":\"com.steadybit.attacks.javaagent.instrumentation.JavaMethodDelayInstrumentation\",\"delay\":500,\"delayJitter\":true,\"duration\":10000,\"methods\":[\"com.steadybit.demo.CustomerController#GetCustomers\"]}",
 			},
 		},
+		{
+			name: "Should return config with argument matcher",
+			requestBody: action_kit_api.PrepareActionRequestBody{
+				Config: map[string]any{
+					"action":               "prepare",
+					"className":            "com.steadybit.demo.CustomerController",
+					"methodName":           "GetCustomers",
+					"duration":             "10000",
+					"delay":                "500",
+					"delayJitter":          "false",
+					"validate":             "true",
+					"argumentCondition":    "REGEX",
+					"argumentIndex":        "1",
+					"argumentPropertyPath": "tenant.id",
+					"argumentValue":        "acme-.*",
+				},
+				ExecutionId: uuid.New(),
+				Target:      new(fake.getTarget()),
+			},
+
+			wantedState: &JavaagentActionState{
+				ValidateAdviceApplied: true,
+				ConfigJson:            "{\"argumentMatcher\":{\"condition\":\"REGEX\",\"index\":1,\"propertyPath\":\"tenant.id\",\"value\":\"acme-.*\"},\"attack-class\":\"com.steadybit.attacks.javaagent.instrumentation.JavaMethodDelayInstrumentation\",\"delay\":500,\"delayJitter\":false,\"duration\":10000,\"methods\":[\"com.steadybit.demo.CustomerController#GetCustomers\"]}",
+			},
+		},
+		{
+			name: "Should return config with uniform delay distribution",
+			requestBody: action_kit_api.PrepareActionRequestBody{
+				Config: map[string]any{
+					"action":            "prepare",
+					"className":         "com.steadybit.demo.CustomerController",
+					"methodName":        "GetCustomers",
+					"duration":          "10000",
+					"delay":             "200",
+					"delayJitter":       "false",
+					"delayDistribution": "UNIFORM",
+					"delayMax":          "800",
+					"validate":          "true",
+				},
+				ExecutionId: uuid.New(),
+				Target:      new(fake.getTarget()),
+			},
+
+			wantedState: &JavaagentActionState{
+				ValidateAdviceApplied: true,
+				ConfigJson:            "{\"attack-class\":\"com.steadybit.attacks.javaagent.instrumentation.JavaMethodDelayInstrumentation\",\"delay\":200,\"delayDistribution\":{\"max\":800,\"min\":200,\"type\":\"UNIFORM\"},\"delayJitter\":false,\"duration\":10000,\"methods\":[\"com.steadybit.demo.CustomerController#GetCustomers\"]}",
+			},
+		},
 	}
 	action := NewJavaMethodDelay(facade)
 	for _, tt := range tests {
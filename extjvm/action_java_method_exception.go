@@ -21,6 +21,7 @@ func NewJavaMethodException(facade jvm.JavaFacade) action_kit_sdk.Action[Javaage
 		description:    methodExceptionDescribe(),
 		configProvider: methodExceptionConfigProvider,
 		facade:         facade,
+		prepareCheck:   checkExceptionClassLoaded,
 	}
 }
 
@@ -75,6 +76,12 @@ func methodExceptionDescribe() action_kit_api.ActionDescription {
 				DefaultValue: new("30s"),
 				Required:     new(true),
 			},
+			exceptionClassAttribute,
+			exceptionMessageAttribute,
+			argumentConditionAttribute,
+			argumentIndexAttribute,
+			argumentPropertyPathAttribute,
+			argumentValueAttribute,
 			{
 				Name:         "validate",
 				Label:        "Validate class and method name",
@@ -98,10 +105,20 @@ func methodExceptionConfigProvider(request action_kit_api.PrepareActionRequestBo
 	className := extutil.ToString(request.Config["className"])
 	methodName := extutil.ToString(request.Config["methodName"])
 
-	return map[string]any{
+	config := map[string]any{
 		"attack-class":      "com.steadybit.attacks.javaagent.instrumentation.JavaMethodExceptionInstrumentation",
 		"duration":          int(duration / time.Millisecond),
 		"erroneousCallRate": extutil.ToInt(request.Config["erroneousCallRate"]),
 		"methods":           []string{fmt.Sprintf("%s#%s", className, methodName)},
-	}, nil
+	}
+
+	extractException(request, config)
+
+	if argumentMatcher, err := extractArgumentMatcher(request); err != nil {
+		return nil, err
+	} else if argumentMatcher != nil {
+		config["argumentMatcher"] = argumentMatcher
+	}
+
+	return config, nil
 }
@@ -5,12 +5,15 @@ import (
 	"github.com/google/uuid"
 	"github.com/steadybit/action-kit/go/action_kit_api/v2"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"testing"
 )
 
 func Test_Java_Method_Exception_Prepare(t *testing.T) {
 	facade := &mockJavaFacade{}
+	facade.On("HasClassLoaded", mock.Anything, "java.net.SocketTimeoutException").Return(true)
+	facade.On("HasClassLoaded", mock.Anything, "com.example.MissingException").Return(false)
 	fake, err := facade.startFakeJvm()
 	require.NoError(t, err)
 	defer func(fake *FakeJvm) {
@@ -21,6 +24,7 @@ func Test_Java_Method_Exception_Prepare(t *testing.T) {
 		name        string
 		requestBody action_kit_api.PrepareActionRequestBody
 		wantedState *JavaagentActionState
+		wantedWarn  string
 	}{
 		{
 			name: "Should return config",
@@ -42,6 +46,72 @@ func Test_Java_Method_Exception_Prepare(t *testing.T) {
 				ConfigJson:            "{\"attack-class\":\"com.steadybit.attacks.javaagent.instrumentation.JavaMethodExceptionInstrumentation\",\"duration\":10000,\"erroneousCallRate\":75,\"methods\":[\"com.steadybit.demo.CustomerController#GetCustomers\"]}",
 			},
 		},
+		{
+			name: "Should return config with argument matcher",
+			requestBody: action_kit_api.PrepareActionRequestBody{
+				Config: map[string]any{
+					"action":            "prepare",
+					"className":         "com.steadybit.demo.CustomerController",
+					"methodName":        "GetCustomers",
+					"duration":          "10000",
+					"erroneousCallRate": 100,
+					"validate":          "false",
+					"argumentCondition": "EQUALS",
+					"argumentValue":     "tenant-a",
+				},
+				ExecutionId: uuid.New(),
+				Target:      new(fake.getTarget()),
+			},
+
+			wantedState: &JavaagentActionState{
+				ValidateAdviceApplied: false,
+				ConfigJson:            "{\"argumentMatcher\":{\"condition\":\"EQUALS\",\"index\":0,\"propertyPath\":\"\",\"value\":\"tenant-a\"},\"attack-class\":\"com.steadybit.attacks.javaagent.instrumentation.JavaMethodExceptionInstrumentation\",\"duration\":10000,\"erroneousCallRate\":100,\"methods\":[\"com.steadybit.demo.CustomerController#GetCustomers\"]}",
+			},
+		},
+		{
+			name: "Should return config with exception class",
+			requestBody: action_kit_api.PrepareActionRequestBody{
+				Config: map[string]any{
+					"action":            "prepare",
+					"className":         "com.steadybit.demo.CustomerController",
+					"methodName":        "GetCustomers",
+					"duration":          "10000",
+					"erroneousCallRate": 100,
+					"validate":          "true",
+					"exceptionClass":    "java.net.SocketTimeoutException",
+					"exceptionMessage":  "Read timed out",
+				},
+				ExecutionId: uuid.New(),
+				Target:      new(fake.getTarget()),
+			},
+
+			wantedState: &JavaagentActionState{
+				ValidateAdviceApplied: true,
+				ConfigJson:            "{\"attack-class\":\"com.steadybit.attacks.javaagent.instrumentation.JavaMethodExceptionInstrumentation\",\"duration\":10000,\"erroneousCallRate\":100,\"exceptionClass\":\"java.net.SocketTimeoutException\",\"exceptionMessage\":\"Read timed out\",\"methods\":[\"com.steadybit.demo.CustomerController#GetCustomers\"]}",
+			},
+		},
+		{
+			name: "Should warn if exception class is not loaded",
+			requestBody: action_kit_api.PrepareActionRequestBody{
+				Config: map[string]any{
+					"action":            "prepare",
+					"className":         "com.steadybit.demo.CustomerController",
+					"methodName":        "GetCustomers",
+					"duration":          "10000",
+					"erroneousCallRate": 100,
+					"validate":          "true",
+					"exceptionClass":    "com.example.MissingException",
+				},
+				ExecutionId: uuid.New(),
+				Target:      new(fake.getTarget()),
+			},
+
+			wantedState: &JavaagentActionState{
+				ValidateAdviceApplied: true,
+				ConfigJson:            "{\"attack-class\":\"com.steadybit.attacks.javaagent.instrumentation.JavaMethodExceptionInstrumentation\",\"duration\":10000,\"erroneousCallRate\":100,\"exceptionClass\":\"com.example.MissingException\",\"methods\":[\"com.steadybit.demo.CustomerController#GetCustomers\"]}",
+			},
+			wantedWarn: "Exception class com.example.MissingException is not loaded",
+		},
 	}
 	action := NewJavaMethodException(facade)
 	for _, tt := range tests {
@@ -51,8 +121,15 @@ func Test_Java_Method_Exception_Prepare(t *testing.T) {
 			request := tt.requestBody
 
 			//When
-			_, err := action.Prepare(context.Background(), &state, request)
+			result, err := action.Prepare(context.Background(), &state, request)
 			assert.NoError(t, err)
+			if tt.wantedWarn != "" {
+				require.NotNil(t, result)
+				require.Len(t, *result.Messages, 1)
+				assert.Contains(t, (*result.Messages)[0].Message, tt.wantedWarn)
+			} else {
+				assert.Nil(t, result)
+			}
 
 			//Then
 			if tt.wantedState != nil {
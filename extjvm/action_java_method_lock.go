@@ -0,0 +1,186 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjvm
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/steadybit/action-kit/go/action_kit_api/v2"
+	"github.com/steadybit/action-kit/go/action_kit_sdk"
+	"github.com/steadybit/extension-jvm/extjvm/jvm"
+	"github.com/steadybit/extension-kit/extbuild"
+	"github.com/steadybit/extension-kit/extutil"
+)
+
+const (
+	lockModeContention = "CONTENTION"
+	lockModeDeadlock   = "DEADLOCK"
+)
+
+func NewJavaMethodLock(facade jvm.JavaFacade) action_kit_sdk.Action[JavaagentActionState] {
+	return &javaagentAction{
+		pluginJar:      "attack-java-javaagent.jar",
+		description:    methodLockDescribe(),
+		configProvider: methodLockConfigProvider,
+		facade:         facade,
+	}
+}
+
+func methodLockDescribe() action_kit_api.ActionDescription {
+	return action_kit_api.ActionDescription{
+		Id:          ActionIDPrefix + ".java-method-lock-attack",
+		Label:       "Java Method Lock Contention",
+		Description: "Make calls of a public method acquire a shared lock and hold it, causing lock contention or a deadlock between two methods.",
+		Version:     extbuild.GetSemverVersionStringOrUnknown(),
+		Icon:        new(javaMethodLockIcon),
+		TargetSelection: new(action_kit_api.TargetSelection{
+			TargetType:         targetType,
+			SelectionTemplates: new(targetSelectionTemplates),
+		}),
+		Technology: new("JVM"),
+
+		// To clarify the purpose of the action, you can set a kind.
+		//   Attack: Will cause harm to targets
+		//   Check: Will perform checks on the targets
+		//   LoadTest: Will perform load tests on the targets
+		//   Other
+		Kind: action_kit_api.Attack,
+
+		// How the action is controlled over time.
+		//   External: The agent takes care and calls stop then the time has passed. Requires a duration parameter. Use this when the duration is known in advance.
+		//   Internal: The action has to implement the status endpoint to signal when the action is done. Use this when the duration is not known in advance.
+		//   Instantaneous: The action is done immediately. Use this for actions that happen immediately, e.g. a reboot.
+		TimeControl: action_kit_api.TimeControlExternal,
+
+		// The parameters for the action
+		Parameters: []action_kit_api.ActionParameter{
+			{
+				Name:        "className",
+				Label:       "Class Name",
+				Description: new("Which Java class should be attacked?"),
+				Type:        action_kit_api.ActionParameterTypeString,
+				Required:    new(true),
+			},
+			{
+				Name:        "methodName",
+				Label:       "Method Name",
+				Description: new("Which public method should be attacked?"),
+				Type:        action_kit_api.ActionParameterTypeString,
+				Required:    new(true),
+			},
+			{
+				Name:         "lockMode",
+				Label:        "Mode",
+				Description:  new("Should calls contend for a single lock or should two methods acquire two locks in opposite order, causing a deadlock?"),
+				Type:         action_kit_api.ActionParameterTypeString,
+				DefaultValue: new(lockModeContention),
+				Required:     new(true),
+				Options: new([]action_kit_api.ParameterOption{
+					action_kit_api.ExplicitParameterOption{
+						Label: "Lock Contention",
+						Value: lockModeContention,
+					},
+					action_kit_api.ExplicitParameterOption{
+						Label: "Deadlock",
+						Value: lockModeDeadlock,
+					},
+				}),
+			},
+			{
+				Name:        "secondClassName",
+				Label:       "Second Class Name",
+				Description: new("Which Java class contains the second method of the deadlock? Only used for mode Deadlock."),
+				Type:        action_kit_api.ActionParameterTypeString,
+				Required:    new(false),
+			},
+			{
+				Name:        "secondMethodName",
+				Label:       "Second Method Name",
+				Description: new("Which public method should acquire the locks in opposite order? Only used for mode Deadlock."),
+				Type:        action_kit_api.ActionParameterTypeString,
+				Required:    new(false),
+			},
+			{
+				Name:         "holdTime",
+				Label:        "Hold Time",
+				Description:  new("How long should a call hold the lock?"),
+				Type:         action_kit_api.ActionParameterTypeDuration,
+				DefaultValue: new("1s"),
+				Required:     new(true),
+			},
+			{
+				Name:         "duration",
+				Label:        "Duration",
+				Description:  new("How long should the locks be acquired? Threads still waiting for a lock are released when the attack ends."),
+				Type:         action_kit_api.ActionParameterTypeDuration,
+				DefaultValue: new("30s"),
+				Required:     new(true),
+			},
+			argumentConditionAttribute,
+			argumentIndexAttribute,
+			argumentPropertyPathAttribute,
+			argumentValueAttribute,
+			{
+				Name:         "validate",
+				Label:        "Validate class and method name",
+				Description:  new("Should the action fail if the specified class and method could not be found?"),
+				Type:         action_kit_api.ActionParameterTypeBoolean,
+				DefaultValue: new("true"),
+				Required:     new(true),
+				Advanced:     new(true),
+			},
+		},
+		Stop: new(action_kit_api.MutatingEndpointReference{}),
+	}
+}
+
+func methodLockConfigProvider(request action_kit_api.PrepareActionRequestBody) (map[string]any, error) {
+	duration, err := extractDuration(request)
+	if err != nil {
+		return nil, err
+	}
+
+	lockMode := extutil.ToString(request.Config["lockMode"])
+	if lockMode == "" {
+		lockMode = lockModeContention
+	}
+
+	methods := []string{fmt.Sprintf("%s#%s", extutil.ToString(request.Config["className"]), extutil.ToString(request.Config["methodName"]))}
+	switch lockMode {
+	case lockModeContention:
+	case lockModeDeadlock:
+		secondClassName := strings.TrimSpace(extutil.ToString(request.Config["secondClassName"]))
+		secondMethodName := strings.TrimSpace(extutil.ToString(request.Config["secondMethodName"]))
+		if secondClassName == "" || secondMethodName == "" {
+			return nil, errors.New("secondClassName and secondMethodName are required for mode DEADLOCK")
+		}
+		second := fmt.Sprintf("%s#%s", secondClassName, secondMethodName)
+		if second == methods[0] {
+			return nil, errors.New("the second method must differ from the first one for mode DEADLOCK")
+		}
+		methods = append(methods, second)
+	default:
+		return nil, fmt.Errorf("unknown lock mode %s", lockMode)
+	}
+
+	config := map[string]any{
+		"attack-class": "com.steadybit.attacks.javaagent.instrumentation.JavaMethodLockInstrumentation",
+		"duration":     int(duration / time.Millisecond),
+		"holdTime":     extutil.ToUInt64(request.Config["holdTime"]),
+		"lockMode":     lockMode,
+		"methods":      methods,
+	}
+
+	if argumentMatcher, err := extractArgumentMatcher(request); err != nil {
+		return nil, err
+	} else if argumentMatcher != nil {
+		config["argumentMatcher"] = argumentMatcher
+	}
+
+	return config, nil
+}
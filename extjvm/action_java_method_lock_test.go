@@ -0,0 +1,108 @@
+package extjvm
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/steadybit/action-kit/go/action_kit_api/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func Test_Java_Method_Lock_Prepare(t *testing.T) {
+	facade := &mockJavaFacade{}
+	fake, err := facade.startFakeJvm()
+	require.NoError(t, err)
+	defer func(fake *FakeJvm) {
+		_ = fake.stop()
+	}(fake)
+
+	tests := []struct {
+		name        string
+		requestBody action_kit_api.PrepareActionRequestBody
+		wantedState *JavaagentActionState
+		wantedError string
+	}{
+		{
+			name: "Should return config for lock contention",
+			requestBody: action_kit_api.PrepareActionRequestBody{
+				Config: map[string]any{
+					"action":     "prepare",
+					"className":  "com.steadybit.demo.CustomerController",
+					"methodName": "GetCustomers",
+					"lockMode":   "CONTENTION",
+					"holdTime":   "1000",
+					"duration":   "10000",
+					"validate":   "true",
+				},
+				ExecutionId: uuid.New(),
+				Target:      new(fake.getTarget()),
+			},
+
+			wantedState: &JavaagentActionState{
+				ValidateAdviceApplied: true,
+				ConfigJson:            "{\"attack-class\":\"com.steadybit.attacks.javaagent.instrumentation.JavaMethodLockInstrumentation\",\"duration\":10000,\"holdTime\":1000,\"lockMode\":\"CONTENTION\",\"methods\":[\"com.steadybit.demo.CustomerController#GetCustomers\"]}",
+			},
+		},
+		{
+			name: "Should return config for deadlock",
+			requestBody: action_kit_api.PrepareActionRequestBody{
+				Config: map[string]any{
+					"action":           "prepare",
+					"className":        "com.steadybit.demo.CustomerController",
+					"methodName":       "GetCustomers",
+					"lockMode":         "DEADLOCK",
+					"secondClassName":  "com.steadybit.demo.OrderController",
+					"secondMethodName": "GetOrders",
+					"holdTime":         "500",
+					"duration":         "10000",
+					"validate":         "false",
+				},
+				ExecutionId: uuid.New(),
+				Target:      new(fake.getTarget()),
+			},
+
+			wantedState: &JavaagentActionState{
+				ConfigJson: "{\"attack-class\":\"com.steadybit.attacks.javaagent.instrumentation.JavaMethodLockInstrumentation\",\"duration\":10000,\"holdTime\":500,\"lockMode\":\"DEADLOCK\",\"methods\":[\"com.steadybit.demo.CustomerController#GetCustomers\",\"com.steadybit.demo.OrderController#GetOrders\"]}",
+			},
+		},
+		{
+			name: "Should fail for deadlock without second method",
+			requestBody: action_kit_api.PrepareActionRequestBody{
+				Config: map[string]any{
+					"action":     "prepare",
+					"className":  "com.steadybit.demo.CustomerController",
+					"methodName": "GetCustomers",
+					"lockMode":   "DEADLOCK",
+					"holdTime":   "500",
+					"duration":   "10000",
+				},
+				ExecutionId: uuid.New(),
+				Target:      new(fake.getTarget()),
+			},
+			wantedError: "secondClassName and secondMethodName are required for mode DEADLOCK",
+		},
+	}
+	action := NewJavaMethodLock(facade)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			//Given
+			state := action.NewEmptyState()
+			request := tt.requestBody
+
+			//When
+			_, err := action.Prepare(context.Background(), &state, request)
+			if tt.wantedError != "" {
+				assert.EqualError(t, err, tt.wantedError)
+				return
+			}
+			assert.NoError(t, err)
+
+			//Then
+			if tt.wantedState != nil {
+				assert.Equal(t, tt.wantedState.ConfigJson, state.ConfigJson)
+				assert.Equal(t, tt.wantedState.ValidateAdviceApplied, state.ValidateAdviceApplied)
+			}
+		})
+	}
+}
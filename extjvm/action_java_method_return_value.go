@@ -0,0 +1,284 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjvm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/steadybit/action-kit/go/action_kit_api/v2"
+	"github.com/steadybit/action-kit/go/action_kit_sdk"
+	"github.com/steadybit/extension-jvm/extjvm/jvm"
+	"github.com/steadybit/extension-kit/extbuild"
+	"github.com/steadybit/extension-kit/extutil"
+)
+
+func NewJavaMethodReturnValue(facade jvm.JavaFacade) action_kit_sdk.Action[JavaagentActionState] {
+	return &javaagentAction{
+		pluginJar:      "attack-java-javaagent.jar",
+		description:    methodReturnValueDescribe(),
+		configProvider: methodReturnValueConfigProvider,
+		prepareCheck:   checkReturnValueType,
+		facade:         facade,
+	}
+}
+
+func methodReturnValueDescribe() action_kit_api.ActionDescription {
+	return action_kit_api.ActionDescription{
+		Id:          ActionIDPrefix + ".java-method-return-value-attack",
+		Label:       "Java Method Return Value",
+		Description: "Manipulate the value returned by a Java method.",
+		Version:     extbuild.GetSemverVersionStringOrUnknown(),
+		Icon:        new(javaMethodReturnValueIcon),
+		TargetSelection: new(action_kit_api.TargetSelection{
+			TargetType:         targetType,
+			SelectionTemplates: new(targetSelectionTemplates),
+		}),
+		Technology: new("JVM"),
+
+		// To clarify the purpose of the action, you can set a kind.
+		//   Attack: Will cause harm to targets
+		//   Check: Will perform checks on the targets
+		//   LoadTest: Will perform load tests on the targets
+		//   Other
+		Kind: action_kit_api.Attack,
+
+		// How the action is controlled over time.
+		//   External: The agent takes care and calls stop then the time has passed. Requires a duration parameter. Use this when the duration is known in advance.
+		//   Internal: The action has to implement the status endpoint to signal when the action is done. Use this when the duration is not known in advance.
+		//   Instantaneous: The action is done immediately. Use this for actions that happen immediately, e.g. a reboot.
+		TimeControl: action_kit_api.TimeControlExternal,
+
+		// The parameters for the action
+		Parameters: []action_kit_api.ActionParameter{
+			{
+				Name:        "className",
+				Label:       "Class Name",
+				Description: new("Which Java class should be attacked?"),
+				Type:        action_kit_api.ActionParameterTypeString,
+				Required:    new(true),
+			},
+			{
+				Name:        "methodName",
+				Label:       "Method Name",
+				Description: new("Which method should be attacked? Methods returning void are not affected."),
+				Type:        action_kit_api.ActionParameterTypeString,
+				Required:    new(true),
+			},
+			{
+				Name:         "returnValueType",
+				Label:        "Return Value",
+				Description:  new("Which value should be returned? The experiment is rejected if the value is not compatible with the return type of the method. If the class is not loaded yet, the attack fails to start instead."),
+				Type:         action_kit_api.ActionParameterTypeString,
+				DefaultValue: new("NULL"),
+				Required:     new(true),
+				Options: new([]action_kit_api.ParameterOption{
+					action_kit_api.ExplicitParameterOption{
+						Label: "null",
+						Value: "NULL",
+					},
+					action_kit_api.ExplicitParameterOption{
+						Label: "Empty (Collection, Map, Optional, String, Array)",
+						Value: "EMPTY",
+					},
+					action_kit_api.ExplicitParameterOption{
+						Label: "Boolean",
+						Value: "BOOLEAN",
+					},
+					action_kit_api.ExplicitParameterOption{
+						Label: "Number",
+						Value: "NUMBER",
+					},
+					action_kit_api.ExplicitParameterOption{
+						Label: "String",
+						Value: "STRING",
+					},
+					action_kit_api.ExplicitParameterOption{
+						Label: "JSON",
+						Value: "JSON",
+					},
+				}),
+			},
+			{
+				Name:        "returnValue",
+				Label:       "Value",
+				Description: new("The value for the Boolean, Number, String and JSON return values. JSON is deserialized into the return type using Jackson, if available."),
+				Type:        action_kit_api.ActionParameterTypeString,
+			},
+			erroneousCallRate,
+			{
+				Name:         "duration",
+				Label:        "Duration",
+				Description:  new("How long should the return value be manipulated?"),
+				Type:         action_kit_api.ActionParameterTypeDuration,
+				DefaultValue: new("30s"),
+				Required:     new(true),
+			},
+			argumentConditionAttribute,
+			argumentIndexAttribute,
+			argumentPropertyPathAttribute,
+			argumentValueAttribute,
+			{
+				Name:         "validate",
+				Label:        "Validate class and method name",
+				Description:  new("Should the action fail if the specified class and method could not be found?"),
+				Type:         action_kit_api.ActionParameterTypeBoolean,
+				DefaultValue: new("true"),
+				Required:     new(true),
+				Advanced:     new(true),
+			},
+		},
+		Stop: new(action_kit_api.MutatingEndpointReference{}),
+	}
+}
+
+func methodReturnValueConfigProvider(request action_kit_api.PrepareActionRequestBody) (map[string]any, error) {
+	duration, err := extractDuration(request)
+	if err != nil {
+		return nil, err
+	}
+
+	returnValue, err := extractReturnValue(request)
+	if err != nil {
+		return nil, err
+	}
+
+	className := extutil.ToString(request.Config["className"])
+	methodName := extutil.ToString(request.Config["methodName"])
+
+	config := map[string]any{
+		"attack-class":      "com.steadybit.attacks.javaagent.instrumentation.JavaMethodReturnValueInstrumentation",
+		"duration":          int(duration / time.Millisecond),
+		"erroneousCallRate": extutil.ToInt(request.Config["erroneousCallRate"]),
+		"methods":           []string{fmt.Sprintf("%s#%s", className, methodName)},
+		"returnValue":       returnValue,
+	}
+
+	if argumentMatcher, err := extractArgumentMatcher(request); err != nil {
+		return nil, err
+	} else if argumentMatcher != nil {
+		config["argumentMatcher"] = argumentMatcher
+	}
+
+	return config, nil
+}
+
+func extractReturnValue(request action_kit_api.PrepareActionRequestBody) (map[string]any, error) {
+	valueType := extutil.ToString(request.Config["returnValueType"])
+	if valueType == "" {
+		valueType = "NULL"
+	}
+	value := extutil.ToString(request.Config["returnValue"])
+
+	switch valueType {
+	case "NULL", "EMPTY", "STRING":
+	case "BOOLEAN":
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("return value '%s' is not a boolean", value)
+		}
+		value = strconv.FormatBool(b)
+	case "NUMBER":
+		if f, err := strconv.ParseFloat(value, 64); err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, fmt.Errorf("return value '%s' is not a number", value)
+		}
+	case "JSON":
+		if !json.Valid([]byte(value)) {
+			return nil, fmt.Errorf("return value '%s' is not valid JSON", value)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported return value type '%s'", valueType)
+	}
+
+	return map[string]any{
+		"type":  valueType,
+		"value": value,
+	}, nil
+}
+
+// Types a returned value of the given kind is assignable to. The JDK types implemented by the created values are listed
+// explicitly, as only the names of the return types are known to the extension.
+var (
+	returnValueNumberTypes = []string{"int", "java.lang.Integer", "long", "java.lang.Long", "double", "java.lang.Double", "float",
+		"java.lang.Float", "short", "java.lang.Short", "byte", "java.lang.Byte", "java.math.BigInteger", "java.math.BigDecimal",
+		"java.lang.Number", "java.lang.Comparable", "java.io.Serializable", "java.lang.Object"}
+	returnValueBooleanTypes = []string{"boolean", "java.lang.Boolean", "java.lang.Comparable", "java.io.Serializable", "java.lang.constant.Constable", "java.lang.Object"}
+	returnValueStringTypes  = []string{"java.lang.String", "java.lang.CharSequence", "java.lang.Comparable", "java.io.Serializable",
+		"java.lang.constant.Constable", "java.lang.constant.ConstantDesc", "java.lang.Object"}
+	returnValueEmptyTypes = append([]string{"java.util.ArrayList", "java.util.AbstractList", "java.util.List", "java.util.RandomAccess",
+		"java.util.SequencedCollection", "java.util.HashSet", "java.util.AbstractSet", "java.util.Set", "java.util.AbstractCollection",
+		"java.util.Collection", "java.lang.Iterable", "java.util.HashMap", "java.util.AbstractMap", "java.util.Map", "java.lang.Cloneable",
+		"java.util.Optional", "java.util.stream.Stream"}, returnValueStringTypes...)
+	primitiveTypes = []string{"boolean", "byte", "char", "short", "int", "long", "float", "double"}
+)
+
+// checkReturnValueType rejects return values that are not compatible with any return type of the attacked method. The
+// check is skipped if the class is not loaded yet, the attack fails to start in that case.
+func checkReturnValueType(_ context.Context, facade jvm.JavaFacade, javaVm jvm.JavaVm, config map[string]any) ([]action_kit_api.Message, error) {
+	returnValue, _ := config["returnValue"].(map[string]any)
+	valueType, _ := returnValue["type"].(string)
+	methods, _ := config["methods"].([]string)
+
+	for _, method := range methods {
+		returnTypes, err := readMethodReturnTypes(facade, javaVm, method)
+		if err != nil {
+			return []action_kit_api.Message{{
+				Level:   extutil.Ptr(action_kit_api.Warn),
+				Message: fmt.Sprintf("Could not read the return type of %s in JVM with PID %d: %s", method, javaVm.Pid(), err),
+			}}, nil
+		}
+		if len(returnTypes) > 0 && !slices.ContainsFunc(returnTypes, func(returnType string) bool {
+			return isReturnValueCompatible(valueType, returnType)
+		}) {
+			return nil, fmt.Errorf("return value %s is not compatible with the return type %s of %s", valueType, strings.Join(returnTypes, ", "), method)
+		}
+	}
+	return nil, nil
+}
+
+func readMethodReturnTypes(facade jvm.JavaFacade, javaVm jvm.JavaVm, method string) ([]string, error) {
+	returnTypes, err := facade.SendCommandToAgentWithHandler(javaVm, "method-return-types", method, func(response io.Reader) (any, error) {
+		line, err := jvm.GetCleanSocketCommandResult(response)
+		if err != nil {
+			return nil, err
+		}
+		if line == "" {
+			return []string{}, nil
+		}
+		return strings.Split(line, ","), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return returnTypes.([]string), nil
+}
+
+// isReturnValueCompatible mirrors the compatibility check of the JavaMethodReturnValueInstrumentation.
+func isReturnValueCompatible(valueType string, returnType string) bool {
+	if returnType == "void" {
+		return false
+	}
+	switch valueType {
+	case "NULL":
+		return !slices.Contains(primitiveTypes, returnType)
+	case "EMPTY":
+		return strings.HasPrefix(returnType, "[") || slices.Contains(returnValueEmptyTypes, returnType)
+	case "BOOLEAN":
+		return slices.Contains(returnValueBooleanTypes, returnType)
+	case "NUMBER":
+		return slices.Contains(returnValueNumberTypes, returnType)
+	case "STRING":
+		return slices.Contains(returnValueStringTypes, returnType)
+	default:
+		return true
+	}
+}
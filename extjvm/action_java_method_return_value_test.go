@@ -0,0 +1,207 @@
+package extjvm
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/steadybit/action-kit/go/action_kit_api/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"io"
+	"strings"
+	"testing"
+)
+
+func mockMethodReturnTypes(facade *mockJavaFacade, method string, returnTypes string) {
+	call := facade.On("SendCommandToAgentWithHandler", mock.Anything, "method-return-types", method, mock.Anything)
+	call.Run(func(args mock.Arguments) {
+		handler := args.Get(3).(func(response io.Reader) (any, error))
+		result, err := handler(strings.NewReader(returnTypes + "\n"))
+		call.ReturnArguments = mock.Arguments{result, err}
+	})
+}
+
+func Test_Java_Method_Return_Value_Prepare(t *testing.T) {
+	facade := &mockJavaFacade{}
+	mockMethodReturnTypes(facade, "com.steadybit.demo.CustomerService#isPremium", "boolean")
+	mockMethodReturnTypes(facade, "com.steadybit.demo.CustomerService#getCustomer", "com.steadybit.demo.Customer")
+	mockMethodReturnTypes(facade, "com.steadybit.demo.CustomerService#getName", "java.lang.String")
+	mockMethodReturnTypes(facade, "com.steadybit.demo.CustomerService#findAll", "java.util.List,[Lcom.steadybit.demo.Customer;")
+	mockMethodReturnTypes(facade, "com.steadybit.demo.NotLoadedService#isPremium", "")
+	fake, err := facade.startFakeJvm()
+	require.NoError(t, err)
+	defer func(fake *FakeJvm) {
+		_ = fake.stop()
+	}(fake)
+
+	tests := []struct {
+		name        string
+		requestBody action_kit_api.PrepareActionRequestBody
+		wantedState *JavaagentActionState
+		wantedError string
+	}{
+		{
+			name: "Should return config",
+			requestBody: action_kit_api.PrepareActionRequestBody{
+				Config: map[string]any{
+					"action":            "prepare",
+					"className":         "com.steadybit.demo.CustomerService",
+					"methodName":        "isPremium",
+					"returnValueType":   "BOOLEAN",
+					"returnValue":       "TRUE",
+					"duration":          "10000",
+					"erroneousCallRate": 50,
+					"validate":          "true",
+				},
+				ExecutionId: uuid.New(),
+				Target:      new(fake.getTarget()),
+			},
+
+			wantedState: &JavaagentActionState{
+				ValidateAdviceApplied: true,
+				ConfigJson:            "{\"attack-class\":\"com.steadybit.attacks.javaagent.instrumentation.JavaMethodReturnValueInstrumentation\",\"duration\":10000,\"erroneousCallRate\":50,\"methods\":[\"com.steadybit.demo.CustomerService#isPremium\"],\"returnValue\":{\"type\":\"BOOLEAN\",\"value\":\"true\"}}",
+			},
+		},
+		{
+			name: "Should return config with json value",
+			requestBody: action_kit_api.PrepareActionRequestBody{
+				Config: map[string]any{
+					"action":            "prepare",
+					"className":         "com.steadybit.demo.CustomerService",
+					"methodName":        "getCustomer",
+					"returnValueType":   "JSON",
+					"returnValue":       "{\"name\":\"chaos\"}",
+					"duration":          "10000",
+					"erroneousCallRate": 100,
+					"validate":          "false",
+				},
+				ExecutionId: uuid.New(),
+				Target:      new(fake.getTarget()),
+			},
+
+			wantedState: &JavaagentActionState{
+				ValidateAdviceApplied: false,
+				ConfigJson:            "{\"attack-class\":\"com.steadybit.attacks.javaagent.instrumentation.JavaMethodReturnValueInstrumentation\",\"duration\":10000,\"erroneousCallRate\":100,\"methods\":[\"com.steadybit.demo.CustomerService#getCustomer\"],\"returnValue\":{\"type\":\"JSON\",\"value\":\"{\\\"name\\\":\\\"chaos\\\"}\"}}",
+			},
+		},
+		{
+			name: "Should fail for invalid number",
+			requestBody: action_kit_api.PrepareActionRequestBody{
+				Config: map[string]any{
+					"action":            "prepare",
+					"className":         "com.steadybit.demo.CustomerService",
+					"methodName":        "count",
+					"returnValueType":   "NUMBER",
+					"returnValue":       "many",
+					"duration":          "10000",
+					"erroneousCallRate": 100,
+					"validate":          "false",
+				},
+				ExecutionId: uuid.New(),
+				Target:      new(fake.getTarget()),
+			},
+
+			wantedError: "return value 'many' is not a number",
+		},
+		{
+			name: "Should fail for incompatible return type",
+			requestBody: action_kit_api.PrepareActionRequestBody{
+				Config: map[string]any{
+					"action":            "prepare",
+					"className":         "com.steadybit.demo.CustomerService",
+					"methodName":        "getName",
+					"returnValueType":   "NUMBER",
+					"returnValue":       "42",
+					"duration":          "10000",
+					"erroneousCallRate": 100,
+					"validate":          "false",
+				},
+				ExecutionId: uuid.New(),
+				Target:      new(fake.getTarget()),
+			},
+
+			wantedError: "return value NUMBER is not compatible with the return type java.lang.String of com.steadybit.demo.CustomerService#getName",
+		},
+		{
+			name: "Should fail for primitive return type and null",
+			requestBody: action_kit_api.PrepareActionRequestBody{
+				Config: map[string]any{
+					"action":            "prepare",
+					"className":         "com.steadybit.demo.CustomerService",
+					"methodName":        "isPremium",
+					"returnValueType":   "NULL",
+					"duration":          "10000",
+					"erroneousCallRate": 100,
+					"validate":          "false",
+				},
+				ExecutionId: uuid.New(),
+				Target:      new(fake.getTarget()),
+			},
+
+			wantedError: "return value NULL is not compatible with the return type boolean",
+		},
+		{
+			name: "Should accept empty value for an overloaded method",
+			requestBody: action_kit_api.PrepareActionRequestBody{
+				Config: map[string]any{
+					"action":            "prepare",
+					"className":         "com.steadybit.demo.CustomerService",
+					"methodName":        "findAll",
+					"returnValueType":   "EMPTY",
+					"duration":          "10000",
+					"erroneousCallRate": 100,
+					"validate":          "false",
+				},
+				ExecutionId: uuid.New(),
+				Target:      new(fake.getTarget()),
+			},
+
+			wantedState: &JavaagentActionState{
+				ConfigJson: "{\"attack-class\":\"com.steadybit.attacks.javaagent.instrumentation.JavaMethodReturnValueInstrumentation\",\"duration\":10000,\"erroneousCallRate\":100,\"methods\":[\"com.steadybit.demo.CustomerService#findAll\"],\"returnValue\":{\"type\":\"EMPTY\",\"value\":\"\"}}",
+			},
+		},
+		{
+			name: "Should skip the check if the class is not loaded",
+			requestBody: action_kit_api.PrepareActionRequestBody{
+				Config: map[string]any{
+					"action":            "prepare",
+					"className":         "com.steadybit.demo.NotLoadedService",
+					"methodName":        "isPremium",
+					"returnValueType":   "STRING",
+					"returnValue":       "premium",
+					"duration":          "10000",
+					"erroneousCallRate": 100,
+					"validate":          "false",
+				},
+				ExecutionId: uuid.New(),
+				Target:      new(fake.getTarget()),
+			},
+
+			wantedState: &JavaagentActionState{
+				ConfigJson: "{\"attack-class\":\"com.steadybit.attacks.javaagent.instrumentation.JavaMethodReturnValueInstrumentation\",\"duration\":10000,\"erroneousCallRate\":100,\"methods\":[\"com.steadybit.demo.NotLoadedService#isPremium\"],\"returnValue\":{\"type\":\"STRING\",\"value\":\"premium\"}}",
+			},
+		},
+	}
+	action := NewJavaMethodReturnValue(facade)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			//Given
+			state := action.NewEmptyState()
+			request := tt.requestBody
+
+			//When
+			_, err := action.Prepare(context.Background(), &state, request)
+			if tt.wantedError != "" {
+				assert.ErrorContains(t, err, tt.wantedError)
+				return
+			}
+			assert.NoError(t, err)
+
+			//Then
+			if tt.wantedState != nil {
+				assert.Equal(t, tt.wantedState.ValidateAdviceApplied, state.ValidateAdviceApplied)
+				assert.Equal(t, tt.wantedState.ConfigJson, state.ConfigJson)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjvm
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/steadybit/action-kit/go/action_kit_api/v2"
+	"github.com/steadybit/action-kit/go/action_kit_sdk"
+	"github.com/steadybit/extension-jvm/extjvm/jvm"
+	"github.com/steadybit/extension-kit/extbuild"
+	"github.com/steadybit/extension-kit/extutil"
+)
+
+const (
+	threadPoolWebServer  = "web-server"
+	threadPoolCommonPool = "common-pool"
+)
+
+func NewJavaThreadPoolExhaustion(facade jvm.JavaFacade) action_kit_sdk.Action[JavaagentActionState] {
+	return &javaagentAction{
+		pluginJar:      "attack-java-javaagent.jar",
+		description:    threadPoolExhaustionDescribe(),
+		configProvider: threadPoolExhaustionConfigProvider,
+		facade:         facade,
+	}
+}
+
+func threadPoolExhaustionDescribe() action_kit_api.ActionDescription {
+	return action_kit_api.ActionDescription{
+		Id:          ActionIDPrefix + ".java-thread-pool-exhaustion-attack",
+		Label:       "Java Thread Pool Exhaustion",
+		Description: "Occupy the worker threads of a thread pool with blocking tasks.",
+		Version:     extbuild.GetSemverVersionStringOrUnknown(),
+		Icon:        new(javaThreadPoolExhaustionIcon),
+		TargetSelection: new(action_kit_api.TargetSelection{
+			TargetType:         targetType,
+			SelectionTemplates: new(targetSelectionTemplates),
+		}),
+		Technology: new("JVM"),
+
+		// To clarify the purpose of the action, you can set a kind.
+		//   Attack: Will cause harm to targets
+		//   Check: Will perform checks on the targets
+		//   LoadTest: Will perform load tests on the targets
+		//   Other
+		Kind: action_kit_api.Attack,
+
+		// How the action is controlled over time.
+		//   External: The agent takes care and calls stop then the time has passed. Requires a duration parameter. Use this when the duration is known in advance.
+		//   Internal: The action has to implement the status endpoint to signal when the action is done. Use this when the duration is not known in advance.
+		//   Instantaneous: The action is done immediately. Use this for actions that happen immediately, e.g. a reboot.
+		TimeControl: action_kit_api.TimeControlExternal,
+
+		// The parameters for the action
+		Parameters: []action_kit_api.ActionParameter{
+			{
+				Name:         "pool",
+				Label:        "Thread Pool",
+				Description:  new("Which thread pool should be exhausted? Either the request threads of the embedded web server (Tomcat, Jetty, Undertow), the ForkJoinPool.commonPool or the name of a Spring ThreadPoolTaskExecutor bean."),
+				Type:         action_kit_api.ActionParameterTypeString,
+				DefaultValue: new(threadPoolWebServer),
+				Required:     new(true),
+				OptionsOnly:  new(false),
+				Options: new([]action_kit_api.ParameterOption{
+					action_kit_api.ExplicitParameterOption{
+						Label: "Web Server Request Threads",
+						Value: threadPoolWebServer,
+					},
+					action_kit_api.ExplicitParameterOption{
+						Label: "ForkJoinPool.commonPool",
+						Value: threadPoolCommonPool,
+					},
+					action_kit_api.ParameterOptionsFromTargetAttribute{
+						Attribute: "spring-instance.thread-pool",
+					},
+				}),
+			},
+			{
+				Name:         "poolUtilization",
+				Label:        "Pool Utilization",
+				Description:  new("How many of the pool's worker threads should be occupied?"),
+				Type:         action_kit_api.ActionParameterTypePercentage,
+				DefaultValue: new("100"),
+				MinValue:     new(1),
+				MaxValue:     new(100),
+				Required:     new(true),
+			},
+			{
+				Name:         "duration",
+				Label:        "Duration",
+				Description:  new("How long should the worker threads be occupied?"),
+				Type:         action_kit_api.ActionParameterTypeDuration,
+				DefaultValue: new("30s"),
+				Required:     new(true),
+			},
+		},
+		Stop: new(action_kit_api.MutatingEndpointReference{}),
+	}
+}
+
+func threadPoolExhaustionConfigProvider(request action_kit_api.PrepareActionRequestBody) (map[string]any, error) {
+	duration, err := extractDuration(request)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := strings.TrimSpace(extutil.ToString(request.Config["pool"]))
+	if pool == "" {
+		return nil, errors.New("pool is required")
+	}
+
+	utilization := extutil.ToInt(request.Config["poolUtilization"])
+	if utilization <= 0 || utilization > 100 {
+		return nil, errors.New("poolUtilization must be between 1 and 100 percent")
+	}
+
+	return map[string]any{
+		"attack-class":    "com.steadybit.attacks.javaagent.stress.ThreadPoolExhaustionAttack",
+		"duration":        int(duration / time.Millisecond),
+		"pool":            pool,
+		"poolUtilization": utilization,
+	}, nil
+}
@@ -0,0 +1,38 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjvm
+
+import (
+	"slices"
+
+	"github.com/steadybit/action-kit/go/action_kit_api/v2"
+	"github.com/steadybit/extension-kit/extutil"
+)
+
+// dataSourceActionDescription derives the description of an action attacking a single JVM datasource from the given
+// JDBC action. The jdbc url is then taken from the target, the target query is optional.
+func dataSourceActionDescription(description action_kit_api.ActionDescription, id string, label string, targetQuery *string) action_kit_api.ActionDescription {
+	description.Id = id
+	description.Label = label
+	description.TargetSelection = new(action_kit_api.TargetSelection{
+		TargetType:         dataSourceTargetType,
+		TargetQuery:        targetQuery,
+		SelectionTemplates: new(targetSelectionTemplates),
+	})
+	description.Parameters = slices.DeleteFunc(slices.Clone(description.Parameters), func(parameter action_kit_api.ActionParameter) bool {
+		return parameter.Name == "jdbcUrl"
+	})
+	return description
+}
+
+func extractJdbcUrl(request action_kit_api.PrepareActionRequestBody) string {
+	if jdbcUrl := extutil.ToString(request.Config["jdbcUrl"]); jdbcUrl != "" {
+		return jdbcUrl
+	}
+	if request.Target != nil && len(request.Target.Attributes["datasource.jdbc-url"]) > 0 {
+		return request.Target.Attributes["datasource.jdbc-url"][0]
+	}
+	return "*"
+}
@@ -0,0 +1,123 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjvm
+
+import (
+	"errors"
+	"time"
+
+	"github.com/steadybit/action-kit/go/action_kit_api/v2"
+	"github.com/steadybit/action-kit/go/action_kit_sdk"
+	"github.com/steadybit/extension-jvm/extjvm/jvm"
+	"github.com/steadybit/extension-kit/extbuild"
+	"github.com/steadybit/extension-kit/extutil"
+)
+
+func NewJdbcConnectionPoolExhaustion(facade jvm.JavaFacade) action_kit_sdk.Action[JavaagentActionState] {
+	return &javaagentAction{
+		pluginJar:      "attack-java-javaagent.jar",
+		description:    jdbcConnectionPoolExhaustionDescribe(),
+		configProvider: jdbcConnectionPoolExhaustionConfigProvider,
+		facade:         facade,
+	}
+}
+
+func NewDataSourceConnectionPoolExhaustion(facade jvm.JavaFacade) action_kit_sdk.Action[JavaagentActionState] {
+	return &javaagentAction{
+		pluginJar:      "attack-java-javaagent.jar",
+		description:    dataSourceActionDescription(jdbcConnectionPoolExhaustionDescribe(), ActionIDPrefix+".jvm-datasource-connection-pool-exhaustion-attack", "Datasource Connection Pool Exhaustion", nil),
+		configProvider: jdbcConnectionPoolExhaustionConfigProvider,
+		facade:         facade,
+	}
+}
+
+func jdbcConnectionPoolExhaustionDescribe() action_kit_api.ActionDescription {
+	return action_kit_api.ActionDescription{
+		Id:          ActionIDPrefix + ".jdbc-connection-pool-exhaustion-attack",
+		Label:       "JDBC Connection Pool Exhaustion",
+		Description: "Borrow and hold connections of a JDBC connection pool (HikariCP, Tomcat JDBC, DBCP2).",
+		Version:     extbuild.GetSemverVersionStringOrUnknown(),
+		Icon:        new(jdbcConnectionPoolExhaustionIcon),
+		TargetSelection: new(action_kit_api.TargetSelection{
+			TargetType:         targetType,
+			TargetQuery:        new(`datasource.jdbc-url IS PRESENT`),
+			SelectionTemplates: new(targetSelectionTemplates),
+		}),
+		Technology: new("JVM"),
+
+		// To clarify the purpose of the action, you can set a kind.
+		//   Attack: Will cause harm to targets
+		//   Check: Will perform checks on the targets
+		//   LoadTest: Will perform load tests on the targets
+		//   Other
+		Kind: action_kit_api.Attack,
+
+		// How the action is controlled over time.
+		//   External: The agent takes care and calls stop then the time has passed. Requires a duration parameter. Use this when the duration is known in advance.
+		//   Internal: The action has to implement the status endpoint to signal when the action is done. Use this when the duration is not known in advance.
+		//   Instantaneous: The action is done immediately. Use this for actions that happen immediately, e.g. a reboot.
+		TimeControl: action_kit_api.TimeControlExternal,
+
+		// The parameters for the action
+		Parameters: []action_kit_api.ActionParameter{
+			{
+				Name:         "poolUtilization",
+				Label:        "Pool Utilization",
+				Description:  new("How many connections of the pool's maximum size should be held?"),
+				Type:         action_kit_api.ActionParameterTypePercentage,
+				DefaultValue: new("100"),
+				MinValue:     new(1),
+				MaxValue:     new(100),
+				Required:     new(true),
+			},
+			{
+				Name:         "duration",
+				Label:        "Duration",
+				Description:  new("How long should the connections be held?"),
+				Type:         action_kit_api.ActionParameterTypeDuration,
+				DefaultValue: new("30s"),
+				Required:     new(true),
+			},
+			{
+				Name:         "jdbcUrl",
+				Label:        "JDBC connection url",
+				Description:  new("Which JDBC connection pool should be attacked?"),
+				Type:         action_kit_api.ActionParameterTypeString,
+				DefaultValue: new("*"),
+				Required:     new(true),
+				Advanced:     new(true),
+				Options: new([]action_kit_api.ParameterOption{
+					action_kit_api.ExplicitParameterOption{
+						Label: "Any",
+						Value: "*",
+					},
+					action_kit_api.ParameterOptionsFromTargetAttribute{
+						Attribute: "datasource.jdbc-url",
+					},
+				}),
+			},
+		},
+		Stop: new(action_kit_api.MutatingEndpointReference{}),
+	}
+}
+
+func jdbcConnectionPoolExhaustionConfigProvider(request action_kit_api.PrepareActionRequestBody) (map[string]any, error) {
+	duration, err := extractDuration(request)
+	if err != nil {
+		return nil, err
+	}
+
+	utilization := extutil.ToInt(request.Config["poolUtilization"])
+	if utilization <= 0 || utilization > 100 {
+		return nil, errors.New("poolUtilization must be between 1 and 100 percent")
+	}
+
+	return map[string]any{
+		"attack-class":    "com.steadybit.attacks.javaagent.instrumentation.JdbcConnectionPoolExhaustionInstrumentation",
+		"duration":        int(duration / time.Millisecond),
+		"jdbc-url":        extractJdbcUrl(request),
+		"poolUtilization": utilization,
+	}, nil
+}
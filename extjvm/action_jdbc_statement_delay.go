@@ -0,0 +1,161 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjvm
+
+import (
+	"time"
+
+	"github.com/steadybit/action-kit/go/action_kit_api/v2"
+	"github.com/steadybit/action-kit/go/action_kit_sdk"
+	"github.com/steadybit/extension-jvm/extjvm/jvm"
+	"github.com/steadybit/extension-kit/extbuild"
+	"github.com/steadybit/extension-kit/extutil"
+)
+
+func NewJdbcStatementDelay(facade jvm.JavaFacade) action_kit_sdk.Action[JavaagentActionState] {
+	return &javaagentAction{
+		pluginJar:      "attack-java-javaagent.jar",
+		description:    jdbcStatementDelayDescribe(),
+		configProvider: jdbcStatementDelayConfigProvider,
+		facade:         facade,
+	}
+}
+
+func NewDataSourceStatementDelay(facade jvm.JavaFacade) action_kit_sdk.Action[JavaagentActionState] {
+	return &javaagentAction{
+		pluginJar:      "attack-java-javaagent.jar",
+		description:    dataSourceActionDescription(jdbcStatementDelayDescribe(), ActionIDPrefix+".jvm-datasource-statement-delay-attack", "Datasource Statement Delay", nil),
+		configProvider: jdbcStatementDelayConfigProvider,
+		facade:         facade,
+	}
+}
+
+func jdbcStatementDelayDescribe() action_kit_api.ActionDescription {
+	return action_kit_api.ActionDescription{
+		Id:          ActionIDPrefix + ".jdbc-statement-delay-attack",
+		Label:       "JDBC Statement Delay",
+		Description: "Delay the execution of JDBC statements (java.sql.Statement) by the given duration.",
+		Version:     extbuild.GetSemverVersionStringOrUnknown(),
+		Icon:        new(jdbcTemplateDelayIcon),
+		TargetSelection: new(action_kit_api.TargetSelection{
+			TargetType:         targetType,
+			TargetQuery:        new(`datasource.jdbc-url IS PRESENT`),
+			SelectionTemplates: new(targetSelectionTemplates),
+		}),
+		Technology: new("JVM"),
+
+		// To clarify the purpose of the action, you can set a kind.
+		//   Attack: Will cause harm to targets
+		//   Check: Will perform checks on the targets
+		//   LoadTest: Will perform load tests on the targets
+		//   Other
+		Kind: action_kit_api.Attack,
+
+		// How the action is controlled over time.
+		//   External: The agent takes care and calls stop then the time has passed. Requires a duration parameter. Use this when the duration is known in advance.
+		//   Internal: The action has to implement the status endpoint to signal when the action is done. Use this when the duration is not known in advance.
+		//   Instantaneous: The action is done immediately. Use this for actions that happen immediately, e.g. a reboot.
+		TimeControl: action_kit_api.TimeControlExternal,
+
+		// The parameters for the action
+		Parameters: []action_kit_api.ActionParameter{
+			{
+				Name:         "operations",
+				Label:        "Operation",
+				Description:  new("Which operation should be attacked?"),
+				Type:         action_kit_api.ActionParameterTypeString,
+				DefaultValue: new("*"),
+				Required:     new(true),
+				Options: new([]action_kit_api.ParameterOption{
+					action_kit_api.ExplicitParameterOption{
+						Label: "Any",
+						Value: "*",
+					},
+					action_kit_api.ExplicitParameterOption{
+						Label: "Reads",
+						Value: "r",
+					},
+					action_kit_api.ExplicitParameterOption{
+						Label: "Writes",
+						Value: "w",
+					},
+				}),
+			},
+			{
+				Name:         "duration",
+				Label:        "Duration",
+				Description:  new("How long should the statements be attacked?"),
+				Type:         action_kit_api.ActionParameterTypeDuration,
+				DefaultValue: new("30s"),
+				Required:     new(true),
+			},
+			{
+				Name:         "delay",
+				Label:        "Delay",
+				Description:  new("How long should the db access be delayed?"),
+				Type:         action_kit_api.ActionParameterTypeDuration,
+				DefaultValue: new("500ms"),
+				Required:     new(true),
+			},
+			{
+				Name:         "delayJitter",
+				Label:        "Jitter",
+				Description:  new("Add random +/-30% jitter to response delay?"),
+				Type:         action_kit_api.ActionParameterTypeBoolean,
+				DefaultValue: new("false"),
+				Required:     new(true),
+				Advanced:     new(true),
+			},
+			delayDistributionAttribute,
+			delayMaxAttribute,
+			delayStddevAttribute,
+			delayP99Attribute,
+			delayRampTargetAttribute,
+			{
+				Name:         "jdbcUrl",
+				Label:        "JDBC connection url",
+				Description:  new("Which JDBC connection should be attacked?"),
+				Type:         action_kit_api.ActionParameterTypeString,
+				DefaultValue: new("*"),
+				Required:     new(true),
+				Advanced:     new(true),
+				Options: new([]action_kit_api.ParameterOption{
+					action_kit_api.ExplicitParameterOption{
+						Label: "Any",
+						Value: "*",
+					},
+					action_kit_api.ParameterOptionsFromTargetAttribute{
+						Attribute: "datasource.jdbc-url",
+					},
+				}),
+			},
+		},
+		Stop: new(action_kit_api.MutatingEndpointReference{}),
+	}
+}
+
+func jdbcStatementDelayConfigProvider(request action_kit_api.PrepareActionRequestBody) (map[string]any, error) {
+	duration, err := extractDuration(request)
+	if err != nil {
+		return nil, err
+	}
+
+	config := map[string]any{
+		"attack-class": "com.steadybit.attacks.javaagent.instrumentation.JdbcStatementDelayInstrumentation",
+		"duration":     int(duration / time.Millisecond),
+		"delay":        extutil.ToUInt64(request.Config["delay"]),
+		"delayJitter":  extutil.ToBool(request.Config["delayJitter"]),
+		"operations":   extutil.ToString(request.Config["operations"]),
+		"jdbc-url":     extractJdbcUrl(request),
+	}
+
+	if delayDistribution, err := extractDelayDistribution(request); err != nil {
+		return nil, err
+	} else if delayDistribution != nil {
+		config["delayDistribution"] = delayDistribution
+	}
+
+	return config, nil
+}
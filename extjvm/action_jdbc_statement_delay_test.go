@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: MIT
+// SPDX-FileCopyrightText: 2026 Steadybit GmbH
+
+package extjvm
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/steadybit/action-kit/go/action_kit_api/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func Test_Jdbc_Statement_Delay_Prepare(t *testing.T) {
+	facade := &mockJavaFacade{}
+	fake, err := facade.startFakeJvm()
+	require.NoError(t, err)
+	defer func(fake *FakeJvm) {
+		_ = fake.stop()
+	}(fake)
+
+	tests := []struct {
+		name        string
+		requestBody action_kit_api.PrepareActionRequestBody
+		wantedState *JavaagentActionState
+	}{
+		{
+			name: "Should return config",
+			requestBody: action_kit_api.PrepareActionRequestBody{
+				Config: map[string]any{
+					"action":      "prepare",
+					"jdbcUrl":     "jdbc:mysql://localhost:3306/test",
+					"operations":  "w",
+					"duration":    "10000",
+					"delay":       "500",
+					"delayJitter": "true",
+				},
+				ExecutionId: uuid.New(),
+				Target:      new(fake.getTarget()),
+			},
+
+			wantedState: &JavaagentActionState{
+				ConfigJson: "{\"attack-class\":\"com.steadybit.attacks.javaagent.instrumentation.JdbcStatementDelayInstrumentation\",\"delay\":500,\"delayJitter\":true,\"duration\":10000,\"jdbc-url\":\"jdbc:mysql://localhost:3306/test\",\"operations\":\"w\"}",
+			},
+		},
+	}
+	action := NewJdbcStatementDelay(facade)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			//Given
+			state := action.NewEmptyState()
+			request := tt.requestBody
+
+			//When
+			_, err := action.Prepare(context.Background(), &state, request)
+			assert.NoError(t, err)
+
+			//Then
+			if tt.wantedState != nil {
+				assert.Equal(t, tt.wantedState.ConfigJson, state.ConfigJson)
+			}
+		})
+	}
+}
+
+func Test_DataSource_Statement_Delay_Prepare(t *testing.T) {
+	facade := &mockJavaFacade{}
+	fake, err := facade.startFakeJvm()
+	require.NoError(t, err)
+	defer func(fake *FakeJvm) {
+		_ = fake.stop()
+	}(fake)
+
+	target := fake.getTarget()
+	target.Attributes["datasource.jdbc-url"] = []string{"jdbc:postgresql://orders-db:5432/orders"}
+
+	action := NewDataSourceStatementDelay(facade)
+	assert.Equal(t, dataSourceTargetType, action.Describe().TargetSelection.TargetType)
+	for _, parameter := range action.Describe().Parameters {
+		assert.NotEqual(t, "jdbcUrl", parameter.Name)
+	}
+
+	state := action.NewEmptyState()
+	_, err = action.Prepare(context.Background(), &state, action_kit_api.PrepareActionRequestBody{
+		Config: map[string]any{
+			"action":      "prepare",
+			"operations":  "*",
+			"duration":    "10000",
+			"delay":       "500",
+			"delayJitter": "false",
+		},
+		ExecutionId: uuid.New(),
+		Target:      &target,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "{\"attack-class\":\"com.steadybit.attacks.javaagent.instrumentation.JdbcStatementDelayInstrumentation\",\"delay\":500,\"delayJitter\":false,\"duration\":10000,\"jdbc-url\":\"jdbc:postgresql://orders-db:5432/orders\",\"operations\":\"*\"}", state.ConfigJson)
+}
@@ -23,6 +23,15 @@ func NewJdbcTemplateDelay(facade jvm.JavaFacade) action_kit_sdk.Action[Javaagent
 	}
 }
 
+func NewDataSourceJdbcTemplateDelay(facade jvm.JavaFacade) action_kit_sdk.Action[JavaagentActionState] {
+	return &javaagentAction{
+		pluginJar:      "attack-java-javaagent.jar",
+		description:    dataSourceActionDescription(jdbcTemplateDelayDescribe(), ActionIDPrefix+".jvm-datasource-jdbc-template-delay-attack", "Datasource JDBC Template Delay", new(`spring-instance.name IS PRESENT`)),
+		configProvider: jdbcTemplateDelayConfigProvider,
+		facade:         facade,
+	}
+}
+
 func jdbcTemplateDelayDescribe() action_kit_api.ActionDescription {
 	return action_kit_api.ActionDescription{
 		Id:          ActionIDPrefix + ".spring-jdbctemplate-delay-attack",
@@ -99,6 +108,11 @@ func jdbcTemplateDelayDescribe() action_kit_api.ActionDescription {
 				Required:     new(true),
 				Advanced:     new(true),
 			},
+			delayDistributionAttribute,
+			delayMaxAttribute,
+			delayStddevAttribute,
+			delayP99Attribute,
+			delayRampTargetAttribute,
 			{
 				Name:         "jdbcUrl",
 				Label:        "JDBC connection url",
@@ -128,12 +142,20 @@ func jdbcTemplateDelayConfigProvider(request action_kit_api.PrepareActionRequest
 		return nil, err
 	}
 
-	return map[string]any{
+	config := map[string]any{
 		"attack-class": "com.steadybit.attacks.javaagent.instrumentation.SpringJdbcTemplateDelayInstrumentation",
 		"duration":     int(duration / time.Millisecond),
 		"delay":        extutil.ToUInt64(request.Config["delay"]),
 		"delayJitter":  extutil.ToBool(request.Config["delayJitter"]),
 		"operations":   extutil.ToString(request.Config["operations"]),
-		"jdbc-url":     extutil.ToString(request.Config["jdbcUrl"]),
-	}, nil
+		"jdbc-url":     extractJdbcUrl(request),
+	}
+
+	if delayDistribution, err := extractDelayDistribution(request); err != nil {
+		return nil, err
+	} else if delayDistribution != nil {
+		config["delayDistribution"] = delayDistribution
+	}
+
+	return config, nil
 }
@@ -63,3 +63,10 @@ func Test_JDBC_Template_Delay_Prepare(t *testing.T) {
 		})
 	}
 }
+
+func Test_DataSource_JDBC_Template_Delay_Describe(t *testing.T) {
+	description := NewDataSourceJdbcTemplateDelay(&mockJavaFacade{}).Describe()
+
+	assert.Equal(t, dataSourceTargetType, description.TargetSelection.TargetType)
+	assert.Equal(t, "spring-instance.name IS PRESENT", *description.TargetSelection.TargetQuery)
+}
@@ -23,6 +23,15 @@ func NewJdbcTemplateException(facade jvm.JavaFacade) action_kit_sdk.Action[Javaa
 	}
 }
 
+func NewDataSourceJdbcTemplateException(facade jvm.JavaFacade) action_kit_sdk.Action[JavaagentActionState] {
+	return &javaagentAction{
+		pluginJar:      "attack-java-javaagent.jar",
+		description:    dataSourceActionDescription(jdbcTemplateExceptionDescribe(), ActionIDPrefix+".jvm-datasource-jdbc-template-exception-attack", "Datasource JDBC Template Exception", new(`spring-instance.name IS PRESENT`)),
+		configProvider: jdbcTemplateExceptionConfigProvider,
+		facade:         facade,
+	}
+}
+
 func jdbcTemplateExceptionDescribe() action_kit_api.ActionDescription {
 	return action_kit_api.ActionDescription{
 		Id:          ActionIDPrefix + ".spring-jdbctemplate-exception-attack",
@@ -116,7 +125,7 @@ func jdbcTemplateExceptionConfigProvider(request action_kit_api.PrepareActionReq
 		"attack-class":      "com.steadybit.attacks.javaagent.instrumentation.SpringJdbcTemplateExceptionInstrumentation",
 		"duration":          int(duration / time.Millisecond),
 		"operations":        extutil.ToString(request.Config["operations"]),
-		"jdbc-url":          extutil.ToString(request.Config["jdbcUrl"]),
+		"jdbc-url":          extractJdbcUrl(request),
 		"erroneousCallRate": extutil.ToInt(request.Config["erroneousCallRate"]),
 	}, nil
 }
@@ -0,0 +1,30 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjvm
+
+import (
+	"github.com/steadybit/action-kit/go/action_kit_api/v2"
+)
+
+var (
+	kafkaTargetQuery    = `kafka.bootstrap-servers IS PRESENT`
+	kafkaTopicAttribute = action_kit_api.ActionParameter{
+		Name:         "topic",
+		Label:        "Topic",
+		Description:  new("Which topic should be attacked?"),
+		Type:         action_kit_api.ActionParameterTypeString,
+		DefaultValue: new("*"),
+		Required:     new(true),
+		Options: new([]action_kit_api.ParameterOption{
+			action_kit_api.ExplicitParameterOption{
+				Label: "Any",
+				Value: "*",
+			},
+			action_kit_api.ParameterOptionsFromTargetAttribute{
+				Attribute: "kafka.topics",
+			},
+		}),
+	}
+)
@@ -1,18 +1,34 @@
 package extjvm
 
 const (
-	targetType     = "com.steadybit.extension_jvm.jvm-instance"
-	ActionIDPrefix = "com.steadybit.extension_jvm"
-	targetIcon     = "data:image/svg+xml,%3Csvg%20width%3D%2224%22%20height%3D%2224%22%20viewBox%3D%220%200%2024%2024%22%20fill%3D%22none%22%20xmlns%3D%22http%3A%2F%2Fwww.w3.org%2F2000%2Fsvg%22%3E%0A%3Cpath%20d%3D%22M12.6001%2013.8462C12.6001%2013.2939%2013.0478%2012.8462%2013.6001%2012.8462H16.2001C16.7524%2012.8462%2017.2001%2013.2939%2017.2001%2013.8462C17.2001%2014.3984%2016.7524%2014.8462%2016.2001%2014.8462H13.6001C13.0478%2014.8462%2012.6001%2014.3984%2012.6001%2013.8462Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20d%3D%22M8.51581%2010.6865C8.13026%2010.291%207.49714%2010.283%207.10171%2010.6686C6.70627%2011.0541%206.69826%2011.6872%207.08381%2012.0827L8.80316%2013.8461L7.08381%2015.6096C6.69826%2016.005%206.70627%2016.6381%207.10171%2017.0237C7.49714%2017.4092%208.13026%2017.4012%208.51581%2017.0058L10.9158%2014.5442C11.2945%2014.1558%2011.2945%2013.5364%2010.9158%2013.148L8.51581%2010.6865Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M19.7176%203H4.292L4.28884%203.00001C3.67412%203.00195%203.09037%203.25348%202.66358%203.69122C2.23762%204.1281%202.00182%204.71487%202%205.32212V18.7692C2%2019.3528%202.22579%2019.9174%202.63547%2020.3376C3.04599%2020.7587%203.60824%2021%204.2%2021H19.708L19.7112%2021C20.3259%2020.9981%2020.9096%2020.7465%2021.3364%2020.3088C21.7624%2019.8719%2021.9982%2019.2851%2022%2018.6779L22%2018.6749L22%205.31856L22%205.31554C21.9982%204.71004%2021.7631%204.12495%2021.3383%203.68929C20.9127%203.25279%2020.3306%203.00195%2019.7176%203ZM20%206.69232V5.32037C19.9994%205.22761%2019.963%205.14368%2019.9063%205.08549C19.8504%205.02818%2019.7802%205.00048%2019.7122%205H4.29417C4.22453%205.00048%204.15266%205.02888%204.09558%205.08742C4.03762%205.14687%204.00055%205.23254%204%205.3271V6.69232H20ZM4%208.69232H20V18.6731C19.9994%2018.7676%2019.9623%2018.8532%2019.9044%2018.9126C19.8473%2018.9711%2019.7754%2018.9995%2019.7058%2019H4.2C4.15525%2019%204.10704%2018.982%204.06747%2018.9414C4.02706%2018.9%204%2018.8385%204%2018.7692V8.69232Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3C%2Fsvg%3E%0A"
+	targetType               = "com.steadybit.extension_jvm.jvm-instance"
+	dataSourceTargetType     = "com.steadybit.extension_jvm.jvm-datasource"
+	springEndpointTargetType = "com.steadybit.extension_jvm.spring-endpoint"
+	ActionIDPrefix           = "com.steadybit.extension_jvm"
+	targetIcon               = "data:image/svg+xml,%3Csvg%20width%3D%2224%22%20height%3D%2224%22%20viewBox%3D%220%200%2024%2024%22%20fill%3D%22none%22%20xmlns%3D%22http%3A%2F%2Fwww.w3.org%2F2000%2Fsvg%22%3E%0A%3Cpath%20d%3D%22M12.6001%2013.8462C12.6001%2013.2939%2013.0478%2012.8462%2013.6001%2012.8462H16.2001C16.7524%2012.8462%2017.2001%2013.2939%2017.2001%2013.8462C17.2001%2014.3984%2016.7524%2014.8462%2016.2001%2014.8462H13.6001C13.0478%2014.8462%2012.6001%2014.3984%2012.6001%2013.8462Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20d%3D%22M8.51581%2010.6865C8.13026%2010.291%207.49714%2010.283%207.10171%2010.6686C6.70627%2011.0541%206.69826%2011.6872%207.08381%2012.0827L8.80316%2013.8461L7.08381%2015.6096C6.69826%2016.005%206.70627%2016.6381%207.10171%2017.0237C7.49714%2017.4092%208.13026%2017.4012%208.51581%2017.0058L10.9158%2014.5442C11.2945%2014.1558%2011.2945%2013.5364%2010.9158%2013.148L8.51581%2010.6865Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M19.7176%203H4.292L4.28884%203.00001C3.67412%203.00195%203.09037%203.25348%202.66358%203.69122C2.23762%204.1281%202.00182%204.71487%202%205.32212V18.7692C2%2019.3528%202.22579%2019.9174%202.63547%2020.3376C3.04599%2020.7587%203.60824%2021%204.2%2021H19.708L19.7112%2021C20.3259%2020.9981%2020.9096%2020.7465%2021.3364%2020.3088C21.7624%2019.8719%2021.9982%2019.2851%2022%2018.6779L22%2018.6749L22%205.31856L22%205.31554C21.9982%204.71004%2021.7631%204.12495%2021.3383%203.68929C20.9127%203.25279%2020.3306%203.00195%2019.7176%203ZM20%206.69232V5.32037C19.9994%205.22761%2019.963%205.14368%2019.9063%205.08549C19.8504%205.02818%2019.7802%205.00048%2019.7122%205H4.29417C4.22453%205.00048%204.15266%205.02888%204.09558%205.08742C4.03762%205.14687%204.00055%205.23254%204%205.3271V6.69232H20ZM4%208.69232H20V18.6731C19.9994%2018.7676%2019.9623%2018.8532%2019.9044%2018.9126C19.8473%2018.9711%2019.7754%2018.9995%2019.7058%2019H4.2C4.15525%2019%204.10704%2018.982%204.06747%2018.9414C4.02706%2018.9%204%2018.8385%204%2018.7692V8.69232Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3C%2Fsvg%3E%0A"
 
 	category = "instance"
 
-	controllerDelayIcon       = "data:image/svg+xml,%3Csvg%20width%3D%2224%22%20height%3D%2224%22%20viewBox%3D%220%200%2024%2024%22%20fill%3D%22none%22%20xmlns%3D%22http%3A%2F%2Fwww.w3.org%2F2000%2Fsvg%22%3E%0A%3Cg%20clip-path%3D%22url%28%23clip0_1_36269%29%22%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M0.672193%200.662C1.09415%200.240044%201.66645%200.00299072%202.26318%200.00299072H20.2632C20.8599%200.00299072%2021.4322%200.240044%2021.8542%200.662C22.2761%201.08396%2022.5132%201.65625%2022.5132%202.25299V8.25299C22.5132%208.6672%2022.1774%209.00299%2021.7632%209.00299C21.349%209.00299%2021.0132%208.6672%2021.0132%208.25299V2.25299C21.0132%202.05408%2020.9342%201.86331%2020.7935%201.72266C20.6529%201.58201%2020.4621%201.50299%2020.2632%201.50299H2.26318C2.06427%201.50299%201.87351%201.58201%201.73285%201.72266C1.5922%201.86331%201.51318%202.05408%201.51318%202.25299V18.753C1.51318%2018.9519%201.5922%2019.1427%201.73285%2019.2833C1.87351%2019.424%202.06427%2019.503%202.26318%2019.503H8.26318C8.6774%2019.503%209.01318%2019.8388%209.01318%2020.253C9.01318%2020.6672%208.6774%2021.003%208.26318%2021.003H2.26318C1.66645%2021.003%201.09415%2020.7659%200.672193%2020.344C0.250237%2019.922%200.0131836%2019.3497%200.0131836%2018.753V2.25299C0.0131836%201.65625%200.250237%201.08396%200.672193%200.662Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M0.0131836%205.25299C0.0131836%204.83878%200.34897%204.50299%200.763184%204.50299H21.7632C22.1774%204.50299%2022.5132%204.83878%2022.5132%205.25299C22.5132%205.6672%2022.1774%206.00299%2021.7632%206.00299H0.763184C0.34897%206.00299%200.0131836%205.6672%200.0131836%205.25299Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M17.2602%2012.003C14.3607%2012.003%2012.0102%2014.3535%2012.0102%2017.253C12.0102%2020.1525%2014.3607%2022.503%2017.2602%2022.503C20.1597%2022.503%2022.5102%2020.1525%2022.5102%2017.253C22.5102%2014.3535%2020.1597%2012.003%2017.2602%2012.003ZM10.5102%2017.253C10.5102%2013.5251%2013.5323%2010.503%2017.2602%2010.503C20.9881%2010.503%2024.0102%2013.5251%2024.0102%2017.253C24.0102%2020.9809%2020.9881%2024.003%2017.2602%2024.003C13.5323%2024.003%2010.5102%2020.9809%2010.5102%2017.253Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M17.2602%2013.852C17.6744%2013.852%2018.0102%2014.1878%2018.0102%2014.602V16.503H19.9122C20.3264%2016.503%2020.6622%2016.8388%2020.6622%2017.253C20.6622%2017.6672%2020.3264%2018.003%2019.9122%2018.003H17.2602C16.846%2018.003%2016.5102%2017.6672%2016.5102%2017.253V14.602C16.5102%2014.1878%2016.846%2013.852%2017.2602%2013.852Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3C%2Fg%3E%0A%3Cdefs%3E%0A%3CclipPath%20id%3D%22clip0_1_36269%22%3E%0A%3Crect%20width%3D%2224%22%20height%3D%2224%22%20fill%3D%22white%22%2F%3E%0A%3C%2FclipPath%3E%0A%3C%2Fdefs%3E%0A%3C%2Fsvg%3E%0A"
-	controllerExceptionIcon   = "data:image/svg+xml,%3Csvg%20width%3D%2224%22%20height%3D%2224%22%20viewBox%3D%220%200%2024%2024%22%20fill%3D%22none%22%20xmlns%3D%22http%3A%2F%2Fwww.w3.org%2F2000%2Fsvg%22%3E%0A%3Cg%20clip-path%3D%22url%28%23clip0_1_36275%29%22%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M0.668775%200.662C1.09073%200.240044%201.66303%200.00299072%202.25977%200.00299072H20.2598C20.8565%200.00299072%2021.4288%200.240044%2021.8508%200.662C22.2727%201.08396%2022.5098%201.65625%2022.5098%202.25299V8.25299C22.5098%208.6672%2022.174%209.00299%2021.7598%209.00299C21.3456%209.00299%2021.0098%208.6672%2021.0098%208.25299V2.25299C21.0098%202.05408%2020.9307%201.86331%2020.7901%201.72266C20.6494%201.58201%2020.4587%201.50299%2020.2598%201.50299H2.25977C2.06085%201.50299%201.87009%201.58201%201.72944%201.72266C1.58878%201.86331%201.50977%202.05408%201.50977%202.25299V18.753C1.50977%2018.9519%201.58878%2019.1427%201.72944%2019.2833C1.87009%2019.424%202.06085%2019.503%202.25977%2019.503H8.25977C8.67398%2019.503%209.00977%2019.8388%209.00977%2020.253C9.00977%2020.6672%208.67398%2021.003%208.25977%2021.003H2.25977C1.66303%2021.003%201.09073%2020.7659%200.668775%2020.344C0.246819%2019.922%200.00976562%2019.3497%200.00976562%2018.753V2.25299C0.00976562%201.65625%200.246819%201.08396%200.668775%200.662Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M0.00976562%205.25299C0.00976562%204.83878%200.345552%204.50299%200.759766%204.50299H21.7598C22.174%204.50299%2022.5098%204.83878%2022.5098%205.25299C22.5098%205.6672%2022.174%206.00299%2021.7598%206.00299H0.759766C0.345552%206.00299%200.00976562%205.6672%200.00976562%205.25299Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M17.2598%2012.003C14.3603%2012.003%2012.0098%2014.3535%2012.0098%2017.253C12.0098%2020.1525%2014.3603%2022.503%2017.2598%2022.503C20.1593%2022.503%2022.5098%2020.1525%2022.5098%2017.253C22.5098%2014.3535%2020.1593%2012.003%2017.2598%2012.003ZM10.5098%2017.253C10.5098%2013.5251%2013.5318%2010.503%2017.2598%2010.503C20.9877%2010.503%2024.0098%2013.5251%2024.0098%2017.253C24.0098%2020.9809%2020.9877%2024.003%2017.2598%2024.003C13.5318%2024.003%2010.5098%2020.9809%2010.5098%2017.253Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M20.0401%2014.4727C20.333%2014.7656%2020.333%2015.2404%2020.0401%2015.5333L15.5401%2020.0333C15.2472%2020.3262%2014.7723%2020.3262%2014.4794%2020.0333C14.1865%2019.7404%2014.1865%2019.2656%2014.4794%2018.9727L18.9794%2014.4727C19.2723%2014.1798%2019.7472%2014.1798%2020.0401%2014.4727Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M14.4794%2014.4727C14.7723%2014.1798%2015.2472%2014.1798%2015.5401%2014.4727L20.0401%2018.9727C20.333%2019.2656%2020.333%2019.7404%2020.0401%2020.0333C19.7472%2020.3262%2019.2723%2020.3262%2018.9794%2020.0333L14.4794%2015.5333C14.1865%2015.2404%2014.1865%2014.7656%2014.4794%2014.4727Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3C%2Fg%3E%0A%3Cdefs%3E%0A%3CclipPath%20id%3D%22clip0_1_36275%22%3E%0A%3Crect%20width%3D%2224%22%20height%3D%2224%22%20fill%3D%22white%22%2F%3E%0A%3C%2FclipPath%3E%0A%3C%2Fdefs%3E%0A%3C%2Fsvg%3E%0A"
-	jdbcTemplateExceptionIcon = "data:image/svg+xml,%3Csvg%20width%3D%2224%22%20height%3D%2224%22%20viewBox%3D%220%200%2024%2024%22%20fill%3D%22none%22%20xmlns%3D%22http%3A%2F%2Fwww.w3.org%2F2000%2Fsvg%22%3E%0A%3Cg%20clip-path%3D%22url%28%23clip0_1_36294%29%22%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M2.14943%204.04445C1.67354%204.49448%201.5%204.90246%201.5%205.25101C1.5%205.59955%201.67354%206.00753%202.14943%206.45756C2.62689%206.90908%203.35803%207.35026%204.32368%207.73653C6.25081%208.5074%208.96451%209.00101%2012%209.00101C12.4142%209.00101%2012.75%209.33679%2012.75%209.75101C12.75%2010.1652%2012.4142%2010.501%2012%2010.501C8.8225%2010.501%205.91119%209.98711%203.76657%209.12924C2.69635%208.70113%201.77993%208.17263%201.11879%207.54742C0.456087%206.92073%200%206.14496%200%205.25101C0%204.35705%200.456087%203.58129%201.11879%202.95459C1.77993%202.32939%202.69635%201.80088%203.76657%201.37278C5.91119%200.514905%208.8225%200.00100708%2012%200.00100708C15.1775%200.00100708%2018.0888%200.514641%2020.2334%201.37239C21.3036%201.80043%2022.2201%202.32891%2022.8812%202.95419C23.544%203.58099%2024%204.35688%2024%205.25101C24%206.20743%2023.4789%207.02652%2022.7388%207.67651C21.9978%208.32736%2020.9716%208.87194%2019.775%209.30268C19.3853%209.44297%2018.9556%209.24077%2018.8153%208.85104C18.675%208.46131%2018.8772%208.03163%2019.267%207.89134C20.3594%207.49807%2021.1977%207.03366%2021.7489%206.5495C22.3011%206.0645%2022.5%205.62258%2022.5%205.25101C22.5%204.90214%2022.3264%204.49402%2021.8505%204.04401C21.3731%203.59248%2020.642%203.15133%2019.6764%202.76513C17.7492%201.99437%2015.0355%201.50101%2012%201.50101C8.96451%201.50101%206.25081%201.99461%204.32368%202.76549C3.35803%203.15176%202.62689%203.59294%202.14943%204.04445Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M23.25%204.50101C23.6642%204.50101%2024%204.83679%2024%205.25101V9.75101C24%2010.1652%2023.6642%2010.501%2023.25%2010.501C22.8358%2010.501%2022.5%2010.1652%2022.5%209.75101V5.25101C22.5%204.83679%2022.8358%204.50101%2023.25%204.50101Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M0.75%204.50101C1.16421%204.50101%201.5%204.83679%201.5%205.25101V11.251C1.5%2011.8022%201.95712%2012.514%203.22257%2013.2185C4.44336%2013.8981%206.21854%2014.4491%208.34237%2014.7504C8.75248%2014.8086%209.03776%2015.1883%208.97956%2015.5984C8.92136%2016.0085%208.54173%2016.2938%208.13163%2016.2356C5.89346%2015.918%203.92514%2015.3264%202.49293%2014.529C1.10538%2013.7566%200%2012.6568%200%2011.251V5.25101C0%204.83679%200.335786%204.50101%200.75%204.50101Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M0.75%2010.501C1.16421%2010.501%201.5%2010.8368%201.5%2011.251V17.251C1.5%2017.8358%202.01765%2018.5979%203.43374%2019.3319C4.79359%2020.0367%206.75734%2020.5861%209.0745%2020.8426C9.4862%2020.8881%209.78301%2021.2588%209.73745%2021.6705C9.69189%2022.0822%209.3212%2022.379%208.9095%2022.3335C6.47365%2022.0639%204.31641%2021.4788%202.74351%2020.6636C1.22685%2019.8776%200%2018.7342%200%2017.251V11.251C0%2010.8368%200.335786%2010.501%200.75%2010.501Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M17.25%2012.001C14.3505%2012.001%2012%2014.3515%2012%2017.251C12%2020.1505%2014.3505%2022.501%2017.25%2022.501C20.1495%2022.501%2022.5%2020.1505%2022.5%2017.251C22.5%2014.3515%2020.1495%2012.001%2017.25%2012.001ZM10.5%2017.251C10.5%2013.5231%2013.5221%2010.501%2017.25%2010.501C20.9779%2010.501%2024%2013.5231%2024%2017.251C24%2020.9789%2020.9779%2024.001%2017.25%2024.001C13.5221%2024.001%2010.5%2020.9789%2010.5%2017.251Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M20.0303%2014.4697C20.3232%2014.7626%2020.3232%2015.2374%2020.0303%2015.5303L15.5303%2020.0303C15.2374%2020.3232%2014.7626%2020.3232%2014.4697%2020.0303C14.1768%2019.7374%2014.1768%2019.2626%2014.4697%2018.9697L18.9697%2014.4697C19.2626%2014.1768%2019.7374%2014.1768%2020.0303%2014.4697Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M14.4697%2014.4697C14.7626%2014.1768%2015.2374%2014.1768%2015.5303%2014.4697L20.0303%2018.9697C20.3232%2019.2626%2020.3232%2019.7374%2020.0303%2020.0303C19.7374%2020.3232%2019.2626%2020.3232%2018.9697%2020.0303L14.4697%2015.5303C14.1768%2015.2374%2014.1768%2014.7626%2014.4697%2014.4697Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3C%2Fg%3E%0A%3Cdefs%3E%0A%3CclipPath%20id%3D%22clip0_1_36294%22%3E%0A%3Crect%20width%3D%2224%22%20height%3D%2224%22%20fill%3D%22white%22%2F%3E%0A%3C%2FclipPath%3E%0A%3C%2Fdefs%3E%0A%3C%2Fsvg%3E%0A"
-	jdbcTemplateDelayIcon     = "data:image/svg+xml,%3Csvg%20width%3D%2224%22%20height%3D%2224%22%20viewBox%3D%220%200%2024%2024%22%20fill%3D%22none%22%20xmlns%3D%22http%3A%2F%2Fwww.w3.org%2F2000%2Fsvg%22%3E%0A%3Cg%20clip-path%3D%22url%28%23clip0_1_36282%29%22%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M17.25%2012.001C14.3505%2012.001%2012%2014.3515%2012%2017.251C12%2020.1505%2014.3505%2022.501%2017.25%2022.501C20.1495%2022.501%2022.5%2020.1505%2022.5%2017.251C22.5%2014.3515%2020.1495%2012.001%2017.25%2012.001ZM10.5%2017.251C10.5%2013.5231%2013.5221%2010.501%2017.25%2010.501C20.9779%2010.501%2024%2013.5231%2024%2017.251C24%2020.9789%2020.9779%2024.001%2017.25%2024.001C13.5221%2024.001%2010.5%2020.9789%2010.5%2017.251Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M17.25%2013.849C17.6642%2013.849%2018%2014.1848%2018%2014.599V16.501H19.902C20.3162%2016.501%2020.652%2016.8368%2020.652%2017.251C20.652%2017.6652%2020.3162%2018.001%2019.902%2018.001H17.25C16.8358%2018.001%2016.5%2017.6652%2016.5%2017.251V14.599C16.5%2014.1848%2016.8358%2013.849%2017.25%2013.849Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M3.76661%201.37236C5.91124%200.51461%208.82254%200.000976562%2012%200.000976562C15.1775%200.000976562%2018.0888%200.51461%2020.2334%201.37236C21.3036%201.8004%2022.2201%202.32888%2022.8812%202.95416C23.544%203.58096%2024%204.35685%2024%205.25098C24%206.19566%2023.4927%207.00618%2022.7683%207.6516C22.0437%208.29713%2021.0401%208.83788%2019.8697%209.26795C19.4809%209.41082%2019.0499%209.21145%2018.907%208.82266C18.7642%208.43386%2018.9635%208.00286%2019.3523%207.86C20.4189%207.46808%2021.2348%207.00883%2021.7705%206.5316C22.3063%206.05428%2022.5%205.6193%2022.5%205.25098C22.5%204.9021%2022.3264%204.49399%2021.8505%204.04398C21.3731%203.59245%2020.642%203.1513%2019.6764%202.7651C17.7492%201.99434%2015.0355%201.50098%2012%201.50098C8.96446%201.50098%206.25076%201.99434%204.32364%202.7651C3.358%203.1513%202.62689%203.59245%202.14945%204.04398C1.67362%204.49399%201.5%204.9021%201.5%205.25098C1.5%205.59985%201.67362%206.00796%202.14945%206.45798C2.62689%206.90951%203.358%207.35065%204.32364%207.73686C6.25076%208.50761%208.96446%209.00098%2012%209.00098C12.4142%209.00098%2012.75%209.33676%2012.75%209.75098C12.75%2010.1652%2012.4142%2010.501%2012%2010.501C8.82254%2010.501%205.91124%209.98734%203.76661%209.1296C2.69638%208.70155%201.77992%208.17307%201.11877%207.54779C0.456008%206.92099%200%206.1451%200%205.25098C0%204.35685%200.456008%203.58096%201.11877%202.95416C1.77992%202.32888%202.69638%201.8004%203.76661%201.37236Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M0.75%2010.501C1.16421%2010.501%201.5%2010.8368%201.5%2011.251C1.5%2011.8103%201.97124%2012.534%203.27241%2013.2457C4.52623%2013.9315%206.34638%2014.4824%208.51676%2014.7736C8.92729%2014.8287%209.21543%2015.2062%209.16034%2015.6167C9.10524%2016.0273%208.72778%2016.3154%208.31724%2016.2603C6.03162%2015.9536%204.01827%2015.3634%202.55259%2014.5617C1.13426%2013.7859%200%2012.6756%200%2011.251C0%2010.8368%200.335786%2010.501%200.75%2010.501Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M0.75%204.50098C1.16421%204.50098%201.5%204.83676%201.5%205.25098V17.251C1.5%2017.8534%202.05053%2018.6413%203.54587%2019.3889C4.97873%2020.1052%207.04061%2020.6516%209.45601%2020.8813C9.86837%2020.9206%2010.1709%2021.2866%2010.1316%2021.699C10.0924%2022.1113%209.72634%2022.4138%209.31399%2022.3746C6.77739%2022.1333%204.52177%2021.5538%202.87513%2020.7306C1.29097%2019.9386%200%2018.7736%200%2017.251V5.25098C0%204.83676%200.335786%204.50098%200.75%204.50098Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M23.25%204.50098C23.6642%204.50098%2024%204.83676%2024%205.25098V10.543C24%2010.9572%2023.6642%2011.293%2023.25%2011.293C22.8358%2011.293%2022.5%2010.9572%2022.5%2010.543V5.25098C22.5%204.83676%2022.8358%204.50098%2023.25%204.50098Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M3.76661%201.37236C5.91124%200.51461%208.82254%200.000976562%2012%200.000976562C15.1775%200.000976562%2018.0888%200.51461%2020.2334%201.37236C21.3036%201.8004%2022.2201%202.32888%2022.8812%202.95416C23.544%203.58096%2024%204.35685%2024%205.25098C24%206.19566%2023.4927%207.00618%2022.7683%207.6516C22.0437%208.29713%2021.0401%208.83788%2019.8697%209.26795C19.4809%209.41082%2019.0499%209.21145%2018.907%208.82266C18.7642%208.43386%2018.9635%208.00286%2019.3523%207.86C20.4189%207.46808%2021.2348%207.00883%2021.7705%206.5316C22.3063%206.05428%2022.5%205.6193%2022.5%205.25098C22.5%204.9021%2022.3264%204.49399%2021.8505%204.04398C21.3731%203.59245%2020.642%203.1513%2019.6764%202.7651C17.7492%201.99434%2015.0355%201.50098%2012%201.50098C8.96446%201.50098%206.25076%201.99434%204.32364%202.7651C3.358%203.1513%202.62689%203.59245%202.14945%204.04398C1.67362%204.49399%201.5%204.9021%201.5%205.25098C1.5%205.59985%201.67362%206.00796%202.14945%206.45798C2.62689%206.90951%203.358%207.35065%204.32364%207.73686C6.25076%208.50761%208.96446%209.00098%2012%209.00098C12.4142%209.00098%2012.75%209.33676%2012.75%209.75098C12.75%2010.1652%2012.4142%2010.501%2012%2010.501C8.82254%2010.501%205.91124%209.98734%203.76661%209.1296C2.69638%208.70155%201.77992%208.17307%201.11877%207.54779C0.456008%206.92099%200%206.1451%200%205.25098C0%204.35685%200.456008%203.58096%201.11877%202.95416C1.77992%202.32888%202.69638%201.8004%203.76661%201.37236Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M0.75%2010.501C1.16421%2010.501%201.5%2010.8368%201.5%2011.251C1.5%2011.8103%201.97124%2012.534%203.27241%2013.2457C4.52623%2013.9315%206.34638%2014.4824%208.51676%2014.7736C8.92729%2014.8287%209.21543%2015.2062%209.16034%2015.6167C9.10524%2016.0273%208.72778%2016.3154%208.31724%2016.2603C6.03162%2015.9536%204.01827%2015.3634%202.55259%2014.5617C1.13426%2013.7859%200%2012.6756%200%2011.251C0%2010.8368%200.335786%2010.501%200.75%2010.501Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M0.75%204.50098C1.16421%204.50098%201.5%204.83676%201.5%205.25098V17.251C1.5%2017.8534%202.05053%2018.6413%203.54587%2019.3889C4.97873%2020.1052%207.04061%2020.6516%209.45601%2020.8813C9.86837%2020.9206%2010.1709%2021.2866%2010.1316%2021.699C10.0924%2022.1113%209.72634%2022.4138%209.31399%2022.3746C6.77739%2022.1333%204.52177%2021.5538%202.87513%2020.7306C1.29097%2019.9386%200%2018.7736%200%2017.251V5.25098C0%204.83676%200.335786%204.50098%200.75%204.50098Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M23.25%204.50098C23.6642%204.50098%2024%204.83676%2024%205.25098V10.543C24%2010.9572%2023.6642%2011.293%2023.25%2011.293C22.8358%2011.293%2022.5%2010.9572%2022.5%2010.543V5.25098C22.5%204.83676%2022.8358%204.50098%2023.25%204.50098Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3C%2Fg%3E%0A%3Cdefs%3E%0A%3CclipPath%20id%3D%22clip0_1_36282%22%3E%0A%3Crect%20width%3D%2224%22%20height%3D%2224%22%20fill%3D%22white%22%2F%3E%0A%3C%2FclipPath%3E%0A%3C%2Fdefs%3E%0A%3C%2Fsvg%3E%0A"
-	javaMethodExceptionIcon   = "data:image/svg+xml,%3Csvg%20width%3D%2224%22%20height%3D%2224%22%20viewBox%3D%220%200%2024%2024%22%20fill%3D%22none%22%20xmlns%3D%22http%3A%2F%2Fwww.w3.org%2F2000%2Fsvg%22%3E%0A%3Cg%20clip-path%3D%22url%28%23clip0_1_36343%29%22%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M12.476%2012.481C13.7419%2011.2152%2015.4588%2010.504%2017.249%2010.504C19.0392%2010.504%2020.7561%2011.2152%2022.022%2012.481C23.2878%2013.7469%2023.999%2015.4638%2023.999%2017.254C23.999%2018.1404%2023.8244%2019.0182%2023.4852%2019.8371C23.146%2020.6561%2022.6488%2021.4002%2022.022%2022.027C21.3952%2022.6538%2020.6511%2023.151%2019.8321%2023.4902C19.0132%2023.8294%2018.1354%2024.004%2017.249%2024.004C16.3626%2024.004%2015.4848%2023.8294%2014.6659%2023.4902C13.8469%2023.151%2013.1028%2022.6538%2012.476%2022.027C11.8492%2021.4002%2011.352%2020.6561%2011.0128%2019.8371C10.6736%2019.0182%2010.499%2018.1404%2010.499%2017.254C10.499%2015.4638%2011.2102%2013.7469%2012.476%2012.481ZM17.249%2012.004C15.8566%2012.004%2014.5212%2012.5571%2013.5367%2013.5417C12.5521%2014.5263%2011.999%2015.8616%2011.999%2017.254C11.999%2017.9434%2012.1348%2018.6261%2012.3986%2019.2631C12.6625%2019.9%2013.0492%2020.4788%2013.5367%2020.9663C14.0242%2021.4538%2014.6029%2021.8405%2015.2399%2022.1044C15.8769%2022.3682%2016.5596%2022.504%2017.249%2022.504C17.9384%2022.504%2018.6211%2022.3682%2019.2581%2022.1044C19.895%2021.8405%2020.4738%2021.4538%2020.9613%2020.9663C21.4488%2020.4788%2021.8355%2019.9%2022.0994%2019.2631C22.3632%2018.6261%2022.499%2017.9434%2022.499%2017.254C22.499%2015.8616%2021.9459%2014.5263%2020.9613%2013.5417C19.9767%2012.5571%2018.6414%2012.004%2017.249%2012.004ZM14.4687%2014.4727C14.7616%2014.1798%2015.2364%2014.1798%2015.5293%2014.4727L17.249%2016.1923L18.9687%2014.4727C19.2616%2014.1798%2019.7364%2014.1798%2020.0293%2014.4727C20.3222%2014.7656%2020.3222%2015.2404%2020.0293%2015.5333L18.3097%2017.253L20.0293%2018.9727C20.3222%2019.2656%2020.3222%2019.7404%2020.0293%2020.0333C19.7364%2020.3262%2019.2616%2020.3262%2018.9687%2020.0333L17.249%2018.3137L15.5293%2020.0333C15.2364%2020.3262%2014.7616%2020.3262%2014.4687%2020.0333C14.1758%2019.7404%2014.1758%2019.2656%2014.4687%2018.9727L16.1883%2017.253L14.4687%2015.5333C14.1758%2015.2404%2014.1758%2014.7656%2014.4687%2014.4727Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M2.24899%201.504C2.05008%201.504%201.85931%201.58302%201.71866%201.72367C1.57801%201.86432%201.49899%202.05509%201.49899%202.254V18.754C1.49899%2018.9529%201.57801%2019.1437%201.71866%2019.2843C1.85931%2019.425%202.05008%2019.504%202.24899%2019.504H8.24899C8.66321%2019.504%208.99899%2019.8398%208.99899%2020.254C8.99899%2020.6682%208.66321%2021.004%208.24899%2021.004H2.24899C1.65226%2021.004%201.07996%2020.7669%200.658003%2020.345C0.236045%2019.923%20-0.00100708%2019.3507%20-0.00100708%2018.754V2.254C-0.00100708%201.65726%200.236046%201.08496%200.658003%200.663008C1.07996%200.241051%201.65226%200.0039978%202.24899%200.0039978H12.878C13.4743%200.00412512%2014.0464%200.240971%2014.4682%200.662498L17.3402%203.53358C17.5491%203.74261%2017.7151%203.99101%2017.8281%204.26408C17.941%204.53705%2017.9991%204.82959%2017.999%205.125C17.999%205.12488%2017.999%205.12511%2017.999%205.125V8.254C17.999%208.66821%2017.6632%209.004%2017.249%209.004C16.8348%209.004%2016.499%208.66821%2016.499%208.254V5.125C16.499%205.02649%2016.4797%204.9286%2016.442%204.83757C16.4044%204.74667%2016.3493%204.66405%2016.2798%204.59442M16.2798%204.59442L13.4078%201.7235C13.4079%201.72352%2013.4078%201.72347%2013.4078%201.7235C13.2673%201.58307%2013.0767%201.50408%2012.878%201.504C12.878%201.504%2012.8779%201.504%2012.878%201.504H2.24899%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M3.74899%204.504C4.16321%204.504%204.49899%204.83978%204.49899%205.254V9.754C4.49899%2010.1682%204.16321%2010.504%203.74899%2010.504C3.33478%2010.504%202.99899%2010.1682%202.99899%209.754V5.254C2.99899%204.83978%203.33478%204.504%203.74899%204.504ZM5.99899%205.254C5.99899%204.83978%206.33478%204.504%206.74899%204.504H9.74899C10.1632%204.504%2010.499%204.83978%2010.499%205.254V9.754C10.499%2010.1682%2010.1632%2010.504%209.74899%2010.504H6.74899C6.33478%2010.504%205.99899%2010.1682%205.99899%209.754V5.254ZM7.49899%206.004V9.004H8.99899V6.004H7.49899ZM12.749%204.504C13.1632%204.504%2013.499%204.83978%2013.499%205.254V9.754C13.499%2010.1682%2013.1632%2010.504%2012.749%2010.504C12.3348%2010.504%2011.999%2010.1682%2011.999%209.754V5.254C11.999%204.83978%2012.3348%204.504%2012.749%204.504ZM2.99899%2012.754C2.99899%2012.3398%203.33478%2012.004%203.74899%2012.004H6.74899C7.16321%2012.004%207.49899%2012.3398%207.49899%2012.754V17.254C7.49899%2017.6682%207.16321%2018.004%206.74899%2018.004H3.74899C3.33478%2018.004%202.99899%2017.6682%202.99899%2017.254V12.754ZM4.49899%2013.504V16.504H5.99899V13.504H4.49899Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3C%2Fg%3E%0A%3Cdefs%3E%0A%3CclipPath%20id%3D%22clip0_1_36343%22%3E%0A%3Crect%20width%3D%2224%22%20height%3D%2224%22%20fill%3D%22white%22%2F%3E%0A%3C%2FclipPath%3E%0A%3C%2Fdefs%3E%0A%3C%2Fsvg%3E%0A"
-	javaMethodDelayIcon       = "data:image/svg+xml,%3Csvg%20width%3D%2224%22%20height%3D%2224%22%20viewBox%3D%220%200%2024%2024%22%20fill%3D%22none%22%20xmlns%3D%22http%3A%2F%2Fwww.w3.org%2F2000%2Fsvg%22%3E%0A%3Cg%20clip-path%3D%22url%28%23clip0_1_36338%29%22%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M13.1524%201.56098C13.0614%201.52331%2012.9638%201.50395%2012.8653%201.504L2.237%201.504C2.03809%201.504%201.84732%201.58302%201.70667%201.72367C1.56602%201.86432%201.487%202.05509%201.487%202.254V18.754C1.487%2018.9529%201.56602%2019.1437%201.70667%2019.2843C1.84732%2019.425%202.03809%2019.504%202.237%2019.504H8.237C8.65121%2019.504%208.987%2019.8398%208.987%2020.254C8.987%2020.6682%208.65121%2021.004%208.237%2021.004H2.237C1.64026%2021.004%201.06797%2020.7669%200.646009%2020.345C0.224052%2019.923%20-0.0130005%2019.3507%20-0.0130005%2018.754V2.254C-0.0130005%201.65726%200.224053%201.08496%200.646009%200.663008C1.06797%200.241051%201.64026%200.00399785%202.237%200.00399785L12.8647%200.00399791C13.1601%200.00390862%2013.4529%200.0619923%2013.7259%200.174936C13.9989%200.287904%2014.247%200.453542%2014.4561%200.662391L17.33%203.53334C17.5392%203.74225%2017.7051%203.99033%2017.8184%204.26341C17.9316%204.53649%2017.9899%204.82921%2017.99%205.12484V8.254C17.99%208.66821%2017.6542%209.004%2017.24%209.004C16.8258%209.004%2016.49%208.66821%2016.49%208.254V5.12516C16.49%205.02667%2016.4705%204.92899%2016.4328%204.83802C16.395%204.74699%2016.3397%204.6643%2016.27%204.59466L13.3959%201.7236C13.3263%201.65397%2013.2434%201.59864%2013.1524%201.56098Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M3.737%204.504C4.15121%204.504%204.487%204.83978%204.487%205.254V9.754C4.487%2010.1682%204.15121%2010.504%203.737%2010.504C3.32279%2010.504%202.987%2010.1682%202.987%209.754V5.254C2.987%204.83978%203.32279%204.504%203.737%204.504ZM5.987%205.254C5.987%204.83978%206.32279%204.504%206.737%204.504H9.737C10.1512%204.504%2010.487%204.83978%2010.487%205.254V9.754C10.487%2010.1682%2010.1512%2010.504%209.737%2010.504H6.737C6.32279%2010.504%205.987%2010.1682%205.987%209.754V5.254ZM7.487%206.004V9.004H8.987V6.004H7.487ZM12.737%204.504C13.1512%204.504%2013.487%204.83978%2013.487%205.254V9.754C13.487%2010.1682%2013.1512%2010.504%2012.737%2010.504C12.3228%2010.504%2011.987%2010.1682%2011.987%209.754V5.254C11.987%204.83978%2012.3228%204.504%2012.737%204.504ZM2.987%2012.754C2.987%2012.3398%203.32279%2012.004%203.737%2012.004H6.737C7.15121%2012.004%207.487%2012.3398%207.487%2012.754V17.254C7.487%2017.6682%207.15121%2018.004%206.737%2018.004H3.737C3.32279%2018.004%202.987%2017.6682%202.987%2017.254V12.754ZM4.487%2013.504V16.504H5.987V13.504H4.487Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M16.487%2012.004C13.5875%2012.004%2011.237%2014.3545%2011.237%2017.254C11.237%2020.1535%2013.5875%2022.504%2016.487%2022.504C19.3865%2022.504%2021.737%2020.1535%2021.737%2017.254C21.737%2014.3545%2019.3865%2012.004%2016.487%2012.004ZM9.737%2017.254C9.737%2013.5261%2012.7591%2010.504%2016.487%2010.504C20.2149%2010.504%2023.237%2013.5261%2023.237%2017.254C23.237%2020.9819%2020.2149%2024.004%2016.487%2024.004C12.7591%2024.004%209.737%2020.9819%209.737%2017.254Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M16.487%2013.852C16.9012%2013.852%2017.237%2014.1878%2017.237%2014.602V16.504H19.139C19.5532%2016.504%2019.889%2016.8398%2019.889%2017.254C19.889%2017.6682%2019.5532%2018.004%2019.139%2018.004H16.487C16.0728%2018.004%2015.737%2017.6682%2015.737%2017.254V14.602C15.737%2014.1878%2016.0728%2013.852%2016.487%2013.852Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3C%2Fg%3E%0A%3Cdefs%3E%0A%3CclipPath%20id%3D%22clip0_1_36338%22%3E%0A%3Crect%20width%3D%2224%22%20height%3D%2224%22%20fill%3D%22white%22%2F%3E%0A%3C%2FclipPath%3E%0A%3C%2Fdefs%3E%0A%3C%2Fsvg%3E%0A"
-	springHttpDelayIcon       = "data:image/svg+xml,%3Csvg%20width%3D%2224%22%20height%3D%2224%22%20viewBox%3D%220%200%2024%2024%22%20fill%3D%22none%22%20xmlns%3D%22http%3A%2F%2Fwww.w3.org%2F2000%2Fsvg%22%3E%0A%3Cg%20clip-path%3D%22url%28%23clip0_1_36448%29%22%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M17.25%2012C14.3505%2012%2012%2014.3505%2012%2017.25C12%2020.1495%2014.3505%2022.5%2017.25%2022.5C20.1495%2022.5%2022.5%2020.1495%2022.5%2017.25C22.5%2014.3505%2020.1495%2012%2017.25%2012ZM10.5%2017.25C10.5%2013.5221%2013.5221%2010.5%2017.25%2010.5C20.9779%2010.5%2024%2013.5221%2024%2017.25C24%2020.9779%2020.9779%2024%2017.25%2024C13.5221%2024%2010.5%2020.9779%2010.5%2017.25Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M20.0303%2014.4697C20.3232%2014.7626%2020.3232%2015.2374%2020.0303%2015.5303L15.5303%2020.0303C15.2374%2020.3232%2014.7625%2020.3232%2014.4697%2020.0303C14.1768%2019.7374%2014.1768%2019.2626%2014.4697%2018.9697L18.9697%2014.4697C19.2625%2014.1768%2019.7374%2014.1768%2020.0303%2014.4697Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M14.4697%2014.4697C14.7625%2014.1768%2015.2374%2014.1768%2015.5303%2014.4697L20.0303%2018.9697C20.3232%2019.2626%2020.3232%2019.7374%2020.0303%2020.0303C19.7374%2020.3232%2019.2625%2020.3232%2018.9697%2020.0303L14.4697%2015.5303C14.1768%2015.2374%2014.1768%2014.7626%2014.4697%2014.4697Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M15.3627%202.053C13.5088%201.42623%2011.5166%201.32999%209.61093%201.77513C7.70524%202.22028%205.96184%203.1891%204.57744%204.57231C3.19303%205.95552%202.22269%207.69806%201.77589%209.60337C1.32908%2011.5087%201.42359%2013.5009%202.04875%2015.3554C2.67392%2017.2099%203.80486%2018.8527%205.31399%2020.0987C6.82312%2021.3446%208.65039%2022.144%2010.5897%2022.4068C11.0001%2022.4624%2011.2878%2022.8402%2011.2322%2023.2507C11.1766%2023.6612%2010.7987%2023.9488%2010.3883%2023.8932C8.17199%2023.5929%206.0837%2022.6793%204.359%2021.2554C2.6343%2019.8315%201.34181%2017.9539%200.62735%2015.8346C-0.0871135%2013.7152%20-0.195124%2011.4384%200.315502%209.26091C0.826129%207.08344%201.93507%205.09198%203.51724%203.51119C5.0994%201.9304%207.09182%200.823187%209.26974%200.314453C11.4477%20-0.194281%2013.7244%20-0.0842913%2015.8431%200.632014C17.9619%201.34832%2019.8383%202.64244%2021.2607%204.36837C22.6831%206.09431%2023.5949%208.1834%2023.8933%2010.4C23.9485%2010.8105%2023.6605%2011.188%2023.25%2011.2433C22.8395%2011.2986%2022.4619%2011.0106%2022.4067%2010.6C22.1456%208.66054%2021.3478%206.83257%2020.1031%205.32236C18.8585%203.81214%2017.2166%202.67978%2015.3627%202.053Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M9.7112%200.459135C10.0535%200.69232%2010.142%201.15888%209.90884%201.50122C8.50723%203.55895%207.49998%207.44148%207.49998%2012C7.49998%2016.5586%208.50726%2020.442%209.90876%2022.4986C10.142%2022.8409%2010.0536%2023.3075%209.71133%2023.5408C9.36903%2023.774%208.90246%2023.6856%208.6692%2023.3433C7.0287%2020.936%205.99998%2016.7074%205.99998%2012C5.99998%207.29252%207.02873%203.06505%208.66912%200.656781C8.9023%200.314439%209.36886%200.22595%209.7112%200.459135Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M0.0249805%2011.25C0.0249805%2010.8358%200.360767%2010.5%200.774981%2010.5H10.5C10.9142%2010.5%2011.25%2010.8358%2011.25%2011.25C11.25%2011.6642%2010.9142%2012%2010.5%2012H0.774981C0.360767%2012%200.0249805%2011.6642%200.0249805%2011.25Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M2.24898%205.25C2.24898%204.83579%202.58477%204.5%202.99898%204.5H21C21.4142%204.5%2021.75%204.83579%2021.75%205.25C21.75%205.66421%2021.4142%206%2021%206H2.99898C2.58477%206%202.24898%205.66421%202.24898%205.25Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M1.29798%2017.25C1.29798%2016.8358%201.63377%2016.5%202.04798%2016.5H7.21398C7.62819%2016.5%207.96398%2016.8358%207.96398%2017.25C7.96398%2017.6642%207.62819%2018%207.21398%2018H2.04798C1.63377%2018%201.29798%2017.6642%201.29798%2017.25Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M14.3288%200.43368C14.6852%200.222607%2015.1452%200.340418%2015.3563%200.696818C16.7069%202.9774%2017.53%205.5314%2017.765%208.17149C17.8018%208.58407%2017.4971%208.94831%2017.0845%208.98504C16.6719%209.02178%2016.3077%208.71709%2016.2709%208.30451C16.0557%205.88741%2015.3022%203.54914%2014.0657%201.46118C13.8546%201.10478%2013.9724%200.644753%2014.3288%200.43368Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3C%2Fg%3E%0A%3Cdefs%3E%0A%3CclipPath%20id%3D%22clip0_1_36448%22%3E%0A%3Crect%20width%3D%2224%22%20height%3D%2224%22%20fill%3D%22white%22%2F%3E%0A%3C%2FclipPath%3E%0A%3C%2Fdefs%3E%0A%3C%2Fsvg%3E%0A"
-	springHttpStatusIcon      = "data:image/svg+xml,%3Csvg%20width%3D%2224%22%20height%3D%2224%22%20viewBox%3D%220%200%2024%2024%22%20fill%3D%22none%22%20xmlns%3D%22http%3A%2F%2Fwww.w3.org%2F2000%2Fsvg%22%3E%0A%3Cg%20clip-path%3D%22url%28%23clip0_1_36438%29%22%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M17.2498%2012C14.3503%2012%2011.9998%2014.3505%2011.9998%2017.25C11.9998%2020.1495%2014.3503%2022.5%2017.2498%2022.5C20.1493%2022.5%2022.4998%2020.1495%2022.4998%2017.25C22.4998%2014.3505%2020.1493%2012%2017.2498%2012ZM10.4998%2017.25C10.4998%2013.5221%2013.5219%2010.5%2017.2498%2010.5C20.9778%2010.5%2023.9998%2013.5221%2023.9998%2017.25C23.9998%2020.9779%2020.9778%2024%2017.2498%2024C13.5219%2024%2010.4998%2020.9779%2010.4998%2017.25Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M17.2498%2013.849C17.664%2013.849%2017.9998%2014.1848%2017.9998%2014.599V16.5H19.9018C20.316%2016.5%2020.6518%2016.8358%2020.6518%2017.25C20.6518%2017.6642%2020.316%2018%2019.9018%2018H17.2498C16.8356%2018%2016.4998%2017.6642%2016.4998%2017.25V14.599C16.4998%2014.1848%2016.8356%2013.849%2017.2498%2013.849Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M15.3672%202.05366C13.5131%201.4261%2011.5205%201.32925%209.61432%201.77404C7.70811%202.21884%205.96416%203.18757%204.57929%204.5709C3.19443%205.95424%202.22377%207.69711%201.77687%209.60283C1.32996%2011.5085%201.42461%2013.5012%202.05012%2015.356C2.67562%2017.2108%203.80709%2018.8538%205.31684%2020.0997C6.82658%2021.3455%208.65449%2022.1446%2010.5943%2022.4068C11.0048%2022.4622%2011.2925%2022.84%2011.2371%2023.2504C11.1816%2023.6609%2010.8039%2023.9487%2010.3934%2023.8932C8.17653%2023.5937%206.08751%2022.6804%204.36212%2021.2566C2.63672%2019.8328%201.34362%2017.9551%200.628765%2015.8354C-0.0860927%2013.7156%20-0.194255%2011.4383%200.316485%209.26036C0.827225%207.08242%201.93653%205.09059%203.51922%203.50965C5.10191%201.92872%207.09496%200.821614%209.27346%200.313284C11.452%20-0.195045%2013.7292%20-0.0843624%2015.8481%200.632841C17.967%201.35004%2019.8433%202.64522%2021.2653%204.37219C22.6872%206.09916%2023.5981%208.18918%2023.8952%2010.4064C23.9502%2010.8169%2023.662%2011.1943%2023.2514%2011.2494C22.8409%2011.3044%2022.4635%2011.0162%2022.4085%2010.6056C22.1485%208.66554%2021.3514%206.83675%2020.1073%205.32563C18.8631%203.81451%2017.2213%202.68122%2015.3672%202.05366Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M9.71105%200.459133C10.0534%200.692318%2010.1419%201.15887%209.9087%201.50122C8.50708%203.55895%207.49983%207.44147%207.49983%2012C7.49983%2016.5586%208.50711%2020.442%209.90861%2022.4986C10.1419%2022.8409%2010.0535%2023.3075%209.71118%2023.5408C9.36889%2023.774%208.90231%2023.6856%208.66906%2023.3433C7.02855%2020.936%205.99983%2016.7074%205.99983%2012C5.99983%207.29252%207.02859%203.06504%208.66897%200.656779C8.90215%200.314438%209.36871%200.225949%209.71105%200.459133Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M0.0248334%2011.25C0.0248334%2010.8358%200.36062%2010.5%200.774833%2010.5H10.2978C10.712%2010.5%2011.0478%2010.8358%2011.0478%2011.25C11.0478%2011.6642%2010.712%2012%2010.2978%2012H0.774833C0.36062%2012%200.0248334%2011.6642%200.0248334%2011.25Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M2.24883%205.25C2.24883%204.83578%202.58462%204.5%202.99883%204.5H20.9998C21.414%204.5%2021.7498%204.83578%2021.7498%205.25C21.7498%205.66421%2021.414%206%2020.9998%206H2.99883C2.58462%206%202.24883%205.66421%202.24883%205.25Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M1.29783%2017.25C1.29783%2016.8358%201.63362%2016.5%202.04783%2016.5H8.24983C8.66405%2016.5%208.99983%2016.8358%208.99983%2017.25C8.99983%2017.6642%208.66405%2018%208.24983%2018H2.04783C1.63362%2018%201.29783%2017.6642%201.29783%2017.25Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M14.3289%200.433553C14.6853%200.222597%2015.1453%200.340558%2015.3563%200.697027C16.7019%202.97081%2017.5224%205.51656%2017.7578%208.14817C17.7948%208.56073%2017.4902%208.9251%2017.0777%208.96201C16.6651%208.99892%2016.3007%208.6944%2016.2638%208.28183C16.0483%205.87282%2015.2972%203.54242%2014.0654%201.46097C13.8544%201.1045%2013.9724%200.64451%2014.3289%200.433553Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3C%2Fg%3E%0A%3Cdefs%3E%0A%3CclipPath%20id%3D%22clip0_1_36438%22%3E%0A%3Crect%20width%3D%2224%22%20height%3D%2224%22%20fill%3D%22white%22%2F%3E%0A%3C%2FclipPath%3E%0A%3C%2Fdefs%3E%0A%3C%2Fsvg%3E%0A"
+	controllerDelayIcon              = "data:image/svg+xml,%3Csvg%20width%3D%2224%22%20height%3D%2224%22%20viewBox%3D%220%200%2024%2024%22%20fill%3D%22none%22%20xmlns%3D%22http%3A%2F%2Fwww.w3.org%2F2000%2Fsvg%22%3E%0A%3Cg%20clip-path%3D%22url%28%23clip0_1_36269%29%22%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M0.672193%200.662C1.09415%200.240044%201.66645%200.00299072%202.26318%200.00299072H20.2632C20.8599%200.00299072%2021.4322%200.240044%2021.8542%200.662C22.2761%201.08396%2022.5132%201.65625%2022.5132%202.25299V8.25299C22.5132%208.6672%2022.1774%209.00299%2021.7632%209.00299C21.349%209.00299%2021.0132%208.6672%2021.0132%208.25299V2.25299C21.0132%202.05408%2020.9342%201.86331%2020.7935%201.72266C20.6529%201.58201%2020.4621%201.50299%2020.2632%201.50299H2.26318C2.06427%201.50299%201.87351%201.58201%201.73285%201.72266C1.5922%201.86331%201.51318%202.05408%201.51318%202.25299V18.753C1.51318%2018.9519%201.5922%2019.1427%201.73285%2019.2833C1.87351%2019.424%202.06427%2019.503%202.26318%2019.503H8.26318C8.6774%2019.503%209.01318%2019.8388%209.01318%2020.253C9.01318%2020.6672%208.6774%2021.003%208.26318%2021.003H2.26318C1.66645%2021.003%201.09415%2020.7659%200.672193%2020.344C0.250237%2019.922%200.0131836%2019.3497%200.0131836%2018.753V2.25299C0.0131836%201.65625%200.250237%201.08396%200.672193%200.662Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M0.0131836%205.25299C0.0131836%204.83878%200.34897%204.50299%200.763184%204.50299H21.7632C22.1774%204.50299%2022.5132%204.83878%2022.5132%205.25299C22.5132%205.6672%2022.1774%206.00299%2021.7632%206.00299H0.763184C0.34897%206.00299%200.0131836%205.6672%200.0131836%205.25299Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M17.2602%2012.003C14.3607%2012.003%2012.0102%2014.3535%2012.0102%2017.253C12.0102%2020.1525%2014.3607%2022.503%2017.2602%2022.503C20.1597%2022.503%2022.5102%2020.1525%2022.5102%2017.253C22.5102%2014.3535%2020.1597%2012.003%2017.2602%2012.003ZM10.5102%2017.253C10.5102%2013.5251%2013.5323%2010.503%2017.2602%2010.503C20.9881%2010.503%2024.0102%2013.5251%2024.0102%2017.253C24.0102%2020.9809%2020.9881%2024.003%2017.2602%2024.003C13.5323%2024.003%2010.5102%2020.9809%2010.5102%2017.253Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M17.2602%2013.852C17.6744%2013.852%2018.0102%2014.1878%2018.0102%2014.602V16.503H19.9122C20.3264%2016.503%2020.6622%2016.8388%2020.6622%2017.253C20.6622%2017.6672%2020.3264%2018.003%2019.9122%2018.003H17.2602C16.846%2018.003%2016.5102%2017.6672%2016.5102%2017.253V14.602C16.5102%2014.1878%2016.846%2013.852%2017.2602%2013.852Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3C%2Fg%3E%0A%3Cdefs%3E%0A%3CclipPath%20id%3D%22clip0_1_36269%22%3E%0A%3Crect%20width%3D%2224%22%20height%3D%2224%22%20fill%3D%22white%22%2F%3E%0A%3C%2FclipPath%3E%0A%3C%2Fdefs%3E%0A%3C%2Fsvg%3E%0A"
+	controllerExceptionIcon          = "data:image/svg+xml,%3Csvg%20width%3D%2224%22%20height%3D%2224%22%20viewBox%3D%220%200%2024%2024%22%20fill%3D%22none%22%20xmlns%3D%22http%3A%2F%2Fwww.w3.org%2F2000%2Fsvg%22%3E%0A%3Cg%20clip-path%3D%22url%28%23clip0_1_36275%29%22%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M0.668775%200.662C1.09073%200.240044%201.66303%200.00299072%202.25977%200.00299072H20.2598C20.8565%200.00299072%2021.4288%200.240044%2021.8508%200.662C22.2727%201.08396%2022.5098%201.65625%2022.5098%202.25299V8.25299C22.5098%208.6672%2022.174%209.00299%2021.7598%209.00299C21.3456%209.00299%2021.0098%208.6672%2021.0098%208.25299V2.25299C21.0098%202.05408%2020.9307%201.86331%2020.7901%201.72266C20.6494%201.58201%2020.4587%201.50299%2020.2598%201.50299H2.25977C2.06085%201.50299%201.87009%201.58201%201.72944%201.72266C1.58878%201.86331%201.50977%202.05408%201.50977%202.25299V18.753C1.50977%2018.9519%201.58878%2019.1427%201.72944%2019.2833C1.87009%2019.424%202.06085%2019.503%202.25977%2019.503H8.25977C8.67398%2019.503%209.00977%2019.8388%209.00977%2020.253C9.00977%2020.6672%208.67398%2021.003%208.25977%2021.003H2.25977C1.66303%2021.003%201.09073%2020.7659%200.668775%2020.344C0.246819%2019.922%200.00976562%2019.3497%200.00976562%2018.753V2.25299C0.00976562%201.65625%200.246819%201.08396%200.668775%200.662Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M0.00976562%205.25299C0.00976562%204.83878%200.345552%204.50299%200.759766%204.50299H21.7598C22.174%204.50299%2022.5098%204.83878%2022.5098%205.25299C22.5098%205.6672%2022.174%206.00299%2021.7598%206.00299H0.759766C0.345552%206.00299%200.00976562%205.6672%200.00976562%205.25299Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M17.2598%2012.003C14.3603%2012.003%2012.0098%2014.3535%2012.0098%2017.253C12.0098%2020.1525%2014.3603%2022.503%2017.2598%2022.503C20.1593%2022.503%2022.5098%2020.1525%2022.5098%2017.253C22.5098%2014.3535%2020.1593%2012.003%2017.2598%2012.003ZM10.5098%2017.253C10.5098%2013.5251%2013.5318%2010.503%2017.2598%2010.503C20.9877%2010.503%2024.0098%2013.5251%2024.0098%2017.253C24.0098%2020.9809%2020.9877%2024.003%2017.2598%2024.003C13.5318%2024.003%2010.5098%2020.9809%2010.5098%2017.253Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M20.0401%2014.4727C20.333%2014.7656%2020.333%2015.2404%2020.0401%2015.5333L15.5401%2020.0333C15.2472%2020.3262%2014.7723%2020.3262%2014.4794%2020.0333C14.1865%2019.7404%2014.1865%2019.2656%2014.4794%2018.9727L18.9794%2014.4727C19.2723%2014.1798%2019.7472%2014.1798%2020.0401%2014.4727Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M14.4794%2014.4727C14.7723%2014.1798%2015.2472%2014.1798%2015.5401%2014.4727L20.0401%2018.9727C20.333%2019.2656%2020.333%2019.7404%2020.0401%2020.0333C19.7472%2020.3262%2019.2723%2020.3262%2018.9794%2020.0333L14.4794%2015.5333C14.1865%2015.2404%2014.1865%2014.7656%2014.4794%2014.4727Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3C%2Fg%3E%0A%3Cdefs%3E%0A%3CclipPath%20id%3D%22clip0_1_36275%22%3E%0A%3Crect%20width%3D%2224%22%20height%3D%2224%22%20fill%3D%22white%22%2F%3E%0A%3C%2FclipPath%3E%0A%3C%2Fdefs%3E%0A%3C%2Fsvg%3E%0A"
+	jdbcTemplateExceptionIcon        = "data:image/svg+xml,%3Csvg%20width%3D%2224%22%20height%3D%2224%22%20viewBox%3D%220%200%2024%2024%22%20fill%3D%22none%22%20xmlns%3D%22http%3A%2F%2Fwww.w3.org%2F2000%2Fsvg%22%3E%0A%3Cg%20clip-path%3D%22url%28%23clip0_1_36294%29%22%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M2.14943%204.04445C1.67354%204.49448%201.5%204.90246%201.5%205.25101C1.5%205.59955%201.67354%206.00753%202.14943%206.45756C2.62689%206.90908%203.35803%207.35026%204.32368%207.73653C6.25081%208.5074%208.96451%209.00101%2012%209.00101C12.4142%209.00101%2012.75%209.33679%2012.75%209.75101C12.75%2010.1652%2012.4142%2010.501%2012%2010.501C8.8225%2010.501%205.91119%209.98711%203.76657%209.12924C2.69635%208.70113%201.77993%208.17263%201.11879%207.54742C0.456087%206.92073%200%206.14496%200%205.25101C0%204.35705%200.456087%203.58129%201.11879%202.95459C1.77993%202.32939%202.69635%201.80088%203.76657%201.37278C5.91119%200.514905%208.8225%200.00100708%2012%200.00100708C15.1775%200.00100708%2018.0888%200.514641%2020.2334%201.37239C21.3036%201.80043%2022.2201%202.32891%2022.8812%202.95419C23.544%203.58099%2024%204.35688%2024%205.25101C24%206.20743%2023.4789%207.02652%2022.7388%207.67651C21.9978%208.32736%2020.9716%208.87194%2019.775%209.30268C19.3853%209.44297%2018.9556%209.24077%2018.8153%208.85104C18.675%208.46131%2018.8772%208.03163%2019.267%207.89134C20.3594%207.49807%2021.1977%207.03366%2021.7489%206.5495C22.3011%206.0645%2022.5%205.62258%2022.5%205.25101C22.5%204.90214%2022.3264%204.49402%2021.8505%204.04401C21.3731%203.59248%2020.642%203.15133%2019.6764%202.76513C17.7492%201.99437%2015.0355%201.50101%2012%201.50101C8.96451%201.50101%206.25081%201.99461%204.32368%202.76549C3.35803%203.15176%202.62689%203.59294%202.14943%204.04445Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M23.25%204.50101C23.6642%204.50101%2024%204.83679%2024%205.25101V9.75101C24%2010.1652%2023.6642%2010.501%2023.25%2010.501C22.8358%2010.501%2022.5%2010.1652%2022.5%209.75101V5.25101C22.5%204.83679%2022.8358%204.50101%2023.25%204.50101Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M0.75%204.50101C1.16421%204.50101%201.5%204.83679%201.5%205.25101V11.251C1.5%2011.8022%201.95712%2012.514%203.22257%2013.2185C4.44336%2013.8981%206.21854%2014.4491%208.34237%2014.7504C8.75248%2014.8086%209.03776%2015.1883%208.97956%2015.5984C8.92136%2016.0085%208.54173%2016.2938%208.13163%2016.2356C5.89346%2015.918%203.92514%2015.3264%202.49293%2014.529C1.10538%2013.7566%200%2012.6568%200%2011.251V5.25101C0%204.83679%200.335786%204.50101%200.75%204.50101Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M0.75%2010.501C1.16421%2010.501%201.5%2010.8368%201.5%2011.251V17.251C1.5%2017.8358%202.01765%2018.5979%203.43374%2019.3319C4.79359%2020.0367%206.75734%2020.5861%209.0745%2020.8426C9.4862%2020.8881%209.78301%2021.2588%209.73745%2021.6705C9.69189%2022.0822%209.3212%2022.379%208.9095%2022.3335C6.47365%2022.0639%204.31641%2021.4788%202.74351%2020.6636C1.22685%2019.8776%200%2018.7342%200%2017.251V11.251C0%2010.8368%200.335786%2010.501%200.75%2010.501Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M17.25%2012.001C14.3505%2012.001%2012%2014.3515%2012%2017.251C12%2020.1505%2014.3505%2022.501%2017.25%2022.501C20.1495%2022.501%2022.5%2020.1505%2022.5%2017.251C22.5%2014.3515%2020.1495%2012.001%2017.25%2012.001ZM10.5%2017.251C10.5%2013.5231%2013.5221%2010.501%2017.25%2010.501C20.9779%2010.501%2024%2013.5231%2024%2017.251C24%2020.9789%2020.9779%2024.001%2017.25%2024.001C13.5221%2024.001%2010.5%2020.9789%2010.5%2017.251Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M20.0303%2014.4697C20.3232%2014.7626%2020.3232%2015.2374%2020.0303%2015.5303L15.5303%2020.0303C15.2374%2020.3232%2014.7626%2020.3232%2014.4697%2020.0303C14.1768%2019.7374%2014.1768%2019.2626%2014.4697%2018.9697L18.9697%2014.4697C19.2626%2014.1768%2019.7374%2014.1768%2020.0303%2014.4697Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M14.4697%2014.4697C14.7626%2014.1768%2015.2374%2014.1768%2015.5303%2014.4697L20.0303%2018.9697C20.3232%2019.2626%2020.3232%2019.7374%2020.0303%2020.0303C19.7374%2020.3232%2019.2626%2020.3232%2018.9697%2020.0303L14.4697%2015.5303C14.1768%2015.2374%2014.1768%2014.7626%2014.4697%2014.4697Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3C%2Fg%3E%0A%3Cdefs%3E%0A%3CclipPath%20id%3D%22clip0_1_36294%22%3E%0A%3Crect%20width%3D%2224%22%20height%3D%2224%22%20fill%3D%22white%22%2F%3E%0A%3C%2FclipPath%3E%0A%3C%2Fdefs%3E%0A%3C%2Fsvg%3E%0A"
+	jdbcTemplateDelayIcon            = "data:image/svg+xml,%3Csvg%20width%3D%2224%22%20height%3D%2224%22%20viewBox%3D%220%200%2024%2024%22%20fill%3D%22none%22%20xmlns%3D%22http%3A%2F%2Fwww.w3.org%2F2000%2Fsvg%22%3E%0A%3Cg%20clip-path%3D%22url%28%23clip0_1_36282%29%22%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M17.25%2012.001C14.3505%2012.001%2012%2014.3515%2012%2017.251C12%2020.1505%2014.3505%2022.501%2017.25%2022.501C20.1495%2022.501%2022.5%2020.1505%2022.5%2017.251C22.5%2014.3515%2020.1495%2012.001%2017.25%2012.001ZM10.5%2017.251C10.5%2013.5231%2013.5221%2010.501%2017.25%2010.501C20.9779%2010.501%2024%2013.5231%2024%2017.251C24%2020.9789%2020.9779%2024.001%2017.25%2024.001C13.5221%2024.001%2010.5%2020.9789%2010.5%2017.251Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M17.25%2013.849C17.6642%2013.849%2018%2014.1848%2018%2014.599V16.501H19.902C20.3162%2016.501%2020.652%2016.8368%2020.652%2017.251C20.652%2017.6652%2020.3162%2018.001%2019.902%2018.001H17.25C16.8358%2018.001%2016.5%2017.6652%2016.5%2017.251V14.599C16.5%2014.1848%2016.8358%2013.849%2017.25%2013.849Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M3.76661%201.37236C5.91124%200.51461%208.82254%200.000976562%2012%200.000976562C15.1775%200.000976562%2018.0888%200.51461%2020.2334%201.37236C21.3036%201.8004%2022.2201%202.32888%2022.8812%202.95416C23.544%203.58096%2024%204.35685%2024%205.25098C24%206.19566%2023.4927%207.00618%2022.7683%207.6516C22.0437%208.29713%2021.0401%208.83788%2019.8697%209.26795C19.4809%209.41082%2019.0499%209.21145%2018.907%208.82266C18.7642%208.43386%2018.9635%208.00286%2019.3523%207.86C20.4189%207.46808%2021.2348%207.00883%2021.7705%206.5316C22.3063%206.05428%2022.5%205.6193%2022.5%205.25098C22.5%204.9021%2022.3264%204.49399%2021.8505%204.04398C21.3731%203.59245%2020.642%203.1513%2019.6764%202.7651C17.7492%201.99434%2015.0355%201.50098%2012%201.50098C8.96446%201.50098%206.25076%201.99434%204.32364%202.7651C3.358%203.1513%202.62689%203.59245%202.14945%204.04398C1.67362%204.49399%201.5%204.9021%201.5%205.25098C1.5%205.59985%201.67362%206.00796%202.14945%206.45798C2.62689%206.90951%203.358%207.35065%204.32364%207.73686C6.25076%208.50761%208.96446%209.00098%2012%209.00098C12.4142%209.00098%2012.75%209.33676%2012.75%209.75098C12.75%2010.1652%2012.4142%2010.501%2012%2010.501C8.82254%2010.501%205.91124%209.98734%203.76661%209.1296C2.69638%208.70155%201.77992%208.17307%201.11877%207.54779C0.456008%206.92099%200%206.1451%200%205.25098C0%204.35685%200.456008%203.58096%201.11877%202.95416C1.77992%202.32888%202.69638%201.8004%203.76661%201.37236Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M0.75%2010.501C1.16421%2010.501%201.5%2010.8368%201.5%2011.251C1.5%2011.8103%201.97124%2012.534%203.27241%2013.2457C4.52623%2013.9315%206.34638%2014.4824%208.51676%2014.7736C8.92729%2014.8287%209.21543%2015.2062%209.16034%2015.6167C9.10524%2016.0273%208.72778%2016.3154%208.31724%2016.2603C6.03162%2015.9536%204.01827%2015.3634%202.55259%2014.5617C1.13426%2013.7859%200%2012.6756%200%2011.251C0%2010.8368%200.335786%2010.501%200.75%2010.501Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M0.75%204.50098C1.16421%204.50098%201.5%204.83676%201.5%205.25098V17.251C1.5%2017.8534%202.05053%2018.6413%203.54587%2019.3889C4.97873%2020.1052%207.04061%2020.6516%209.45601%2020.8813C9.86837%2020.9206%2010.1709%2021.2866%2010.1316%2021.699C10.0924%2022.1113%209.72634%2022.4138%209.31399%2022.3746C6.77739%2022.1333%204.52177%2021.5538%202.87513%2020.7306C1.29097%2019.9386%200%2018.7736%200%2017.251V5.25098C0%204.83676%200.335786%204.50098%200.75%204.50098Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M23.25%204.50098C23.6642%204.50098%2024%204.83676%2024%205.25098V10.543C24%2010.9572%2023.6642%2011.293%2023.25%2011.293C22.8358%2011.293%2022.5%2010.9572%2022.5%2010.543V5.25098C22.5%204.83676%2022.8358%204.50098%2023.25%204.50098Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M3.76661%201.37236C5.91124%200.51461%208.82254%200.000976562%2012%200.000976562C15.1775%200.000976562%2018.0888%200.51461%2020.2334%201.37236C21.3036%201.8004%2022.2201%202.32888%2022.8812%202.95416C23.544%203.58096%2024%204.35685%2024%205.25098C24%206.19566%2023.4927%207.00618%2022.7683%207.6516C22.0437%208.29713%2021.0401%208.83788%2019.8697%209.26795C19.4809%209.41082%2019.0499%209.21145%2018.907%208.82266C18.7642%208.43386%2018.9635%208.00286%2019.3523%207.86C20.4189%207.46808%2021.2348%207.00883%2021.7705%206.5316C22.3063%206.05428%2022.5%205.6193%2022.5%205.25098C22.5%204.9021%2022.3264%204.49399%2021.8505%204.04398C21.3731%203.59245%2020.642%203.1513%2019.6764%202.7651C17.7492%201.99434%2015.0355%201.50098%2012%201.50098C8.96446%201.50098%206.25076%201.99434%204.32364%202.7651C3.358%203.1513%202.62689%203.59245%202.14945%204.04398C1.67362%204.49399%201.5%204.9021%201.5%205.25098C1.5%205.59985%201.67362%206.00796%202.14945%206.45798C2.62689%206.90951%203.358%207.35065%204.32364%207.73686C6.25076%208.50761%208.96446%209.00098%2012%209.00098C12.4142%209.00098%2012.75%209.33676%2012.75%209.75098C12.75%2010.1652%2012.4142%2010.501%2012%2010.501C8.82254%2010.501%205.91124%209.98734%203.76661%209.1296C2.69638%208.70155%201.77992%208.17307%201.11877%207.54779C0.456008%206.92099%200%206.1451%200%205.25098C0%204.35685%200.456008%203.58096%201.11877%202.95416C1.77992%202.32888%202.69638%201.8004%203.76661%201.37236Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M0.75%2010.501C1.16421%2010.501%201.5%2010.8368%201.5%2011.251C1.5%2011.8103%201.97124%2012.534%203.27241%2013.2457C4.52623%2013.9315%206.34638%2014.4824%208.51676%2014.7736C8.92729%2014.8287%209.21543%2015.2062%209.16034%2015.6167C9.10524%2016.0273%208.72778%2016.3154%208.31724%2016.2603C6.03162%2015.9536%204.01827%2015.3634%202.55259%2014.5617C1.13426%2013.7859%200%2012.6756%200%2011.251C0%2010.8368%200.335786%2010.501%200.75%2010.501Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M0.75%204.50098C1.16421%204.50098%201.5%204.83676%201.5%205.25098V17.251C1.5%2017.8534%202.05053%2018.6413%203.54587%2019.3889C4.97873%2020.1052%207.04061%2020.6516%209.45601%2020.8813C9.86837%2020.9206%2010.1709%2021.2866%2010.1316%2021.699C10.0924%2022.1113%209.72634%2022.4138%209.31399%2022.3746C6.77739%2022.1333%204.52177%2021.5538%202.87513%2020.7306C1.29097%2019.9386%200%2018.7736%200%2017.251V5.25098C0%204.83676%200.335786%204.50098%200.75%204.50098Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M23.25%204.50098C23.6642%204.50098%2024%204.83676%2024%205.25098V10.543C24%2010.9572%2023.6642%2011.293%2023.25%2011.293C22.8358%2011.293%2022.5%2010.9572%2022.5%2010.543V5.25098C22.5%204.83676%2022.8358%204.50098%2023.25%204.50098Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3C%2Fg%3E%0A%3Cdefs%3E%0A%3CclipPath%20id%3D%22clip0_1_36282%22%3E%0A%3Crect%20width%3D%2224%22%20height%3D%2224%22%20fill%3D%22white%22%2F%3E%0A%3C%2FclipPath%3E%0A%3C%2Fdefs%3E%0A%3C%2Fsvg%3E%0A"
+	javaMethodExceptionIcon          = "data:image/svg+xml,%3Csvg%20width%3D%2224%22%20height%3D%2224%22%20viewBox%3D%220%200%2024%2024%22%20fill%3D%22none%22%20xmlns%3D%22http%3A%2F%2Fwww.w3.org%2F2000%2Fsvg%22%3E%0A%3Cg%20clip-path%3D%22url%28%23clip0_1_36343%29%22%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M12.476%2012.481C13.7419%2011.2152%2015.4588%2010.504%2017.249%2010.504C19.0392%2010.504%2020.7561%2011.2152%2022.022%2012.481C23.2878%2013.7469%2023.999%2015.4638%2023.999%2017.254C23.999%2018.1404%2023.8244%2019.0182%2023.4852%2019.8371C23.146%2020.6561%2022.6488%2021.4002%2022.022%2022.027C21.3952%2022.6538%2020.6511%2023.151%2019.8321%2023.4902C19.0132%2023.8294%2018.1354%2024.004%2017.249%2024.004C16.3626%2024.004%2015.4848%2023.8294%2014.6659%2023.4902C13.8469%2023.151%2013.1028%2022.6538%2012.476%2022.027C11.8492%2021.4002%2011.352%2020.6561%2011.0128%2019.8371C10.6736%2019.0182%2010.499%2018.1404%2010.499%2017.254C10.499%2015.4638%2011.2102%2013.7469%2012.476%2012.481ZM17.249%2012.004C15.8566%2012.004%2014.5212%2012.5571%2013.5367%2013.5417C12.5521%2014.5263%2011.999%2015.8616%2011.999%2017.254C11.999%2017.9434%2012.1348%2018.6261%2012.3986%2019.2631C12.6625%2019.9%2013.0492%2020.4788%2013.5367%2020.9663C14.0242%2021.4538%2014.6029%2021.8405%2015.2399%2022.1044C15.8769%2022.3682%2016.5596%2022.504%2017.249%2022.504C17.9384%2022.504%2018.6211%2022.3682%2019.2581%2022.1044C19.895%2021.8405%2020.4738%2021.4538%2020.9613%2020.9663C21.4488%2020.4788%2021.8355%2019.9%2022.0994%2019.2631C22.3632%2018.6261%2022.499%2017.9434%2022.499%2017.254C22.499%2015.8616%2021.9459%2014.5263%2020.9613%2013.5417C19.9767%2012.5571%2018.6414%2012.004%2017.249%2012.004ZM14.4687%2014.4727C14.7616%2014.1798%2015.2364%2014.1798%2015.5293%2014.4727L17.249%2016.1923L18.9687%2014.4727C19.2616%2014.1798%2019.7364%2014.1798%2020.0293%2014.4727C20.3222%2014.7656%2020.3222%2015.2404%2020.0293%2015.5333L18.3097%2017.253L20.0293%2018.9727C20.3222%2019.2656%2020.3222%2019.7404%2020.0293%2020.0333C19.7364%2020.3262%2019.2616%2020.3262%2018.9687%2020.0333L17.249%2018.3137L15.5293%2020.0333C15.2364%2020.3262%2014.7616%2020.3262%2014.4687%2020.0333C14.1758%2019.7404%2014.1758%2019.2656%2014.4687%2018.9727L16.1883%2017.253L14.4687%2015.5333C14.1758%2015.2404%2014.1758%2014.7656%2014.4687%2014.4727Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M2.24899%201.504C2.05008%201.504%201.85931%201.58302%201.71866%201.72367C1.57801%201.86432%201.49899%202.05509%201.49899%202.254V18.754C1.49899%2018.9529%201.57801%2019.1437%201.71866%2019.2843C1.85931%2019.425%202.05008%2019.504%202.24899%2019.504H8.24899C8.66321%2019.504%208.99899%2019.8398%208.99899%2020.254C8.99899%2020.6682%208.66321%2021.004%208.24899%2021.004H2.24899C1.65226%2021.004%201.07996%2020.7669%200.658003%2020.345C0.236045%2019.923%20-0.00100708%2019.3507%20-0.00100708%2018.754V2.254C-0.00100708%201.65726%200.236046%201.08496%200.658003%200.663008C1.07996%200.241051%201.65226%200.0039978%202.24899%200.0039978H12.878C13.4743%200.00412512%2014.0464%200.240971%2014.4682%200.662498L17.3402%203.53358C17.5491%203.74261%2017.7151%203.99101%2017.8281%204.26408C17.941%204.53705%2017.9991%204.82959%2017.999%205.125C17.999%205.12488%2017.999%205.12511%2017.999%205.125V8.254C17.999%208.66821%2017.6632%209.004%2017.249%209.004C16.8348%209.004%2016.499%208.66821%2016.499%208.254V5.125C16.499%205.02649%2016.4797%204.9286%2016.442%204.83757C16.4044%204.74667%2016.3493%204.66405%2016.2798%204.59442M16.2798%204.59442L13.4078%201.7235C13.4079%201.72352%2013.4078%201.72347%2013.4078%201.7235C13.2673%201.58307%2013.0767%201.50408%2012.878%201.504C12.878%201.504%2012.8779%201.504%2012.878%201.504H2.24899%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M3.74899%204.504C4.16321%204.504%204.49899%204.83978%204.49899%205.254V9.754C4.49899%2010.1682%204.16321%2010.504%203.74899%2010.504C3.33478%2010.504%202.99899%2010.1682%202.99899%209.754V5.254C2.99899%204.83978%203.33478%204.504%203.74899%204.504ZM5.99899%205.254C5.99899%204.83978%206.33478%204.504%206.74899%204.504H9.74899C10.1632%204.504%2010.499%204.83978%2010.499%205.254V9.754C10.499%2010.1682%2010.1632%2010.504%209.74899%2010.504H6.74899C6.33478%2010.504%205.99899%2010.1682%205.99899%209.754V5.254ZM7.49899%206.004V9.004H8.99899V6.004H7.49899ZM12.749%204.504C13.1632%204.504%2013.499%204.83978%2013.499%205.254V9.754C13.499%2010.1682%2013.1632%2010.504%2012.749%2010.504C12.3348%2010.504%2011.999%2010.1682%2011.999%209.754V5.254C11.999%204.83978%2012.3348%204.504%2012.749%204.504ZM2.99899%2012.754C2.99899%2012.3398%203.33478%2012.004%203.74899%2012.004H6.74899C7.16321%2012.004%207.49899%2012.3398%207.49899%2012.754V17.254C7.49899%2017.6682%207.16321%2018.004%206.74899%2018.004H3.74899C3.33478%2018.004%202.99899%2017.6682%202.99899%2017.254V12.754ZM4.49899%2013.504V16.504H5.99899V13.504H4.49899Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3C%2Fg%3E%0A%3Cdefs%3E%0A%3CclipPath%20id%3D%22clip0_1_36343%22%3E%0A%3Crect%20width%3D%2224%22%20height%3D%2224%22%20fill%3D%22white%22%2F%3E%0A%3C%2FclipPath%3E%0A%3C%2Fdefs%3E%0A%3C%2Fsvg%3E%0A"
+	javaMethodDelayIcon              = "data:image/svg+xml,%3Csvg%20width%3D%2224%22%20height%3D%2224%22%20viewBox%3D%220%200%2024%2024%22%20fill%3D%22none%22%20xmlns%3D%22http%3A%2F%2Fwww.w3.org%2F2000%2Fsvg%22%3E%0A%3Cg%20clip-path%3D%22url%28%23clip0_1_36338%29%22%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M13.1524%201.56098C13.0614%201.52331%2012.9638%201.50395%2012.8653%201.504L2.237%201.504C2.03809%201.504%201.84732%201.58302%201.70667%201.72367C1.56602%201.86432%201.487%202.05509%201.487%202.254V18.754C1.487%2018.9529%201.56602%2019.1437%201.70667%2019.2843C1.84732%2019.425%202.03809%2019.504%202.237%2019.504H8.237C8.65121%2019.504%208.987%2019.8398%208.987%2020.254C8.987%2020.6682%208.65121%2021.004%208.237%2021.004H2.237C1.64026%2021.004%201.06797%2020.7669%200.646009%2020.345C0.224052%2019.923%20-0.0130005%2019.3507%20-0.0130005%2018.754V2.254C-0.0130005%201.65726%200.224053%201.08496%200.646009%200.663008C1.06797%200.241051%201.64026%200.00399785%202.237%200.00399785L12.8647%200.00399791C13.1601%200.00390862%2013.4529%200.0619923%2013.7259%200.174936C13.9989%200.287904%2014.247%200.453542%2014.4561%200.662391L17.33%203.53334C17.5392%203.74225%2017.7051%203.99033%2017.8184%204.26341C17.9316%204.53649%2017.9899%204.82921%2017.99%205.12484V8.254C17.99%208.66821%2017.6542%209.004%2017.24%209.004C16.8258%209.004%2016.49%208.66821%2016.49%208.254V5.12516C16.49%205.02667%2016.4705%204.92899%2016.4328%204.83802C16.395%204.74699%2016.3397%204.6643%2016.27%204.59466L13.3959%201.7236C13.3263%201.65397%2013.2434%201.59864%2013.1524%201.56098Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M3.737%204.504C4.15121%204.504%204.487%204.83978%204.487%205.254V9.754C4.487%2010.1682%204.15121%2010.504%203.737%2010.504C3.32279%2010.504%202.987%2010.1682%202.987%209.754V5.254C2.987%204.83978%203.32279%204.504%203.737%204.504ZM5.987%205.254C5.987%204.83978%206.32279%204.504%206.737%204.504H9.737C10.1512%204.504%2010.487%204.83978%2010.487%205.254V9.754C10.487%2010.1682%2010.1512%2010.504%209.737%2010.504H6.737C6.32279%2010.504%205.987%2010.1682%205.987%209.754V5.254ZM7.487%206.004V9.004H8.987V6.004H7.487ZM12.737%204.504C13.1512%204.504%2013.487%204.83978%2013.487%205.254V9.754C13.487%2010.1682%2013.1512%2010.504%2012.737%2010.504C12.3228%2010.504%2011.987%2010.1682%2011.987%209.754V5.254C11.987%204.83978%2012.3228%204.504%2012.737%204.504ZM2.987%2012.754C2.987%2012.3398%203.32279%2012.004%203.737%2012.004H6.737C7.15121%2012.004%207.487%2012.3398%207.487%2012.754V17.254C7.487%2017.6682%207.15121%2018.004%206.737%2018.004H3.737C3.32279%2018.004%202.987%2017.6682%202.987%2017.254V12.754ZM4.487%2013.504V16.504H5.987V13.504H4.487Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M16.487%2012.004C13.5875%2012.004%2011.237%2014.3545%2011.237%2017.254C11.237%2020.1535%2013.5875%2022.504%2016.487%2022.504C19.3865%2022.504%2021.737%2020.1535%2021.737%2017.254C21.737%2014.3545%2019.3865%2012.004%2016.487%2012.004ZM9.737%2017.254C9.737%2013.5261%2012.7591%2010.504%2016.487%2010.504C20.2149%2010.504%2023.237%2013.5261%2023.237%2017.254C23.237%2020.9819%2020.2149%2024.004%2016.487%2024.004C12.7591%2024.004%209.737%2020.9819%209.737%2017.254Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M16.487%2013.852C16.9012%2013.852%2017.237%2014.1878%2017.237%2014.602V16.504H19.139C19.5532%2016.504%2019.889%2016.8398%2019.889%2017.254C19.889%2017.6682%2019.5532%2018.004%2019.139%2018.004H16.487C16.0728%2018.004%2015.737%2017.6682%2015.737%2017.254V14.602C15.737%2014.1878%2016.0728%2013.852%2016.487%2013.852Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3C%2Fg%3E%0A%3Cdefs%3E%0A%3CclipPath%20id%3D%22clip0_1_36338%22%3E%0A%3Crect%20width%3D%2224%22%20height%3D%2224%22%20fill%3D%22white%22%2F%3E%0A%3C%2FclipPath%3E%0A%3C%2Fdefs%3E%0A%3C%2Fsvg%3E%0A"
+	springHttpDelayIcon              = "data:image/svg+xml,%3Csvg%20width%3D%2224%22%20height%3D%2224%22%20viewBox%3D%220%200%2024%2024%22%20fill%3D%22none%22%20xmlns%3D%22http%3A%2F%2Fwww.w3.org%2F2000%2Fsvg%22%3E%0A%3Cg%20clip-path%3D%22url%28%23clip0_1_36448%29%22%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M17.25%2012C14.3505%2012%2012%2014.3505%2012%2017.25C12%2020.1495%2014.3505%2022.5%2017.25%2022.5C20.1495%2022.5%2022.5%2020.1495%2022.5%2017.25C22.5%2014.3505%2020.1495%2012%2017.25%2012ZM10.5%2017.25C10.5%2013.5221%2013.5221%2010.5%2017.25%2010.5C20.9779%2010.5%2024%2013.5221%2024%2017.25C24%2020.9779%2020.9779%2024%2017.25%2024C13.5221%2024%2010.5%2020.9779%2010.5%2017.25Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M20.0303%2014.4697C20.3232%2014.7626%2020.3232%2015.2374%2020.0303%2015.5303L15.5303%2020.0303C15.2374%2020.3232%2014.7625%2020.3232%2014.4697%2020.0303C14.1768%2019.7374%2014.1768%2019.2626%2014.4697%2018.9697L18.9697%2014.4697C19.2625%2014.1768%2019.7374%2014.1768%2020.0303%2014.4697Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M14.4697%2014.4697C14.7625%2014.1768%2015.2374%2014.1768%2015.5303%2014.4697L20.0303%2018.9697C20.3232%2019.2626%2020.3232%2019.7374%2020.0303%2020.0303C19.7374%2020.3232%2019.2625%2020.3232%2018.9697%2020.0303L14.4697%2015.5303C14.1768%2015.2374%2014.1768%2014.7626%2014.4697%2014.4697Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M15.3627%202.053C13.5088%201.42623%2011.5166%201.32999%209.61093%201.77513C7.70524%202.22028%205.96184%203.1891%204.57744%204.57231C3.19303%205.95552%202.22269%207.69806%201.77589%209.60337C1.32908%2011.5087%201.42359%2013.5009%202.04875%2015.3554C2.67392%2017.2099%203.80486%2018.8527%205.31399%2020.0987C6.82312%2021.3446%208.65039%2022.144%2010.5897%2022.4068C11.0001%2022.4624%2011.2878%2022.8402%2011.2322%2023.2507C11.1766%2023.6612%2010.7987%2023.9488%2010.3883%2023.8932C8.17199%2023.5929%206.0837%2022.6793%204.359%2021.2554C2.6343%2019.8315%201.34181%2017.9539%200.62735%2015.8346C-0.0871135%2013.7152%20-0.195124%2011.4384%200.315502%209.26091C0.826129%207.08344%201.93507%205.09198%203.51724%203.51119C5.0994%201.9304%207.09182%200.823187%209.26974%200.314453C11.4477%20-0.194281%2013.7244%20-0.0842913%2015.8431%200.632014C17.9619%201.34832%2019.8383%202.64244%2021.2607%204.36837C22.6831%206.09431%2023.5949%208.1834%2023.8933%2010.4C23.9485%2010.8105%2023.6605%2011.188%2023.25%2011.2433C22.8395%2011.2986%2022.4619%2011.0106%2022.4067%2010.6C22.1456%208.66054%2021.3478%206.83257%2020.1031%205.32236C18.8585%203.81214%2017.2166%202.67978%2015.3627%202.053Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M9.7112%200.459135C10.0535%200.69232%2010.142%201.15888%209.90884%201.50122C8.50723%203.55895%207.49998%207.44148%207.49998%2012C7.49998%2016.5586%208.50726%2020.442%209.90876%2022.4986C10.142%2022.8409%2010.0536%2023.3075%209.71133%2023.5408C9.36903%2023.774%208.90246%2023.6856%208.6692%2023.3433C7.0287%2020.936%205.99998%2016.7074%205.99998%2012C5.99998%207.29252%207.02873%203.06505%208.66912%200.656781C8.9023%200.314439%209.36886%200.22595%209.7112%200.459135Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M0.0249805%2011.25C0.0249805%2010.8358%200.360767%2010.5%200.774981%2010.5H10.5C10.9142%2010.5%2011.25%2010.8358%2011.25%2011.25C11.25%2011.6642%2010.9142%2012%2010.5%2012H0.774981C0.360767%2012%200.0249805%2011.6642%200.0249805%2011.25Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M2.24898%205.25C2.24898%204.83579%202.58477%204.5%202.99898%204.5H21C21.4142%204.5%2021.75%204.83579%2021.75%205.25C21.75%205.66421%2021.4142%206%2021%206H2.99898C2.58477%206%202.24898%205.66421%202.24898%205.25Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M1.29798%2017.25C1.29798%2016.8358%201.63377%2016.5%202.04798%2016.5H7.21398C7.62819%2016.5%207.96398%2016.8358%207.96398%2017.25C7.96398%2017.6642%207.62819%2018%207.21398%2018H2.04798C1.63377%2018%201.29798%2017.6642%201.29798%2017.25Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M14.3288%200.43368C14.6852%200.222607%2015.1452%200.340418%2015.3563%200.696818C16.7069%202.9774%2017.53%205.5314%2017.765%208.17149C17.8018%208.58407%2017.4971%208.94831%2017.0845%208.98504C16.6719%209.02178%2016.3077%208.71709%2016.2709%208.30451C16.0557%205.88741%2015.3022%203.54914%2014.0657%201.46118C13.8546%201.10478%2013.9724%200.644753%2014.3288%200.43368Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3C%2Fg%3E%0A%3Cdefs%3E%0A%3CclipPath%20id%3D%22clip0_1_36448%22%3E%0A%3Crect%20width%3D%2224%22%20height%3D%2224%22%20fill%3D%22white%22%2F%3E%0A%3C%2FclipPath%3E%0A%3C%2Fdefs%3E%0A%3C%2Fsvg%3E%0A"
+	springHttpStatusIcon             = "data:image/svg+xml,%3Csvg%20width%3D%2224%22%20height%3D%2224%22%20viewBox%3D%220%200%2024%2024%22%20fill%3D%22none%22%20xmlns%3D%22http%3A%2F%2Fwww.w3.org%2F2000%2Fsvg%22%3E%0A%3Cg%20clip-path%3D%22url%28%23clip0_1_36438%29%22%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M17.2498%2012C14.3503%2012%2011.9998%2014.3505%2011.9998%2017.25C11.9998%2020.1495%2014.3503%2022.5%2017.2498%2022.5C20.1493%2022.5%2022.4998%2020.1495%2022.4998%2017.25C22.4998%2014.3505%2020.1493%2012%2017.2498%2012ZM10.4998%2017.25C10.4998%2013.5221%2013.5219%2010.5%2017.2498%2010.5C20.9778%2010.5%2023.9998%2013.5221%2023.9998%2017.25C23.9998%2020.9779%2020.9778%2024%2017.2498%2024C13.5219%2024%2010.4998%2020.9779%2010.4998%2017.25Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M17.2498%2013.849C17.664%2013.849%2017.9998%2014.1848%2017.9998%2014.599V16.5H19.9018C20.316%2016.5%2020.6518%2016.8358%2020.6518%2017.25C20.6518%2017.6642%2020.316%2018%2019.9018%2018H17.2498C16.8356%2018%2016.4998%2017.6642%2016.4998%2017.25V14.599C16.4998%2014.1848%2016.8356%2013.849%2017.2498%2013.849Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M15.3672%202.05366C13.5131%201.4261%2011.5205%201.32925%209.61432%201.77404C7.70811%202.21884%205.96416%203.18757%204.57929%204.5709C3.19443%205.95424%202.22377%207.69711%201.77687%209.60283C1.32996%2011.5085%201.42461%2013.5012%202.05012%2015.356C2.67562%2017.2108%203.80709%2018.8538%205.31684%2020.0997C6.82658%2021.3455%208.65449%2022.1446%2010.5943%2022.4068C11.0048%2022.4622%2011.2925%2022.84%2011.2371%2023.2504C11.1816%2023.6609%2010.8039%2023.9487%2010.3934%2023.8932C8.17653%2023.5937%206.08751%2022.6804%204.36212%2021.2566C2.63672%2019.8328%201.34362%2017.9551%200.628765%2015.8354C-0.0860927%2013.7156%20-0.194255%2011.4383%200.316485%209.26036C0.827225%207.08242%201.93653%205.09059%203.51922%203.50965C5.10191%201.92872%207.09496%200.821614%209.27346%200.313284C11.452%20-0.195045%2013.7292%20-0.0843624%2015.8481%200.632841C17.967%201.35004%2019.8433%202.64522%2021.2653%204.37219C22.6872%206.09916%2023.5981%208.18918%2023.8952%2010.4064C23.9502%2010.8169%2023.662%2011.1943%2023.2514%2011.2494C22.8409%2011.3044%2022.4635%2011.0162%2022.4085%2010.6056C22.1485%208.66554%2021.3514%206.83675%2020.1073%205.32563C18.8631%203.81451%2017.2213%202.68122%2015.3672%202.05366Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M9.71105%200.459133C10.0534%200.692318%2010.1419%201.15887%209.9087%201.50122C8.50708%203.55895%207.49983%207.44147%207.49983%2012C7.49983%2016.5586%208.50711%2020.442%209.90861%2022.4986C10.1419%2022.8409%2010.0535%2023.3075%209.71118%2023.5408C9.36889%2023.774%208.90231%2023.6856%208.66906%2023.3433C7.02855%2020.936%205.99983%2016.7074%205.99983%2012C5.99983%207.29252%207.02859%203.06504%208.66897%200.656779C8.90215%200.314438%209.36871%200.225949%209.71105%200.459133Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M0.0248334%2011.25C0.0248334%2010.8358%200.36062%2010.5%200.774833%2010.5H10.2978C10.712%2010.5%2011.0478%2010.8358%2011.0478%2011.25C11.0478%2011.6642%2010.712%2012%2010.2978%2012H0.774833C0.36062%2012%200.0248334%2011.6642%200.0248334%2011.25Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M2.24883%205.25C2.24883%204.83578%202.58462%204.5%202.99883%204.5H20.9998C21.414%204.5%2021.7498%204.83578%2021.7498%205.25C21.7498%205.66421%2021.414%206%2020.9998%206H2.99883C2.58462%206%202.24883%205.66421%202.24883%205.25Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M1.29783%2017.25C1.29783%2016.8358%201.63362%2016.5%202.04783%2016.5H8.24983C8.66405%2016.5%208.99983%2016.8358%208.99983%2017.25C8.99983%2017.6642%208.66405%2018%208.24983%2018H2.04783C1.63362%2018%201.29783%2017.6642%201.29783%2017.25Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M14.3289%200.433553C14.6853%200.222597%2015.1453%200.340558%2015.3563%200.697027C16.7019%202.97081%2017.5224%205.51656%2017.7578%208.14817C17.7948%208.56073%2017.4902%208.9251%2017.0777%208.96201C16.6651%208.99892%2016.3007%208.6944%2016.2638%208.28183C16.0483%205.87282%2015.2972%203.54242%2014.0654%201.46097C13.8544%201.1045%2013.9724%200.64451%2014.3289%200.433553Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3C%2Fg%3E%0A%3Cdefs%3E%0A%3CclipPath%20id%3D%22clip0_1_36438%22%3E%0A%3Crect%20width%3D%2224%22%20height%3D%2224%22%20fill%3D%22white%22%2F%3E%0A%3C%2FclipPath%3E%0A%3C%2Fdefs%3E%0A%3C%2Fsvg%3E%0A"
+	javaMethodReturnValueIcon        = "data:image/svg+xml,%3Csvg%20width%3D%2224%22%20height%3D%2224%22%20viewBox%3D%220%200%2024%2024%22%20fill%3D%22none%22%20xmlns%3D%22http%3A%2F%2Fwww.w3.org%2F2000%2Fsvg%22%3E%0A%3Cg%20clip-path%3D%22url%28%23clip0_1_36401%29%22%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M13.1524%201.56098C13.0614%201.52331%2012.9638%201.50395%2012.8653%201.504L2.237%201.504C2.03809%201.504%201.84732%201.58302%201.70667%201.72367C1.56602%201.86432%201.487%202.05509%201.487%202.254V18.754C1.487%2018.9529%201.56602%2019.1437%201.70667%2019.2843C1.84732%2019.425%202.03809%2019.504%202.237%2019.504H8.237C8.65121%2019.504%208.987%2019.8398%208.987%2020.254C8.987%2020.6682%208.65121%2021.004%208.237%2021.004H2.237C1.64026%2021.004%201.06797%2020.7669%200.646009%2020.345C0.224052%2019.923%20-0.0130005%2019.3507%20-0.0130005%2018.754V2.254C-0.0130005%201.65726%200.224053%201.08496%200.646009%200.663008C1.06797%200.241051%201.64026%200.00399785%202.237%200.00399785L12.8647%200.00399791C13.1601%200.00390862%2013.4529%200.0619923%2013.7259%200.174936C13.9989%200.287904%2014.247%200.453542%2014.4561%200.662391L17.33%203.53334C17.5392%203.74225%2017.7051%203.99033%2017.8184%204.26341C17.9316%204.53649%2017.9899%204.82921%2017.99%205.12484V8.254C17.99%208.66821%2017.6542%209.004%2017.24%209.004C16.8258%209.004%2016.49%208.66821%2016.49%208.254V5.12516C16.49%205.02667%2016.4705%204.92899%2016.4328%204.83802C16.395%204.74699%2016.3397%204.6643%2016.27%204.59466L13.3959%201.7236C13.3263%201.65397%2013.2434%201.59864%2013.1524%201.56098Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M3.737%204.504C4.15121%204.504%204.487%204.83978%204.487%205.254V9.754C4.487%2010.1682%204.15121%2010.504%203.737%2010.504C3.32279%2010.504%202.987%2010.1682%202.987%209.754V5.254C2.987%204.83978%203.32279%204.504%203.737%204.504ZM5.987%205.254C5.987%204.83978%206.32279%204.504%206.737%204.504H9.737C10.1512%204.504%2010.487%204.83978%2010.487%205.254V9.754C10.487%2010.1682%2010.1512%2010.504%209.737%2010.504H6.737C6.32279%2010.504%205.987%2010.1682%205.987%209.754V5.254ZM7.487%206.004V9.004H8.987V6.004H7.487ZM12.737%204.504C13.1512%204.504%2013.487%204.83978%2013.487%205.254V9.754C13.487%2010.1682%2013.1512%2010.504%2012.737%2010.504C12.3228%2010.504%2011.987%2010.1682%2011.987%209.754V5.254C11.987%204.83978%2012.3228%204.504%2012.737%204.504ZM2.987%2012.754C2.987%2012.3398%203.32279%2012.004%203.737%2012.004H6.737C7.15121%2012.004%207.487%2012.3398%207.487%2012.754V17.254C7.487%2017.6682%207.15121%2018.004%206.737%2018.004H3.737C3.32279%2018.004%202.987%2017.6682%202.987%2017.254V12.754ZM4.487%2013.504V16.504H5.987V13.504H4.487Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M16.487%2012.004C13.5875%2012.004%2011.237%2014.3545%2011.237%2017.254C11.237%2020.1535%2013.5875%2022.504%2016.487%2022.504C19.3865%2022.504%2021.737%2020.1535%2021.737%2017.254C21.737%2014.3545%2019.3865%2012.004%2016.487%2012.004ZM9.737%2017.254C9.737%2013.5261%2012.7591%2010.504%2016.487%2010.504C20.2149%2010.504%2023.237%2013.5261%2023.237%2017.254C23.237%2020.9819%2020.2149%2024.004%2016.487%2024.004C12.7591%2024.004%209.737%2020.9819%209.737%2017.254Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20d%3D%22M19.237%2019.004V16.754C19.237%2016.0636%2018.6774%2015.504%2017.987%2015.504H14.237M15.737%2014.004L14.237%2015.504L15.737%2017.004%22%20stroke%3D%22%231D2632%22%20stroke-width%3D%221.5%22%20stroke-linecap%3D%22round%22%20stroke-linejoin%3D%22round%22%2F%3E%0A%3C%2Fg%3E%0A%3Cdefs%3E%0A%3CclipPath%20id%3D%22clip0_1_36401%22%3E%0A%3Crect%20width%3D%2224%22%20height%3D%2224%22%20fill%3D%22white%22%2F%3E%0A%3C%2FclipPath%3E%0A%3C%2Fdefs%3E%0A%3C%2Fsvg%3E%0A"
+	javaHeapFillIcon                 = "data:image/svg+xml,%3Csvg%20width%3D%2224%22%20height%3D%2224%22%20viewBox%3D%220%200%2024%2024%22%20fill%3D%22none%22%20xmlns%3D%22http%3A%2F%2Fwww.w3.org%2F2000%2Fsvg%22%3E%0A%3Cg%20clip-path%3D%22url%28%23clip0_1_36402%29%22%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M13.1524%201.56098C13.0614%201.52331%2012.9638%201.50395%2012.8653%201.504L2.237%201.504C2.03809%201.504%201.84732%201.58302%201.70667%201.72367C1.56602%201.86432%201.487%202.05509%201.487%202.254V18.754C1.487%2018.9529%201.56602%2019.1437%201.70667%2019.2843C1.84732%2019.425%202.03809%2019.504%202.237%2019.504H8.237C8.65121%2019.504%208.987%2019.8398%208.987%2020.254C8.987%2020.6682%208.65121%2021.004%208.237%2021.004H2.237C1.64026%2021.004%201.06797%2020.7669%200.646009%2020.345C0.224052%2019.923%20-0.0130005%2019.3507%20-0.0130005%2018.754V2.254C-0.0130005%201.65726%200.224053%201.08496%200.646009%200.663008C1.06797%200.241051%201.64026%200.00399785%202.237%200.00399785L12.8647%200.00399791C13.1601%200.00390862%2013.4529%200.0619923%2013.7259%200.174936C13.9989%200.287904%2014.247%200.453542%2014.4561%200.662391L17.33%203.53334C17.5392%203.74225%2017.7051%203.99033%2017.8184%204.26341C17.9316%204.53649%2017.9899%204.82921%2017.99%205.12484V8.254C17.99%208.66821%2017.6542%209.004%2017.24%209.004C16.8258%209.004%2016.49%208.66821%2016.49%208.254V5.12516C16.49%205.02667%2016.4705%204.92899%2016.4328%204.83802C16.395%204.74699%2016.3397%204.6643%2016.27%204.59466L13.3959%201.7236C13.3263%201.65397%2013.2434%201.59864%2013.1524%201.56098Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M3.737%204.504C4.15121%204.504%204.487%204.83978%204.487%205.254V9.754C4.487%2010.1682%204.15121%2010.504%203.737%2010.504C3.32279%2010.504%202.987%2010.1682%202.987%209.754V5.254C2.987%204.83978%203.32279%204.504%203.737%204.504ZM5.987%205.254C5.987%204.83978%206.32279%204.504%206.737%204.504H9.737C10.1512%204.504%2010.487%204.83978%2010.487%205.254V9.754C10.487%2010.1682%2010.1512%2010.504%209.737%2010.504H6.737C6.32279%2010.504%205.987%2010.1682%205.987%209.754V5.254ZM7.487%206.004V9.004H8.987V6.004H7.487ZM12.737%204.504C13.1512%204.504%2013.487%204.83978%2013.487%205.254V9.754C13.487%2010.1682%2013.1512%2010.504%2012.737%2010.504C12.3228%2010.504%2011.987%2010.1682%2011.987%209.754V5.254C11.987%204.83978%2012.3228%204.504%2012.737%204.504ZM2.987%2012.754C2.987%2012.3398%203.32279%2012.004%203.737%2012.004H6.737C7.15121%2012.004%207.487%2012.3398%207.487%2012.754V17.254C7.487%2017.6682%207.15121%2018.004%206.737%2018.004H3.737C3.32279%2018.004%202.987%2017.6682%202.987%2017.254V12.754ZM4.487%2013.504V16.504H5.987V13.504H4.487Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M16.487%2012.004C13.5875%2012.004%2011.237%2014.3545%2011.237%2017.254C11.237%2020.1535%2013.5875%2022.504%2016.487%2022.504C19.3865%2022.504%2021.737%2020.1535%2021.737%2017.254C21.737%2014.3545%2019.3865%2012.004%2016.487%2012.004ZM9.737%2017.254C9.737%2013.5261%2012.7591%2010.504%2016.487%2010.504C20.2149%2010.504%2023.237%2013.5261%2023.237%2017.254C23.237%2020.9819%2020.2149%2024.004%2016.487%2024.004C12.7591%2024.004%209.737%2020.9819%209.737%2017.254Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20d%3D%22M13.987%2019.504H18.987M13.987%2017.254H18.987M13.987%2015.004H16.487%22%20stroke%3D%22%231D2632%22%20stroke-width%3D%221.5%22%20stroke-linecap%3D%22round%22%20stroke-linejoin%3D%22round%22%2F%3E%0A%3C%2Fg%3E%0A%3Cdefs%3E%0A%3CclipPath%20id%3D%22clip0_1_36402%22%3E%0A%3Crect%20width%3D%2224%22%20height%3D%2224%22%20fill%3D%22white%22%2F%3E%0A%3C%2FclipPath%3E%0A%3C%2Fdefs%3E%0A%3C%2Fsvg%3E%0A"
+	javaCpuBurnIcon                  = "data:image/svg+xml,%3Csvg%20width%3D%2224%22%20height%3D%2224%22%20viewBox%3D%220%200%2024%2024%22%20fill%3D%22none%22%20xmlns%3D%22http%3A%2F%2Fwww.w3.org%2F2000%2Fsvg%22%3E%0A%3Cg%20clip-path%3D%22url%28%23clip0_1_36403%29%22%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M13.1524%201.56098C13.0614%201.52331%2012.9638%201.50395%2012.8653%201.504L2.237%201.504C2.03809%201.504%201.84732%201.58302%201.70667%201.72367C1.56602%201.86432%201.487%202.05509%201.487%202.254V18.754C1.487%2018.9529%201.56602%2019.1437%201.70667%2019.2843C1.84732%2019.425%202.03809%2019.504%202.237%2019.504H8.237C8.65121%2019.504%208.987%2019.8398%208.987%2020.254C8.987%2020.6682%208.65121%2021.004%208.237%2021.004H2.237C1.64026%2021.004%201.06797%2020.7669%200.646009%2020.345C0.224052%2019.923%20-0.0130005%2019.3507%20-0.0130005%2018.754V2.254C-0.0130005%201.65726%200.224053%201.08496%200.646009%200.663008C1.06797%200.241051%201.64026%200.00399785%202.237%200.00399785L12.8647%200.00399791C13.1601%200.00390862%2013.4529%200.0619923%2013.7259%200.174936C13.9989%200.287904%2014.247%200.453542%2014.4561%200.662391L17.33%203.53334C17.5392%203.74225%2017.7051%203.99033%2017.8184%204.26341C17.9316%204.53649%2017.9899%204.82921%2017.99%205.12484V8.254C17.99%208.66821%2017.6542%209.004%2017.24%209.004C16.8258%209.004%2016.49%208.66821%2016.49%208.254V5.12516C16.49%205.02667%2016.4705%204.92899%2016.4328%204.83802C16.395%204.74699%2016.3397%204.6643%2016.27%204.59466L13.3959%201.7236C13.3263%201.65397%2013.2434%201.59864%2013.1524%201.56098Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M3.737%204.504C4.15121%204.504%204.487%204.83978%204.487%205.254V9.754C4.487%2010.1682%204.15121%2010.504%203.737%2010.504C3.32279%2010.504%202.987%2010.1682%202.987%209.754V5.254C2.987%204.83978%203.32279%204.504%203.737%204.504ZM5.987%205.254C5.987%204.83978%206.32279%204.504%206.737%204.504H9.737C10.1512%204.504%2010.487%204.83978%2010.487%205.254V9.754C10.487%2010.1682%2010.1512%2010.504%209.737%2010.504H6.737C6.32279%2010.504%205.987%2010.1682%205.987%209.754V5.254ZM7.487%206.004V9.004H8.987V6.004H7.487ZM12.737%204.504C13.1512%204.504%2013.487%204.83978%2013.487%205.254V9.754C13.487%2010.1682%2013.1512%2010.504%2012.737%2010.504C12.3228%2010.504%2011.987%2010.1682%2011.987%209.754V5.254C11.987%204.83978%2012.3228%204.504%2012.737%204.504ZM2.987%2012.754C2.987%2012.3398%203.32279%2012.004%203.737%2012.004H6.737C7.15121%2012.004%207.487%2012.3398%207.487%2012.754V17.254C7.487%2017.6682%207.15121%2018.004%206.737%2018.004H3.737C3.32279%2018.004%202.987%2017.6682%202.987%2017.254V12.754ZM4.487%2013.504V16.504H5.987V13.504H4.487Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M16.487%2012.004C13.5875%2012.004%2011.237%2014.3545%2011.237%2017.254C11.237%2020.1535%2013.5875%2022.504%2016.487%2022.504C19.3865%2022.504%2021.737%2020.1535%2021.737%2017.254C21.737%2014.3545%2019.3865%2012.004%2016.487%2012.004ZM9.737%2017.254C9.737%2013.5261%2012.7591%2010.504%2016.487%2010.504C20.2149%2010.504%2023.237%2013.5261%2023.237%2017.254C23.237%2020.9819%2020.2149%2024.004%2016.487%2024.004C12.7591%2024.004%209.737%2020.9819%209.737%2017.254Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20d%3D%22M16.487%2019.504C17.5916%2019.504%2018.487%2018.6086%2018.487%2017.504C18.487%2016.004%2016.487%2014.504%2016.487%2014.504C16.487%2014.504%2014.487%2016.004%2014.487%2017.504C14.487%2018.6086%2015.3824%2019.504%2016.487%2019.504Z%22%20stroke%3D%22%231D2632%22%20stroke-width%3D%221.5%22%20stroke-linecap%3D%22round%22%20stroke-linejoin%3D%22round%22%2F%3E%0A%3C%2Fg%3E%0A%3Cdefs%3E%0A%3CclipPath%20id%3D%22clip0_1_36403%22%3E%0A%3Crect%20width%3D%2224%22%20height%3D%2224%22%20fill%3D%22white%22%2F%3E%0A%3C%2FclipPath%3E%0A%3C%2Fdefs%3E%0A%3C%2Fsvg%3E%0A"
+	javaThreadPoolExhaustionIcon     = "data:image/svg+xml,%3Csvg%20width%3D%2224%22%20height%3D%2224%22%20viewBox%3D%220%200%2024%2024%22%20fill%3D%22none%22%20xmlns%3D%22http%3A%2F%2Fwww.w3.org%2F2000%2Fsvg%22%3E%0A%3Cg%20clip-path%3D%22url%28%23clip0_1_36404%29%22%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M13.1524%201.56098C13.0614%201.52331%2012.9638%201.50395%2012.8653%201.504L2.237%201.504C2.03809%201.504%201.84732%201.58302%201.70667%201.72367C1.56602%201.86432%201.487%202.05509%201.487%202.254V18.754C1.487%2018.9529%201.56602%2019.1437%201.70667%2019.2843C1.84732%2019.425%202.03809%2019.504%202.237%2019.504H8.237C8.65121%2019.504%208.987%2019.8398%208.987%2020.254C8.987%2020.6682%208.65121%2021.004%208.237%2021.004H2.237C1.64026%2021.004%201.06797%2020.7669%200.646009%2020.345C0.224052%2019.923%20-0.0130005%2019.3507%20-0.0130005%2018.754V2.254C-0.0130005%201.65726%200.224053%201.08496%200.646009%200.663008C1.06797%200.241051%201.64026%200.00399785%202.237%200.00399785L12.8647%200.00399791C13.1601%200.00390862%2013.4529%200.0619923%2013.7259%200.174936C13.9989%200.287904%2014.247%200.453542%2014.4561%200.662391L17.33%203.53334C17.5392%203.74225%2017.7051%203.99033%2017.8184%204.26341C17.9316%204.53649%2017.9899%204.82921%2017.99%205.12484V8.254C17.99%208.66821%2017.6542%209.004%2017.24%209.004C16.8258%209.004%2016.49%208.66821%2016.49%208.254V5.12516C16.49%205.02667%2016.4705%204.92899%2016.4328%204.83802C16.395%204.74699%2016.3397%204.6643%2016.27%204.59466L13.3959%201.7236C13.3263%201.65397%2013.2434%201.59864%2013.1524%201.56098Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M3.737%204.504C4.15121%204.504%204.487%204.83978%204.487%205.254V9.754C4.487%2010.1682%204.15121%2010.504%203.737%2010.504C3.32279%2010.504%202.987%2010.1682%202.987%209.754V5.254C2.987%204.83978%203.32279%204.504%203.737%204.504ZM5.987%205.254C5.987%204.83978%206.32279%204.504%206.737%204.504H9.737C10.1512%204.504%2010.487%204.83978%2010.487%205.254V9.754C10.487%2010.1682%2010.1512%2010.504%209.737%2010.504H6.737C6.32279%2010.504%205.987%2010.1682%205.987%209.754V5.254ZM7.487%206.004V9.004H8.987V6.004H7.487ZM12.737%204.504C13.1512%204.504%2013.487%204.83978%2013.487%205.254V9.754C13.487%2010.1682%2013.1512%2010.504%2012.737%2010.504C12.3228%2010.504%2011.987%2010.1682%2011.987%209.754V5.254C11.987%204.83978%2012.3228%204.504%2012.737%204.504ZM2.987%2012.754C2.987%2012.3398%203.32279%2012.004%203.737%2012.004H6.737C7.15121%2012.004%207.487%2012.3398%207.487%2012.754V17.254C7.487%2017.6682%207.15121%2018.004%206.737%2018.004H3.737C3.32279%2018.004%202.987%2017.6682%202.987%2017.254V12.754ZM4.487%2013.504V16.504H5.987V13.504H4.487Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M16.487%2012.004C13.5875%2012.004%2011.237%2014.3545%2011.237%2017.254C11.237%2020.1535%2013.5875%2022.504%2016.487%2022.504C19.3865%2022.504%2021.737%2020.1535%2021.737%2017.254C21.737%2014.3545%2019.3865%2012.004%2016.487%2012.004ZM9.737%2017.254C9.737%2013.5261%2012.7591%2010.504%2016.487%2010.504C20.2149%2010.504%2023.237%2013.5261%2023.237%2017.254C23.237%2020.9819%2020.2149%2024.004%2016.487%2024.004C12.7591%2024.004%209.737%2020.9819%209.737%2017.254Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20d%3D%22M13.987%2015.004V19.504M16.487%2015.004V19.504M18.987%2015.004V19.504%22%20stroke%3D%22%231D2632%22%20stroke-width%3D%221.5%22%20stroke-linecap%3D%22round%22%20stroke-linejoin%3D%22round%22%2F%3E%0A%3C%2Fg%3E%0A%3Cdefs%3E%0A%3CclipPath%20id%3D%22clip0_1_36404%22%3E%0A%3Crect%20width%3D%2224%22%20height%3D%2224%22%20fill%3D%22white%22%2F%3E%0A%3C%2FclipPath%3E%0A%3C%2Fdefs%3E%0A%3C%2Fsvg%3E%0A"
+	javaMethodLockIcon               = "data:image/svg+xml,%3Csvg%20width%3D%2224%22%20height%3D%2224%22%20viewBox%3D%220%200%2024%2024%22%20fill%3D%22none%22%20xmlns%3D%22http%3A%2F%2Fwww.w3.org%2F2000%2Fsvg%22%3E%0A%3Cg%20clip-path%3D%22url%28%23clip0_1_36405%29%22%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M13.1524%201.56098C13.0614%201.52331%2012.9638%201.50395%2012.8653%201.504L2.237%201.504C2.03809%201.504%201.84732%201.58302%201.70667%201.72367C1.56602%201.86432%201.487%202.05509%201.487%202.254V18.754C1.487%2018.9529%201.56602%2019.1437%201.70667%2019.2843C1.84732%2019.425%202.03809%2019.504%202.237%2019.504H8.237C8.65121%2019.504%208.987%2019.8398%208.987%2020.254C8.987%2020.6682%208.65121%2021.004%208.237%2021.004H2.237C1.64026%2021.004%201.06797%2020.7669%200.646009%2020.345C0.224052%2019.923%20-0.0130005%2019.3507%20-0.0130005%2018.754V2.254C-0.0130005%201.65726%200.224053%201.08496%200.646009%200.663008C1.06797%200.241051%201.64026%200.00399785%202.237%200.00399785L12.8647%200.00399791C13.1601%200.00390862%2013.4529%200.0619923%2013.7259%200.174936C13.9989%200.287904%2014.247%200.453542%2014.4561%200.662391L17.33%203.53334C17.5392%203.74225%2017.7051%203.99033%2017.8184%204.26341C17.9316%204.53649%2017.9899%204.82921%2017.99%205.12484V8.254C17.99%208.66821%2017.6542%209.004%2017.24%209.004C16.8258%209.004%2016.49%208.66821%2016.49%208.254V5.12516C16.49%205.02667%2016.4705%204.92899%2016.4328%204.83802C16.395%204.74699%2016.3397%204.6643%2016.27%204.59466L13.3959%201.7236C13.3263%201.65397%2013.2434%201.59864%2013.1524%201.56098Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M3.737%204.504C4.15121%204.504%204.487%204.83978%204.487%205.254V9.754C4.487%2010.1682%204.15121%2010.504%203.737%2010.504C3.32279%2010.504%202.987%2010.1682%202.987%209.754V5.254C2.987%204.83978%203.32279%204.504%203.737%204.504ZM5.987%205.254C5.987%204.83978%206.32279%204.504%206.737%204.504H9.737C10.1512%204.504%2010.487%204.83978%2010.487%205.254V9.754C10.487%2010.1682%2010.1512%2010.504%209.737%2010.504H6.737C6.32279%2010.504%205.987%2010.1682%205.987%209.754V5.254ZM7.487%206.004V9.004H8.987V6.004H7.487ZM12.737%204.504C13.1512%204.504%2013.487%204.83978%2013.487%205.254V9.754C13.487%2010.1682%2013.1512%2010.504%2012.737%2010.504C12.3228%2010.504%2011.987%2010.1682%2011.987%209.754V5.254C11.987%204.83978%2012.3228%204.504%2012.737%204.504ZM2.987%2012.754C2.987%2012.3398%203.32279%2012.004%203.737%2012.004H6.737C7.15121%2012.004%207.487%2012.3398%207.487%2012.754V17.254C7.487%2017.6682%207.15121%2018.004%206.737%2018.004H3.737C3.32279%2018.004%202.987%2017.6682%202.987%2017.254V12.754ZM4.487%2013.504V16.504H5.987V13.504H4.487Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M16.487%2012.004C13.5875%2012.004%2011.237%2014.3545%2011.237%2017.254C11.237%2020.1535%2013.5875%2022.504%2016.487%2022.504C19.3865%2022.504%2021.737%2020.1535%2021.737%2017.254C21.737%2014.3545%2019.3865%2012.004%2016.487%2012.004ZM9.737%2017.254C9.737%2013.5261%2012.7591%2010.504%2016.487%2010.504C20.2149%2010.504%2023.237%2013.5261%2023.237%2017.254C23.237%2020.9819%2020.2149%2024.004%2016.487%2024.004C12.7591%2024.004%209.737%2020.9819%209.737%2017.254Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20d%3D%22M14.487%2016.504V15.004C14.487%2013.8994%2015.3824%2013.004%2016.487%2013.004C17.5916%2013.004%2018.487%2013.8994%2018.487%2015.004V16.504M13.987%2016.504H18.987V20.004H13.987V16.504Z%22%20stroke%3D%22%231D2632%22%20stroke-width%3D%221.5%22%20stroke-linecap%3D%22round%22%20stroke-linejoin%3D%22round%22%2F%3E%0A%3C%2Fg%3E%0A%3Cdefs%3E%0A%3CclipPath%20id%3D%22clip0_1_36405%22%3E%0A%3Crect%20width%3D%2224%22%20height%3D%2224%22%20fill%3D%22white%22%2F%3E%0A%3C%2FclipPath%3E%0A%3C%2Fdefs%3E%0A%3C%2Fsvg%3E%0A"
+	jdbcConnectionPoolExhaustionIcon = "data:image/svg+xml,%3Csvg%20width%3D%2224%22%20height%3D%2224%22%20viewBox%3D%220%200%2024%2024%22%20fill%3D%22none%22%20xmlns%3D%22http%3A%2F%2Fwww.w3.org%2F2000%2Fsvg%22%3E%0A%3Cg%20clip-path%3D%22url%28%23clip0_1_36406%29%22%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M2.14943%204.04445C1.67354%204.49448%201.5%204.90246%201.5%205.25101C1.5%205.59955%201.67354%206.00753%202.14943%206.45756C2.62689%206.90908%203.35803%207.35026%204.32368%207.73653C6.25081%208.5074%208.96451%209.00101%2012%209.00101C12.4142%209.00101%2012.75%209.33679%2012.75%209.75101C12.75%2010.1652%2012.4142%2010.501%2012%2010.501C8.8225%2010.501%205.91119%209.98711%203.76657%209.12924C2.69635%208.70113%201.77993%208.17263%201.11879%207.54742C0.456087%206.92073%200%206.14496%200%205.25101C0%204.35705%200.456087%203.58129%201.11879%202.95459C1.77993%202.32939%202.69635%201.80088%203.76657%201.37278C5.91119%200.514905%208.8225%200.00100708%2012%200.00100708C15.1775%200.00100708%2018.0888%200.514641%2020.2334%201.37239C21.3036%201.80043%2022.2201%202.32891%2022.8812%202.95419C23.544%203.58099%2024%204.35688%2024%205.25101C24%206.20743%2023.4789%207.02652%2022.7388%207.67651C21.9978%208.32736%2020.9716%208.87194%2019.775%209.30268C19.3853%209.44297%2018.9556%209.24077%2018.8153%208.85104C18.675%208.46131%2018.8772%208.03163%2019.267%207.89134C20.3594%207.49807%2021.1977%207.03366%2021.7489%206.5495C22.3011%206.0645%2022.5%205.62258%2022.5%205.25101C22.5%204.90214%2022.3264%204.49402%2021.8505%204.04401C21.3731%203.59248%2020.642%203.15133%2019.6764%202.76513C17.7492%201.99437%2015.0355%201.50101%2012%201.50101C8.96451%201.50101%206.25081%201.99461%204.32368%202.76549C3.35803%203.15176%202.62689%203.59294%202.14943%204.04445Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M23.25%204.50101C23.6642%204.50101%2024%204.83679%2024%205.25101V9.75101C24%2010.1652%2023.6642%2010.501%2023.25%2010.501C22.8358%2010.501%2022.5%2010.1652%2022.5%209.75101V5.25101C22.5%204.83679%2022.8358%204.50101%2023.25%204.50101Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M0.75%204.50101C1.16421%204.50101%201.5%204.83679%201.5%205.25101V11.251C1.5%2011.8022%201.95712%2012.514%203.22257%2013.2185C4.44336%2013.8981%206.21854%2014.4491%208.34237%2014.7504C8.75248%2014.8086%209.03776%2015.1883%208.97956%2015.5984C8.92136%2016.0085%208.54173%2016.2938%208.13163%2016.2356C5.89346%2015.918%203.92514%2015.3264%202.49293%2014.529C1.10538%2013.7566%200%2012.6568%200%2011.251V5.25101C0%204.83679%200.335786%204.50101%200.75%204.50101Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M0.75%2010.501C1.16421%2010.501%201.5%2010.8368%201.5%2011.251V17.251C1.5%2017.8358%202.01765%2018.5979%203.43374%2019.3319C4.79359%2020.0367%206.75734%2020.5861%209.0745%2020.8426C9.4862%2020.8881%209.78301%2021.2588%209.73745%2021.6705C9.69189%2022.0822%209.3212%2022.379%208.9095%2022.3335C6.47365%2022.0639%204.31641%2021.4788%202.74351%2020.6636C1.22685%2019.8776%200%2018.7342%200%2017.251V11.251C0%2010.8368%200.335786%2010.501%200.75%2010.501Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M17.25%2012.001C14.3505%2012.001%2012%2014.3515%2012%2017.251C12%2020.1505%2014.3505%2022.501%2017.25%2022.501C20.1495%2022.501%2022.5%2020.1505%2022.5%2017.251C22.5%2014.3515%2020.1495%2012.001%2017.25%2012.001ZM10.5%2017.251C10.5%2013.5231%2013.5221%2010.501%2017.25%2010.501C20.9779%2010.501%2024%2013.5231%2024%2017.251C24%2020.9789%2020.9779%2024.001%2017.25%2024.001C13.5221%2024.001%2010.5%2020.9789%2010.5%2017.251Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20d%3D%22M15.75%2015.001V19.501M18.75%2015.001V19.501%22%20stroke%3D%22%231D2632%22%20stroke-width%3D%221.5%22%20stroke-linecap%3D%22round%22%20stroke-linejoin%3D%22round%22%2F%3E%0A%3C%2Fg%3E%0A%3Cdefs%3E%0A%3CclipPath%20id%3D%22clip0_1_36406%22%3E%0A%3Crect%20width%3D%2224%22%20height%3D%2224%22%20fill%3D%22white%22%2F%3E%0A%3C%2FclipPath%3E%0A%3C%2Fdefs%3E%0A%3C%2Fsvg%3E%0A"
+	kafkaProducerDelayIcon           = "data:image/svg+xml,%3Csvg%20width%3D%2224%22%20height%3D%2224%22%20viewBox%3D%220%200%2024%2024%22%20fill%3D%22none%22%20xmlns%3D%22http%3A%2F%2Fwww.w3.org%2F2000%2Fsvg%22%3E%0A%3Cg%20clip-path%3D%22url%28%23clip0_1_36407%29%22%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M13.1524%201.56098C13.0614%201.52331%2012.9638%201.50395%2012.8653%201.504L2.237%201.504C2.03809%201.504%201.84732%201.58302%201.70667%201.72367C1.56602%201.86432%201.487%202.05509%201.487%202.254V18.754C1.487%2018.9529%201.56602%2019.1437%201.70667%2019.2843C1.84732%2019.425%202.03809%2019.504%202.237%2019.504H8.237C8.65121%2019.504%208.987%2019.8398%208.987%2020.254C8.987%2020.6682%208.65121%2021.004%208.237%2021.004H2.237C1.64026%2021.004%201.06797%2020.7669%200.646009%2020.345C0.224052%2019.923%20-0.0130005%2019.3507%20-0.0130005%2018.754V2.254C-0.0130005%201.65726%200.224053%201.08496%200.646009%200.663008C1.06797%200.241051%201.64026%200.00399785%202.237%200.00399785L12.8647%200.00399791C13.1601%200.00390862%2013.4529%200.0619923%2013.7259%200.174936C13.9989%200.287904%2014.247%200.453542%2014.4561%200.662391L17.33%203.53334C17.5392%203.74225%2017.7051%203.99033%2017.8184%204.26341C17.9316%204.53649%2017.9899%204.82921%2017.99%205.12484V8.254C17.99%208.66821%2017.6542%209.004%2017.24%209.004C16.8258%209.004%2016.49%208.66821%2016.49%208.254V5.12516C16.49%205.02667%2016.4705%204.92899%2016.4328%204.83802C16.395%204.74699%2016.3397%204.6643%2016.27%204.59466L13.3959%201.7236C13.3263%201.65397%2013.2434%201.59864%2013.1524%201.56098Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M3.737%204.504C4.15121%204.504%204.487%204.83978%204.487%205.254V9.754C4.487%2010.1682%204.15121%2010.504%203.737%2010.504C3.32279%2010.504%202.987%2010.1682%202.987%209.754V5.254C2.987%204.83978%203.32279%204.504%203.737%204.504ZM5.987%205.254C5.987%204.83978%206.32279%204.504%206.737%204.504H9.737C10.1512%204.504%2010.487%204.83978%2010.487%205.254V9.754C10.487%2010.1682%2010.1512%2010.504%209.737%2010.504H6.737C6.32279%2010.504%205.987%2010.1682%205.987%209.754V5.254ZM7.487%206.004V9.004H8.987V6.004H7.487ZM12.737%204.504C13.1512%204.504%2013.487%204.83978%2013.487%205.254V9.754C13.487%2010.1682%2013.1512%2010.504%2012.737%2010.504C12.3228%2010.504%2011.987%2010.1682%2011.987%209.754V5.254C11.987%204.83978%2012.3228%204.504%2012.737%204.504ZM2.987%2012.754C2.987%2012.3398%203.32279%2012.004%203.737%2012.004H6.737C7.15121%2012.004%207.487%2012.3398%207.487%2012.754V17.254C7.487%2017.6682%207.15121%2018.004%206.737%2018.004H3.737C3.32279%2018.004%202.987%2017.6682%202.987%2017.254V12.754ZM4.487%2013.504V16.504H5.987V13.504H4.487Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M16.487%2012.004C13.5875%2012.004%2011.237%2014.3545%2011.237%2017.254C11.237%2020.1535%2013.5875%2022.504%2016.487%2022.504C19.3865%2022.504%2021.737%2020.1535%2021.737%2017.254C21.737%2014.3545%2019.3865%2012.004%2016.487%2012.004ZM9.737%2017.254C9.737%2013.5261%2012.7591%2010.504%2016.487%2010.504C20.2149%2010.504%2023.237%2013.5261%2023.237%2017.254C23.237%2020.9819%2020.2149%2024.004%2016.487%2024.004C12.7591%2024.004%209.737%2020.9819%209.737%2017.254Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20d%3D%22M16.5%2014V16.5L18%2018M21%2016.5C21%2018.9853%2018.9853%2021%2016.5%2021C14.0147%2021%2012%2018.9853%2012%2016.5C12%2014.0147%2014.0147%2012%2016.5%2012C18.9853%2012%2021%2014.0147%2021%2016.5Z%22%20stroke%3D%22%231D2632%22%20stroke-width%3D%221.5%22%20stroke-linecap%3D%22round%22%20stroke-linejoin%3D%22round%22%2F%3E%0A%3C%2Fg%3E%0A%3Cdefs%3E%0A%3CclipPath%20id%3D%22clip0_1_36407%22%3E%0A%3Crect%20width%3D%2224%22%20height%3D%2224%22%20fill%3D%22white%22%2F%3E%0A%3C%2FclipPath%3E%0A%3C%2Fdefs%3E%0A%3C%2Fsvg%3E%0A"
+	kafkaProducerExceptionIcon       = "data:image/svg+xml,%3Csvg%20width%3D%2224%22%20height%3D%2224%22%20viewBox%3D%220%200%2024%2024%22%20fill%3D%22none%22%20xmlns%3D%22http%3A%2F%2Fwww.w3.org%2F2000%2Fsvg%22%3E%0A%3Cg%20clip-path%3D%22url%28%23clip0_1_36408%29%22%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M13.1524%201.56098C13.0614%201.52331%2012.9638%201.50395%2012.8653%201.504L2.237%201.504C2.03809%201.504%201.84732%201.58302%201.70667%201.72367C1.56602%201.86432%201.487%202.05509%201.487%202.254V18.754C1.487%2018.9529%201.56602%2019.1437%201.70667%2019.2843C1.84732%2019.425%202.03809%2019.504%202.237%2019.504H8.237C8.65121%2019.504%208.987%2019.8398%208.987%2020.254C8.987%2020.6682%208.65121%2021.004%208.237%2021.004H2.237C1.64026%2021.004%201.06797%2020.7669%200.646009%2020.345C0.224052%2019.923%20-0.0130005%2019.3507%20-0.0130005%2018.754V2.254C-0.0130005%201.65726%200.224053%201.08496%200.646009%200.663008C1.06797%200.241051%201.64026%200.00399785%202.237%200.00399785L12.8647%200.00399791C13.1601%200.00390862%2013.4529%200.0619923%2013.7259%200.174936C13.9989%200.287904%2014.247%200.453542%2014.4561%200.662391L17.33%203.53334C17.5392%203.74225%2017.7051%203.99033%2017.8184%204.26341C17.9316%204.53649%2017.9899%204.82921%2017.99%205.12484V8.254C17.99%208.66821%2017.6542%209.004%2017.24%209.004C16.8258%209.004%2016.49%208.66821%2016.49%208.254V5.12516C16.49%205.02667%2016.4705%204.92899%2016.4328%204.83802C16.395%204.74699%2016.3397%204.6643%2016.27%204.59466L13.3959%201.7236C13.3263%201.65397%2013.2434%201.59864%2013.1524%201.56098Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M3.737%204.504C4.15121%204.504%204.487%204.83978%204.487%205.254V9.754C4.487%2010.1682%204.15121%2010.504%203.737%2010.504C3.32279%2010.504%202.987%2010.1682%202.987%209.754V5.254C2.987%204.83978%203.32279%204.504%203.737%204.504ZM5.987%205.254C5.987%204.83978%206.32279%204.504%206.737%204.504H9.737C10.1512%204.504%2010.487%204.83978%2010.487%205.254V9.754C10.487%2010.1682%2010.1512%2010.504%209.737%2010.504H6.737C6.32279%2010.504%205.987%2010.1682%205.987%209.754V5.254ZM7.487%206.004V9.004H8.987V6.004H7.487ZM12.737%204.504C13.1512%204.504%2013.487%204.83978%2013.487%205.254V9.754C13.487%2010.1682%2013.1512%2010.504%2012.737%2010.504C12.3228%2010.504%2011.987%2010.1682%2011.987%209.754V5.254C11.987%204.83978%2012.3228%204.504%2012.737%204.504ZM2.987%2012.754C2.987%2012.3398%203.32279%2012.004%203.737%2012.004H6.737C7.15121%2012.004%207.487%2012.3398%207.487%2012.754V17.254C7.487%2017.6682%207.15121%2018.004%206.737%2018.004H3.737C3.32279%2018.004%202.987%2017.6682%202.987%2017.254V12.754ZM4.487%2013.504V16.504H5.987V13.504H4.487Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M16.487%2012.004C13.5875%2012.004%2011.237%2014.3545%2011.237%2017.254C11.237%2020.1535%2013.5875%2022.504%2016.487%2022.504C19.3865%2022.504%2021.737%2020.1535%2021.737%2017.254C21.737%2014.3545%2019.3865%2012.004%2016.487%2012.004ZM9.737%2017.254C9.737%2013.5261%2012.7591%2010.504%2016.487%2010.504C20.2149%2010.504%2023.237%2013.5261%2023.237%2017.254C23.237%2020.9819%2020.2149%2024.004%2016.487%2024.004C12.7591%2024.004%209.737%2020.9819%209.737%2017.254Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20d%3D%22M16.5%2015V17.5M16.5%2019.5V19.51M12%2021L16.5%2013L21%2021H12Z%22%20stroke%3D%22%231D2632%22%20stroke-width%3D%221.5%22%20stroke-linecap%3D%22round%22%20stroke-linejoin%3D%22round%22%2F%3E%0A%3C%2Fg%3E%0A%3Cdefs%3E%0A%3CclipPath%20id%3D%22clip0_1_36408%22%3E%0A%3Crect%20width%3D%2224%22%20height%3D%2224%22%20fill%3D%22white%22%2F%3E%0A%3C%2FclipPath%3E%0A%3C%2Fdefs%3E%0A%3C%2Fsvg%3E%0A"
+	kafkaConsumerPauseIcon           = "data:image/svg+xml,%3Csvg%20width%3D%2224%22%20height%3D%2224%22%20viewBox%3D%220%200%2024%2024%22%20fill%3D%22none%22%20xmlns%3D%22http%3A%2F%2Fwww.w3.org%2F2000%2Fsvg%22%3E%0A%3Cg%20clip-path%3D%22url%28%23clip0_1_36409%29%22%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M13.1524%201.56098C13.0614%201.52331%2012.9638%201.50395%2012.8653%201.504L2.237%201.504C2.03809%201.504%201.84732%201.58302%201.70667%201.72367C1.56602%201.86432%201.487%202.05509%201.487%202.254V18.754C1.487%2018.9529%201.56602%2019.1437%201.70667%2019.2843C1.84732%2019.425%202.03809%2019.504%202.237%2019.504H8.237C8.65121%2019.504%208.987%2019.8398%208.987%2020.254C8.987%2020.6682%208.65121%2021.004%208.237%2021.004H2.237C1.64026%2021.004%201.06797%2020.7669%200.646009%2020.345C0.224052%2019.923%20-0.0130005%2019.3507%20-0.0130005%2018.754V2.254C-0.0130005%201.65726%200.224053%201.08496%200.646009%200.663008C1.06797%200.241051%201.64026%200.00399785%202.237%200.00399785L12.8647%200.00399791C13.1601%200.00390862%2013.4529%200.0619923%2013.7259%200.174936C13.9989%200.287904%2014.247%200.453542%2014.4561%200.662391L17.33%203.53334C17.5392%203.74225%2017.7051%203.99033%2017.8184%204.26341C17.9316%204.53649%2017.9899%204.82921%2017.99%205.12484V8.254C17.99%208.66821%2017.6542%209.004%2017.24%209.004C16.8258%209.004%2016.49%208.66821%2016.49%208.254V5.12516C16.49%205.02667%2016.4705%204.92899%2016.4328%204.83802C16.395%204.74699%2016.3397%204.6643%2016.27%204.59466L13.3959%201.7236C13.3263%201.65397%2013.2434%201.59864%2013.1524%201.56098Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M3.737%204.504C4.15121%204.504%204.487%204.83978%204.487%205.254V9.754C4.487%2010.1682%204.15121%2010.504%203.737%2010.504C3.32279%2010.504%202.987%2010.1682%202.987%209.754V5.254C2.987%204.83978%203.32279%204.504%203.737%204.504ZM5.987%205.254C5.987%204.83978%206.32279%204.504%206.737%204.504H9.737C10.1512%204.504%2010.487%204.83978%2010.487%205.254V9.754C10.487%2010.1682%2010.1512%2010.504%209.737%2010.504H6.737C6.32279%2010.504%205.987%2010.1682%205.987%209.754V5.254ZM7.487%206.004V9.004H8.987V6.004H7.487ZM12.737%204.504C13.1512%204.504%2013.487%204.83978%2013.487%205.254V9.754C13.487%2010.1682%2013.1512%2010.504%2012.737%2010.504C12.3228%2010.504%2011.987%2010.1682%2011.987%209.754V5.254C11.987%204.83978%2012.3228%204.504%2012.737%204.504ZM2.987%2012.754C2.987%2012.3398%203.32279%2012.004%203.737%2012.004H6.737C7.15121%2012.004%207.487%2012.3398%207.487%2012.754V17.254C7.487%2017.6682%207.15121%2018.004%206.737%2018.004H3.737C3.32279%2018.004%202.987%2017.6682%202.987%2017.254V12.754ZM4.487%2013.504V16.504H5.987V13.504H4.487Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M16.487%2012.004C13.5875%2012.004%2011.237%2014.3545%2011.237%2017.254C11.237%2020.1535%2013.5875%2022.504%2016.487%2022.504C19.3865%2022.504%2021.737%2020.1535%2021.737%2017.254C21.737%2014.3545%2019.3865%2012.004%2016.487%2012.004ZM9.737%2017.254C9.737%2013.5261%2012.7591%2010.504%2016.487%2010.504C20.2149%2010.504%2023.237%2013.5261%2023.237%2017.254C23.237%2020.9819%2020.2149%2024.004%2016.487%2024.004C12.7591%2024.004%209.737%2020.9819%209.737%2017.254Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20d%3D%22M15%2013.5V19.5M18.5%2013.5V19.5%22%20stroke%3D%22%231D2632%22%20stroke-width%3D%221.5%22%20stroke-linecap%3D%22round%22%20stroke-linejoin%3D%22round%22%2F%3E%0A%3C%2Fg%3E%0A%3Cdefs%3E%0A%3CclipPath%20id%3D%22clip0_1_36409%22%3E%0A%3Crect%20width%3D%2224%22%20height%3D%2224%22%20fill%3D%22white%22%2F%3E%0A%3C%2FclipPath%3E%0A%3C%2Fdefs%3E%0A%3C%2Fsvg%3E%0A"
+	kafkaConsumerCommitDelayIcon     = "data:image/svg+xml,%3Csvg%20width%3D%2224%22%20height%3D%2224%22%20viewBox%3D%220%200%2024%2024%22%20fill%3D%22none%22%20xmlns%3D%22http%3A%2F%2Fwww.w3.org%2F2000%2Fsvg%22%3E%0A%3Cg%20clip-path%3D%22url%28%23clip0_1_36410%29%22%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M13.1524%201.56098C13.0614%201.52331%2012.9638%201.50395%2012.8653%201.504L2.237%201.504C2.03809%201.504%201.84732%201.58302%201.70667%201.72367C1.56602%201.86432%201.487%202.05509%201.487%202.254V18.754C1.487%2018.9529%201.56602%2019.1437%201.70667%2019.2843C1.84732%2019.425%202.03809%2019.504%202.237%2019.504H8.237C8.65121%2019.504%208.987%2019.8398%208.987%2020.254C8.987%2020.6682%208.65121%2021.004%208.237%2021.004H2.237C1.64026%2021.004%201.06797%2020.7669%200.646009%2020.345C0.224052%2019.923%20-0.0130005%2019.3507%20-0.0130005%2018.754V2.254C-0.0130005%201.65726%200.224053%201.08496%200.646009%200.663008C1.06797%200.241051%201.64026%200.00399785%202.237%200.00399785L12.8647%200.00399791C13.1601%200.00390862%2013.4529%200.0619923%2013.7259%200.174936C13.9989%200.287904%2014.247%200.453542%2014.4561%200.662391L17.33%203.53334C17.5392%203.74225%2017.7051%203.99033%2017.8184%204.26341C17.9316%204.53649%2017.9899%204.82921%2017.99%205.12484V8.254C17.99%208.66821%2017.6542%209.004%2017.24%209.004C16.8258%209.004%2016.49%208.66821%2016.49%208.254V5.12516C16.49%205.02667%2016.4705%204.92899%2016.4328%204.83802C16.395%204.74699%2016.3397%204.6643%2016.27%204.59466L13.3959%201.7236C13.3263%201.65397%2013.2434%201.59864%2013.1524%201.56098Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M3.737%204.504C4.15121%204.504%204.487%204.83978%204.487%205.254V9.754C4.487%2010.1682%204.15121%2010.504%203.737%2010.504C3.32279%2010.504%202.987%2010.1682%202.987%209.754V5.254C2.987%204.83978%203.32279%204.504%203.737%204.504ZM5.987%205.254C5.987%204.83978%206.32279%204.504%206.737%204.504H9.737C10.1512%204.504%2010.487%204.83978%2010.487%205.254V9.754C10.487%2010.1682%2010.1512%2010.504%209.737%2010.504H6.737C6.32279%2010.504%205.987%2010.1682%205.987%209.754V5.254ZM7.487%206.004V9.004H8.987V6.004H7.487ZM12.737%204.504C13.1512%204.504%2013.487%204.83978%2013.487%205.254V9.754C13.487%2010.1682%2013.1512%2010.504%2012.737%2010.504C12.3228%2010.504%2011.987%2010.1682%2011.987%209.754V5.254C11.987%204.83978%2012.3228%204.504%2012.737%204.504ZM2.987%2012.754C2.987%2012.3398%203.32279%2012.004%203.737%2012.004H6.737C7.15121%2012.004%207.487%2012.3398%207.487%2012.754V17.254C7.487%2017.6682%207.15121%2018.004%206.737%2018.004H3.737C3.32279%2018.004%202.987%2017.6682%202.987%2017.254V12.754ZM4.487%2013.504V16.504H5.987V13.504H4.487Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M16.487%2012.004C13.5875%2012.004%2011.237%2014.3545%2011.237%2017.254C11.237%2020.1535%2013.5875%2022.504%2016.487%2022.504C19.3865%2022.504%2021.737%2020.1535%2021.737%2017.254C21.737%2014.3545%2019.3865%2012.004%2016.487%2012.004ZM9.737%2017.254C9.737%2013.5261%2012.7591%2010.504%2016.487%2010.504C20.2149%2010.504%2023.237%2013.5261%2023.237%2017.254C23.237%2020.9819%2020.2149%2024.004%2016.487%2024.004C12.7591%2024.004%209.737%2020.9819%209.737%2017.254Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20d%3D%22M12.5%2017L14.75%2019.25L20.5%2013.5%22%20stroke%3D%22%231D2632%22%20stroke-width%3D%221.5%22%20stroke-linecap%3D%22round%22%20stroke-linejoin%3D%22round%22%2F%3E%0A%3C%2Fg%3E%0A%3Cdefs%3E%0A%3CclipPath%20id%3D%22clip0_1_36410%22%3E%0A%3Crect%20width%3D%2224%22%20height%3D%2224%22%20fill%3D%22white%22%2F%3E%0A%3C%2FclipPath%3E%0A%3C%2Fdefs%3E%0A%3C%2Fsvg%3E%0A"
+	redisCommandDelayIcon            = "data:image/svg+xml,%3Csvg%20width%3D%2224%22%20height%3D%2224%22%20viewBox%3D%220%200%2024%2024%22%20fill%3D%22none%22%20xmlns%3D%22http%3A%2F%2Fwww.w3.org%2F2000%2Fsvg%22%3E%0A%3Cg%20clip-path%3D%22url%28%23clip0_1_36411%29%22%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M13.1524%201.56098C13.0614%201.52331%2012.9638%201.50395%2012.8653%201.504L2.237%201.504C2.03809%201.504%201.84732%201.58302%201.70667%201.72367C1.56602%201.86432%201.487%202.05509%201.487%202.254V18.754C1.487%2018.9529%201.56602%2019.1437%201.70667%2019.2843C1.84732%2019.425%202.03809%2019.504%202.237%2019.504H8.237C8.65121%2019.504%208.987%2019.8398%208.987%2020.254C8.987%2020.6682%208.65121%2021.004%208.237%2021.004H2.237C1.64026%2021.004%201.06797%2020.7669%200.646009%2020.345C0.224052%2019.923%20-0.0130005%2019.3507%20-0.0130005%2018.754V2.254C-0.0130005%201.65726%200.224053%201.08496%200.646009%200.663008C1.06797%200.241051%201.64026%200.00399785%202.237%200.00399785L12.8647%200.00399791C13.1601%200.00390862%2013.4529%200.0619923%2013.7259%200.174936C13.9989%200.287904%2014.247%200.453542%2014.4561%200.662391L17.33%203.53334C17.5392%203.74225%2017.7051%203.99033%2017.8184%204.26341C17.9316%204.53649%2017.9899%204.82921%2017.99%205.12484V8.254C17.99%208.66821%2017.6542%209.004%2017.24%209.004C16.8258%209.004%2016.49%208.66821%2016.49%208.254V5.12516C16.49%205.02667%2016.4705%204.92899%2016.4328%204.83802C16.395%204.74699%2016.3397%204.6643%2016.27%204.59466L13.3959%201.7236C13.3263%201.65397%2013.2434%201.59864%2013.1524%201.56098Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M3.737%204.504C4.15121%204.504%204.487%204.83978%204.487%205.254V9.754C4.487%2010.1682%204.15121%2010.504%203.737%2010.504C3.32279%2010.504%202.987%2010.1682%202.987%209.754V5.254C2.987%204.83978%203.32279%204.504%203.737%204.504ZM5.987%205.254C5.987%204.83978%206.32279%204.504%206.737%204.504H9.737C10.1512%204.504%2010.487%204.83978%2010.487%205.254V9.754C10.487%2010.1682%2010.1512%2010.504%209.737%2010.504H6.737C6.32279%2010.504%205.987%2010.1682%205.987%209.754V5.254ZM7.487%206.004V9.004H8.987V6.004H7.487ZM12.737%204.504C13.1512%204.504%2013.487%204.83978%2013.487%205.254V9.754C13.487%2010.1682%2013.1512%2010.504%2012.737%2010.504C12.3228%2010.504%2011.987%2010.1682%2011.987%209.754V5.254C11.987%204.83978%2012.3228%204.504%2012.737%204.504ZM2.987%2012.754C2.987%2012.3398%203.32279%2012.004%203.737%2012.004H6.737C7.15121%2012.004%207.487%2012.3398%207.487%2012.754V17.254C7.487%2017.6682%207.15121%2018.004%206.737%2018.004H3.737C3.32279%2018.004%202.987%2017.6682%202.987%2017.254V12.754ZM4.487%2013.504V16.504H5.987V13.504H4.487Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M16.487%2012.004C13.5875%2012.004%2011.237%2014.3545%2011.237%2017.254C11.237%2020.1535%2013.5875%2022.504%2016.487%2022.504C19.3865%2022.504%2021.737%2020.1535%2021.737%2017.254C21.737%2014.3545%2019.3865%2012.004%2016.487%2012.004ZM9.737%2017.254C9.737%2013.5261%2012.7591%2010.504%2016.487%2010.504C20.2149%2010.504%2023.237%2013.5261%2023.237%2017.254C23.237%2020.9819%2020.2149%2024.004%2016.487%2024.004C12.7591%2024.004%209.737%2020.9819%209.737%2017.254Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20d%3D%22M17%2013.5a3.5%203.5%200%201%200%200%207%203.5%203.5%200%200%200%200-7zm.5%201.5v1.8l1.2%201.2-.7.7-1.5-1.5V15h1zM12%204c-2.8%200-5%20.9-5%202v6c0%201.1%202.2%202%205%202%20.5%200%201-.03%201.5-.1V12.9c-.5.07-1%20.1-1.5.1-2.2%200-4-.7-4-1.3V9.6c1%20.6%202.4.9%204%20.9s3-.3%204-.9v2.2c.35-.1.7-.2%201-.2V6c0-1.1-2.2-2-5-2zm0%201c2.2%200%204%20.6%204%201s-1.8%201-4%201-4-.6-4-1%201.8-1%204-1zm0%204.5c-2.2%200-4-.7-4-1.3V7.3c1%20.5%202.4.8%204%20.8s3-.3%204-.8v.9c0%20.6-1.8%201.3-4%201.3z%22%20fill%3D%22currentColor%22%2F%3E%0A%3C%2Fg%3E%0A%3Cdefs%3E%0A%3CclipPath%20id%3D%22clip0_1_36411%22%3E%0A%3Crect%20width%3D%2224%22%20height%3D%2224%22%20fill%3D%22white%22%2F%3E%0A%3C%2FclipPath%3E%0A%3C%2Fdefs%3E%0A%3C%2Fsvg%3E%0A"
+	redisCommandExceptionIcon        = "data:image/svg+xml,%3Csvg%20width%3D%2224%22%20height%3D%2224%22%20viewBox%3D%220%200%2024%2024%22%20fill%3D%22none%22%20xmlns%3D%22http%3A%2F%2Fwww.w3.org%2F2000%2Fsvg%22%3E%0A%3Cg%20clip-path%3D%22url%28%23clip0_1_36412%29%22%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M13.1524%201.56098C13.0614%201.52331%2012.9638%201.50395%2012.8653%201.504L2.237%201.504C2.03809%201.504%201.84732%201.58302%201.70667%201.72367C1.56602%201.86432%201.487%202.05509%201.487%202.254V18.754C1.487%2018.9529%201.56602%2019.1437%201.70667%2019.2843C1.84732%2019.425%202.03809%2019.504%202.237%2019.504H8.237C8.65121%2019.504%208.987%2019.8398%208.987%2020.254C8.987%2020.6682%208.65121%2021.004%208.237%2021.004H2.237C1.64026%2021.004%201.06797%2020.7669%200.646009%2020.345C0.224052%2019.923%20-0.0130005%2019.3507%20-0.0130005%2018.754V2.254C-0.0130005%201.65726%200.224053%201.08496%200.646009%200.663008C1.06797%200.241051%201.64026%200.00399785%202.237%200.00399785L12.8647%200.00399791C13.1601%200.00390862%2013.4529%200.0619923%2013.7259%200.174936C13.9989%200.287904%2014.247%200.453542%2014.4561%200.662391L17.33%203.53334C17.5392%203.74225%2017.7051%203.99033%2017.8184%204.26341C17.9316%204.53649%2017.9899%204.82921%2017.99%205.12484V8.254C17.99%208.66821%2017.6542%209.004%2017.24%209.004C16.8258%209.004%2016.49%208.66821%2016.49%208.254V5.12516C16.49%205.02667%2016.4705%204.92899%2016.4328%204.83802C16.395%204.74699%2016.3397%204.6643%2016.27%204.59466L13.3959%201.7236C13.3263%201.65397%2013.2434%201.59864%2013.1524%201.56098Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M3.737%204.504C4.15121%204.504%204.487%204.83978%204.487%205.254V9.754C4.487%2010.1682%204.15121%2010.504%203.737%2010.504C3.32279%2010.504%202.987%2010.1682%202.987%209.754V5.254C2.987%204.83978%203.32279%204.504%203.737%204.504ZM5.987%205.254C5.987%204.83978%206.32279%204.504%206.737%204.504H9.737C10.1512%204.504%2010.487%204.83978%2010.487%205.254V9.754C10.487%2010.1682%2010.1512%2010.504%209.737%2010.504H6.737C6.32279%2010.504%205.987%2010.1682%205.987%209.754V5.254ZM7.487%206.004V9.004H8.987V6.004H7.487ZM12.737%204.504C13.1512%204.504%2013.487%204.83978%2013.487%205.254V9.754C13.487%2010.1682%2013.1512%2010.504%2012.737%2010.504C12.3228%2010.504%2011.987%2010.1682%2011.987%209.754V5.254C11.987%204.83978%2012.3228%204.504%2012.737%204.504ZM2.987%2012.754C2.987%2012.3398%203.32279%2012.004%203.737%2012.004H6.737C7.15121%2012.004%207.487%2012.3398%207.487%2012.754V17.254C7.487%2017.6682%207.15121%2018.004%206.737%2018.004H3.737C3.32279%2018.004%202.987%2017.6682%202.987%2017.254V12.754ZM4.487%2013.504V16.504H5.987V13.504H4.487Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M16.487%2012.004C13.5875%2012.004%2011.237%2014.3545%2011.237%2017.254C11.237%2020.1535%2013.5875%2022.504%2016.487%2022.504C19.3865%2022.504%2021.737%2020.1535%2021.737%2017.254C21.737%2014.3545%2019.3865%2012.004%2016.487%2012.004ZM9.737%2017.254C9.737%2013.5261%2012.7591%2010.504%2016.487%2010.504C20.2149%2010.504%2023.237%2013.5261%2023.237%2017.254C23.237%2020.9819%2020.2149%2024.004%2016.487%2024.004C12.7591%2024.004%209.737%2020.9819%209.737%2017.254Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20d%3D%22M17.5%2013l-2.5%204h2l-1%204%203.5-5h-2l1-3h-1zM12%204c-2.8%200-5%20.9-5%202v6c0%201.1%202.2%202%205%202%20.5%200%201-.03%201.5-.1V12.9c-.5.07-1%20.1-1.5.1-2.2%200-4-.7-4-1.3V9.6c1%20.6%202.4.9%204%20.9s3-.3%204-.9v2.2c.35-.1.7-.2%201-.2V6c0-1.1-2.2-2-5-2zm0%201c2.2%200%204%20.6%204%201s-1.8%201-4%201-4-.6-4-1%201.8-1%204-1zm0%204.5c-2.2%200-4-.7-4-1.3V7.3c1%20.5%202.4.8%204%20.8s3-.3%204-.8v.9c0%20.6-1.8%201.3-4%201.3z%22%20fill%3D%22currentColor%22%2F%3E%0A%3C%2Fg%3E%0A%3Cdefs%3E%0A%3CclipPath%20id%3D%22clip0_1_36412%22%3E%0A%3Crect%20width%3D%2224%22%20height%3D%2224%22%20fill%3D%22white%22%2F%3E%0A%3C%2FclipPath%3E%0A%3C%2Fdefs%3E%0A%3C%2Fsvg%3E%0A"
+	grpcDelayIcon                    = "data:image/svg+xml,%3Csvg%20width%3D%2224%22%20height%3D%2224%22%20viewBox%3D%220%200%2024%2024%22%20fill%3D%22none%22%20xmlns%3D%22http%3A%2F%2Fwww.w3.org%2F2000%2Fsvg%22%3E%0A%3Cg%20clip-path%3D%22url%28%23clip0_1_36413%29%22%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M13.1524%201.56098C13.0614%201.52331%2012.9638%201.50395%2012.8653%201.504L2.237%201.504C2.03809%201.504%201.84732%201.58302%201.70667%201.72367C1.56602%201.86432%201.487%202.05509%201.487%202.254V18.754C1.487%2018.9529%201.56602%2019.1437%201.70667%2019.2843C1.84732%2019.425%202.03809%2019.504%202.237%2019.504H8.237C8.65121%2019.504%208.987%2019.8398%208.987%2020.254C8.987%2020.6682%208.65121%2021.004%208.237%2021.004H2.237C1.64026%2021.004%201.06797%2020.7669%200.646009%2020.345C0.224052%2019.923%20-0.0130005%2019.3507%20-0.0130005%2018.754V2.254C-0.0130005%201.65726%200.224053%201.08496%200.646009%200.663008C1.06797%200.241051%201.64026%200.00399785%202.237%200.00399785L12.8647%200.00399791C13.1601%200.00390862%2013.4529%200.0619923%2013.7259%200.174936C13.9989%200.287904%2014.247%200.453542%2014.4561%200.662391L17.33%203.53334C17.5392%203.74225%2017.7051%203.99033%2017.8184%204.26341C17.9316%204.53649%2017.9899%204.82921%2017.99%205.12484V8.254C17.99%208.66821%2017.6542%209.004%2017.24%209.004C16.8258%209.004%2016.49%208.66821%2016.49%208.254V5.12516C16.49%205.02667%2016.4705%204.92899%2016.4328%204.83802C16.395%204.74699%2016.3397%204.6643%2016.27%204.59466L13.3959%201.7236C13.3263%201.65397%2013.2434%201.59864%2013.1524%201.56098Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M3.737%204.504C4.15121%204.504%204.487%204.83978%204.487%205.254V9.754C4.487%2010.1682%204.15121%2010.504%203.737%2010.504C3.32279%2010.504%202.987%2010.1682%202.987%209.754V5.254C2.987%204.83978%203.32279%204.504%203.737%204.504ZM5.987%205.254C5.987%204.83978%206.32279%204.504%206.737%204.504H9.737C10.1512%204.504%2010.487%204.83978%2010.487%205.254V9.754C10.487%2010.1682%2010.1512%2010.504%209.737%2010.504H6.737C6.32279%2010.504%205.987%2010.1682%205.987%209.754V5.254ZM7.487%206.004V9.004H8.987V6.004H7.487ZM12.737%204.504C13.1512%204.504%2013.487%204.83978%2013.487%205.254V9.754C13.487%2010.1682%2013.1512%2010.504%2012.737%2010.504C12.3228%2010.504%2011.987%2010.1682%2011.987%209.754V5.254C11.987%204.83978%2012.3228%204.504%2012.737%204.504ZM2.987%2012.754C2.987%2012.3398%203.32279%2012.004%203.737%2012.004H6.737C7.15121%2012.004%207.487%2012.3398%207.487%2012.754V17.254C7.487%2017.6682%207.15121%2018.004%206.737%2018.004H3.737C3.32279%2018.004%202.987%2017.6682%202.987%2017.254V12.754ZM4.487%2013.504V16.504H5.987V13.504H4.487Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M16.487%2012.004C13.5875%2012.004%2011.237%2014.3545%2011.237%2017.254C11.237%2020.1535%2013.5875%2022.504%2016.487%2022.504C19.3865%2022.504%2021.737%2020.1535%2021.737%2017.254C21.737%2014.3545%2019.3865%2012.004%2016.487%2012.004ZM9.737%2017.254C9.737%2013.5261%2012.7591%2010.504%2016.487%2010.504C20.2149%2010.504%2023.237%2013.5261%2023.237%2017.254C23.237%2020.9819%2020.2149%2024.004%2016.487%2024.004C12.7591%2024.004%209.737%2020.9819%209.737%2017.254Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20d%3D%22M4%207h9.6l-2.3-2.3.7-.7L15.5%207.5%2012%2011l-.7-.7L13.6%208H4V7zm7%206.3L8.7%2015.6H14v1H8.7l2.3%202.3-.7.7L6.8%2016.1l3.5-3.5.7.7z%20M18%2012.5a3.5%203.5%200%201%200%200%207%203.5%203.5%200%200%200%200-7zm.5%201.5v1.8l1.2%201.2-.7.7-1.5-1.5V14h1z%22%20fill%3D%22currentColor%22%2F%3E%0A%3C%2Fg%3E%0A%3Cdefs%3E%0A%3CclipPath%20id%3D%22clip0_1_36413%22%3E%0A%3Crect%20width%3D%2224%22%20height%3D%2224%22%20fill%3D%22white%22%2F%3E%0A%3C%2FclipPath%3E%0A%3C%2Fdefs%3E%0A%3C%2Fsvg%3E%0A"
+	grpcStatusIcon                   = "data:image/svg+xml,%3Csvg%20width%3D%2224%22%20height%3D%2224%22%20viewBox%3D%220%200%2024%2024%22%20fill%3D%22none%22%20xmlns%3D%22http%3A%2F%2Fwww.w3.org%2F2000%2Fsvg%22%3E%0A%3Cg%20clip-path%3D%22url%28%23clip0_1_36414%29%22%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M13.1524%201.56098C13.0614%201.52331%2012.9638%201.50395%2012.8653%201.504L2.237%201.504C2.03809%201.504%201.84732%201.58302%201.70667%201.72367C1.56602%201.86432%201.487%202.05509%201.487%202.254V18.754C1.487%2018.9529%201.56602%2019.1437%201.70667%2019.2843C1.84732%2019.425%202.03809%2019.504%202.237%2019.504H8.237C8.65121%2019.504%208.987%2019.8398%208.987%2020.254C8.987%2020.6682%208.65121%2021.004%208.237%2021.004H2.237C1.64026%2021.004%201.06797%2020.7669%200.646009%2020.345C0.224052%2019.923%20-0.0130005%2019.3507%20-0.0130005%2018.754V2.254C-0.0130005%201.65726%200.224053%201.08496%200.646009%200.663008C1.06797%200.241051%201.64026%200.00399785%202.237%200.00399785L12.8647%200.00399791C13.1601%200.00390862%2013.4529%200.0619923%2013.7259%200.174936C13.9989%200.287904%2014.247%200.453542%2014.4561%200.662391L17.33%203.53334C17.5392%203.74225%2017.7051%203.99033%2017.8184%204.26341C17.9316%204.53649%2017.9899%204.82921%2017.99%205.12484V8.254C17.99%208.66821%2017.6542%209.004%2017.24%209.004C16.8258%209.004%2016.49%208.66821%2016.49%208.254V5.12516C16.49%205.02667%2016.4705%204.92899%2016.4328%204.83802C16.395%204.74699%2016.3397%204.6643%2016.27%204.59466L13.3959%201.7236C13.3263%201.65397%2013.2434%201.59864%2013.1524%201.56098Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M3.737%204.504C4.15121%204.504%204.487%204.83978%204.487%205.254V9.754C4.487%2010.1682%204.15121%2010.504%203.737%2010.504C3.32279%2010.504%202.987%2010.1682%202.987%209.754V5.254C2.987%204.83978%203.32279%204.504%203.737%204.504ZM5.987%205.254C5.987%204.83978%206.32279%204.504%206.737%204.504H9.737C10.1512%204.504%2010.487%204.83978%2010.487%205.254V9.754C10.487%2010.1682%2010.1512%2010.504%209.737%2010.504H6.737C6.32279%2010.504%205.987%2010.1682%205.987%209.754V5.254ZM7.487%206.004V9.004H8.987V6.004H7.487ZM12.737%204.504C13.1512%204.504%2013.487%204.83978%2013.487%205.254V9.754C13.487%2010.1682%2013.1512%2010.504%2012.737%2010.504C12.3228%2010.504%2011.987%2010.1682%2011.987%209.754V5.254C11.987%204.83978%2012.3228%204.504%2012.737%204.504ZM2.987%2012.754C2.987%2012.3398%203.32279%2012.004%203.737%2012.004H6.737C7.15121%2012.004%207.487%2012.3398%207.487%2012.754V17.254C7.487%2017.6682%207.15121%2018.004%206.737%2018.004H3.737C3.32279%2018.004%202.987%2017.6682%202.987%2017.254V12.754ZM4.487%2013.504V16.504H5.987V13.504H4.487Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20fill-rule%3D%22evenodd%22%20clip-rule%3D%22evenodd%22%20d%3D%22M16.487%2012.004C13.5875%2012.004%2011.237%2014.3545%2011.237%2017.254C11.237%2020.1535%2013.5875%2022.504%2016.487%2022.504C19.3865%2022.504%2021.737%2020.1535%2021.737%2017.254C21.737%2014.3545%2019.3865%2012.004%2016.487%2012.004ZM9.737%2017.254C9.737%2013.5261%2012.7591%2010.504%2016.487%2010.504C20.2149%2010.504%2023.237%2013.5261%2023.237%2017.254C23.237%2020.9819%2020.2149%2024.004%2016.487%2024.004C12.7591%2024.004%209.737%2020.9819%209.737%2017.254Z%22%20fill%3D%22%231D2632%22%2F%3E%0A%3Cpath%20d%3D%22M4%207h9.6l-2.3-2.3.7-.7L15.5%207.5%2012%2011l-.7-.7L13.6%208H4V7zm7%206.3L8.7%2015.6H14v1H8.7l2.3%202.3-.7.7L6.8%2016.1l3.5-3.5.7.7z%20M18%2012.5a3.5%203.5%200%201%200%200%207%203.5%203.5%200%200%200%200-7zm-1.4%201.4l1.4%201.4%201.4-1.4.7.7-1.4%201.4%201.4%201.4-.7.7-1.4-1.4-1.4%201.4-.7-.7%201.4-1.4-1.4-1.4.7-.7z%22%20fill%3D%22currentColor%22%2F%3E%0A%3C%2Fg%3E%0A%3Cdefs%3E%0A%3CclipPath%20id%3D%22clip0_1_36414%22%3E%0A%3Crect%20width%3D%2224%22%20height%3D%2224%22%20fill%3D%22white%22%2F%3E%0A%3C%2FclipPath%3E%0A%3C%2Fdefs%3E%0A%3C%2Fsvg%3E%0A"
 )
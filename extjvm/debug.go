@@ -0,0 +1,15 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjvm
+
+import (
+	"github.com/steadybit/extension-kit/exthttp"
+)
+
+// RegisterDebugHandlers registers endpoints exposing discovery details which don't fit into target attributes.
+func RegisterDebugHandlers(spring *SpringDiscovery, datasource *DataSourceDiscovery) {
+	exthttp.RegisterHttpHandler("/debug/spring/resilience", exthttp.GetterAsHandler(spring.getResilienceReports))
+	exthttp.RegisterHttpHandler("/debug/datasource/pools", exthttp.GetterAsHandler(datasource.getPools))
+}
@@ -23,9 +23,11 @@ import (
 )
 
 type jvmDiscovery struct {
-	jvms       jvmLister
-	datasource *DataSourceDiscovery
-	spring     *SpringDiscovery
+	jvms          jvmLister
+	datasource    *DataSourceDiscovery
+	agentCommands *AgentCommandDiscovery
+	spring        *SpringDiscovery
+	frameworks    *FrameworkDiscovery
 }
 
 var (
@@ -76,11 +78,13 @@ type jvmLister interface {
 	GetJvms() []jvm.JavaVm
 }
 
-func NewJvmDiscovery(jvms jvmLister, datasource *DataSourceDiscovery, spring *SpringDiscovery) discovery_kit_sdk.TargetDiscovery {
+func NewJvmDiscovery(jvms jvmLister, datasource *DataSourceDiscovery, agentCommands *AgentCommandDiscovery, spring *SpringDiscovery, frameworks *FrameworkDiscovery) discovery_kit_sdk.TargetDiscovery {
 	discovery := &jvmDiscovery{
-		jvms:       jvms,
-		datasource: datasource,
-		spring:     spring,
+		jvms:          jvms,
+		datasource:    datasource,
+		agentCommands: agentCommands,
+		spring:        spring,
+		frameworks:    frameworks,
 	}
 	return discovery_kit_sdk.NewCachedTargetDiscovery(discovery,
 		discovery_kit_sdk.WithRefreshTargetsNow(),
@@ -88,10 +92,12 @@ func NewJvmDiscovery(jvms jvmLister, datasource *DataSourceDiscovery, spring *Sp
 	)
 }
 
-func StartJvmInfrastructure() (func(), jvm.JavaFacade, *DataSourceDiscovery, *SpringDiscovery) {
+func StartJvmInfrastructure() (func(), jvm.JavaFacade, *DataSourceDiscovery, *AgentCommandDiscovery, *SpringDiscovery, *FrameworkDiscovery) {
 	facade := jvm.NewJavaFacade()
 	datasource := newDataSourceDiscovery(facade)
+	agentCommands := newAgentCommandDiscovery(facade, httpClientCommand, kafkaCommand, redisCommand, grpcCommand)
 	spring := newSpringDiscovery(facade)
+	frameworks := newFrameworkDiscovery(facade, quarkusFramework, micronautFramework, jaxrsFramework)
 
 	stop := func() {}
 
@@ -99,18 +105,22 @@ func StartJvmInfrastructure() (func(), jvm.JavaFacade, *DataSourceDiscovery, *Sp
 		stop = func() {
 			log.Info().Msg("Stopping all active discoveries")
 			datasource.stop()
+			agentCommands.stop()
 			spring.stop()
+			frameworks.stop()
 			facade.Stop()
 		}
 
 		facade.Start()
 		datasource.start()
+		agentCommands.start()
 		spring.start()
+		frameworks.start()
 	} else {
 		log.Warn().Msg("JVM attachment is disabled.")
 	}
 
-	return stop, facade, datasource, spring
+	return stop, facade, datasource, agentCommands, spring, frameworks
 }
 
 func (j *jvmDiscovery) Describe() discovery_kit_api.DiscoveryDescription {
@@ -319,6 +329,88 @@ func getJvmToContainerEnrichmentRule() discovery_kit_api.TargetEnrichmentRule {
 	}
 }
 
+// getJvmToLinkedTargetEnrichmentRule copies the location of the owning JVM onto targets created by
+// newJvmLinkedTarget, so they can be selected by namespace, deployment or container as well.
+func getJvmToLinkedTargetEnrichmentRule(id string, linkedTargetType string) discovery_kit_api.TargetEnrichmentRule {
+	return discovery_kit_api.TargetEnrichmentRule{
+		Id:      id,
+		Version: extbuild.GetSemverVersionStringOrUnknown(),
+		Src: discovery_kit_api.SourceOrDestination{
+			Type: targetType,
+			Selector: map[string]string{
+				"host.hostname": "${dest.host.hostname}",
+				"process.pid":   "${dest.process.pid}",
+			},
+		},
+		Dest: discovery_kit_api.SourceOrDestination{
+			Type: linkedTargetType,
+			Selector: map[string]string{
+				"host.hostname": "${src.host.hostname}",
+				"process.pid":   "${src.process.pid}",
+			},
+		},
+		Attributes: []discovery_kit_api.Attribute{
+			{
+				Matcher: discovery_kit_api.Equals,
+				Name:    "k8s.cluster-name",
+			},
+			{
+				Matcher: discovery_kit_api.Equals,
+				Name:    "k8s.namespace",
+			},
+			{
+				Matcher: discovery_kit_api.Equals,
+				Name:    "k8s.deployment",
+			},
+			{
+				Matcher: discovery_kit_api.Equals,
+				Name:    "k8s.pod.name",
+			},
+			{
+				Matcher: discovery_kit_api.Equals,
+				Name:    "k8s.service.name",
+			},
+			{
+				Matcher: discovery_kit_api.Equals,
+				Name:    "container.name",
+			},
+		},
+	}
+}
+
+// newJvmLinkedTarget creates a target for a part of the given JVM, e.g. a datasource, carrying the attributes to link it
+// back to the JVM instance.
+func newJvmLinkedTarget(javaVm jvm.JavaVm, spring *SpringDiscovery, linkedTargetType string, id string, label string) discovery_kit_api.Target {
+	jvmId := fmt.Sprintf("%s/%d", javaVm.Hostname(), javaVm.Pid())
+	target := discovery_kit_api.Target{
+		Id:         fmt.Sprintf("%s/%s", jvmId, id),
+		TargetType: linkedTargetType,
+		Label:      label,
+		Attributes: map[string][]string{
+			"jvm-instance.id": {jvmId},
+			"process.pid":     {strconv.Itoa(int(javaVm.Pid()))},
+			"host.hostname":   {javaVm.Hostname()},
+		},
+	}
+
+	var names []string
+	if name := getApplicationName(javaVm); name != "" {
+		names = append(names, name)
+	}
+	if app := spring.findApplication(javaVm.Pid()); app != nil && app.Name != "" {
+		names = utils.AppendIfMissing(names, app.Name)
+		target.Attributes["spring-instance.name"] = []string{app.Name}
+	}
+	if len(names) > 0 {
+		slices.Sort(names)
+		target.Attributes["jvm-instance.name"] = names
+	}
+	if c, ok := javaVm.(jvm.JavaVmInContainer); ok {
+		target.Attributes["container.id.stripped"] = []string{c.ContainerId()}
+	}
+	return target
+}
+
 func (j *jvmDiscovery) DescribeAttributes() []discovery_kit_api.AttributeDescription {
 	return []discovery_kit_api.AttributeDescription{
 		{
@@ -341,6 +433,13 @@ func (j *jvmDiscovery) DescribeAttributes() []discovery_kit_api.AttributeDescrip
 				Other: "Instance Hostnames",
 			},
 		},
+		{
+			Attribute: "jvm-instance.id",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "JVM instance",
+				Other: "JVM instances",
+			},
+		},
 		{
 			Attribute: "process.pid",
 			Label: discovery_kit_api.PluralLabel{
@@ -376,6 +475,251 @@ func (j *jvmDiscovery) DescribeAttributes() []discovery_kit_api.AttributeDescrip
 				Other: "deployment names",
 			},
 		},
+		{
+			Attribute: "quarkus-instance.name",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Quarkus instance name",
+				Other: "Quarkus instance names",
+			},
+		},
+		{
+			Attribute: "quarkus-instance.endpoint",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Quarkus endpoint",
+				Other: "Quarkus endpoints",
+			},
+		},
+		{
+			Attribute: "quarkus-instance.endpoint.method",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Quarkus endpoint method",
+				Other: "Quarkus endpoint methods",
+			},
+		},
+		{
+			Attribute: "quarkus-instance.endpoint.handler-class",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Quarkus endpoint handler class",
+				Other: "Quarkus endpoint handler classes",
+			},
+		},
+		{
+			Attribute: "micronaut-instance.name",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Micronaut instance name",
+				Other: "Micronaut instance names",
+			},
+		},
+		{
+			Attribute: "micronaut-instance.endpoint",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Micronaut endpoint",
+				Other: "Micronaut endpoints",
+			},
+		},
+		{
+			Attribute: "micronaut-instance.endpoint.method",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Micronaut endpoint method",
+				Other: "Micronaut endpoint methods",
+			},
+		},
+		{
+			Attribute: "micronaut-instance.endpoint.handler-class",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Micronaut endpoint handler class",
+				Other: "Micronaut endpoint handler classes",
+			},
+		},
+		{
+			Attribute: "jaxrs-instance.endpoint",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "JAX-RS endpoint",
+				Other: "JAX-RS endpoints",
+			},
+		},
+		{
+			Attribute: "jaxrs-instance.endpoint.method",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "JAX-RS endpoint method",
+				Other: "JAX-RS endpoint methods",
+			},
+		},
+		{
+			Attribute: "jaxrs-instance.endpoint.handler-class",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "JAX-RS resource class",
+				Other: "JAX-RS resource classes",
+			},
+		},
+		{
+			Attribute: "spring-instance.mvc-mapping.mode",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Spring MVC mapping discovery mode",
+				Other: "Spring MVC mapping discovery modes",
+			},
+		},
+		{
+			Attribute: "spring-instance.spring-boot-version",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Spring Boot version",
+				Other: "Spring Boot versions",
+			},
+		},
+		{
+			Attribute: "spring-instance.spring-framework-version",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Spring Framework version",
+				Other: "Spring Framework versions",
+			},
+		},
+		{
+			Attribute: "spring-instance.active-profile",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Active profile",
+				Other: "Active profiles",
+			},
+		},
+		{
+			Attribute: "spring-instance.server.port",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Server port",
+				Other: "Server ports",
+			},
+		},
+		{
+			Attribute: "spring-instance.management.port",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Management port",
+				Other: "Management ports",
+			},
+		},
+		{
+			Attribute: "spring-instance.git.commit",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Git commit",
+				Other: "Git commits",
+			},
+		},
+		{
+			Attribute: "spring-instance.build.version",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Build version",
+				Other: "Build versions",
+			},
+		},
+		{
+			Attribute: "spring-instance.resilience.findings",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Resilience finding",
+				Other: "Resilience findings",
+			},
+		},
+		{
+			Attribute: "spring-instance.resilience.score",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Resilience score",
+				Other: "Resilience scores",
+			},
+		},
+		{
+			Attribute: "spring-instance.resilience.circuit-breakers",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Circuit breaker",
+				Other: "Circuit breakers",
+			},
+		},
+		{
+			Attribute: "spring-instance.resilience.retries",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Retry",
+				Other: "Retries",
+			},
+		},
+		{
+			Attribute: "spring-instance.resilience.bulkheads",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Bulkhead",
+				Other: "Bulkheads",
+			},
+		},
+		{
+			Attribute: "spring-instance.resilience.time-limiters",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Time limiter",
+				Other: "Time limiters",
+			},
+		},
+		{
+			Attribute: "spring-instance.resilience.rate-limiters",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Rate limiter",
+				Other: "Rate limiters",
+			},
+		},
+		{
+			Attribute: "spring-instance.resilience.fallbacks",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Fallback",
+				Other: "Fallbacks",
+			},
+		},
+		{
+			Attribute: "spring-instance.dependency",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Service dependency",
+				Other: "Service dependencies",
+			},
+		},
+		{
+			Attribute: "spring-instance.dependency.edge",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Service dependency edge",
+				Other: "Service dependency edges",
+			},
+		},
+		{
+			Attribute: "spring-instance.dependent",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Dependent service",
+				Other: "Dependent services",
+			},
+		},
+		{
+			Attribute: "kafka.bootstrap-servers",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Kafka bootstrap server",
+				Other: "Kafka bootstrap servers",
+			},
+		},
+		{
+			Attribute: "kafka.topics",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Kafka topic",
+				Other: "Kafka topics",
+			},
+		},
+		{
+			Attribute: "redis.uri",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Redis URI",
+				Other: "Redis URIs",
+			},
+		},
+		{
+			Attribute: "grpc.service",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "gRPC service",
+				Other: "gRPC services",
+			},
+		},
+		{
+			Attribute: "grpc.client-service",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Called gRPC service",
+				Other: "Called gRPC services",
+			},
+		},
 	}
 }
 
@@ -407,7 +751,10 @@ func (j *jvmDiscovery) DiscoverTargets(_ context.Context) ([]discovery_kit_api.T
 	}
 
 	j.enhanceTargetsWithSpringAttributes(targets)
+	j.enhanceTargetsWithFrameworkAttributes(targets)
 	j.enhanceTargetsWithDataSourceAttributes(targets)
+	j.enhanceTargetsWithAgentCommandAttributes(targets)
+	j.enhanceTargetsWithDependencies(targets)
 	return discovery_kit_commons.ApplyAttributeExcludes(targets, config.Config.DiscoveryAttributesExcludesJVM), nil
 }
 
@@ -417,6 +764,12 @@ func (j *jvmDiscovery) enhanceTargetsWithDataSourceAttributes(targets []discover
 		if targetIndex != -1 {
 			for _, dataSourceConnection := range app.DataSourceConnections {
 				targets[targetIndex].Attributes["datasource.jdbc-url"] = append(targets[targetIndex].Attributes["datasource.jdbc-url"], dataSourceConnection.JdbcUrl)
+				if dataSourceConnection.MaxPoolSize != nil {
+					targets[targetIndex].Attributes["datasource.pool.max-size-by-jdbc-url"] = append(targets[targetIndex].Attributes["datasource.pool.max-size-by-jdbc-url"], fmt.Sprintf("%s=%d", dataSourceConnection.JdbcUrl, *dataSourceConnection.MaxPoolSize))
+				}
+				if dataSourceConnection.ActiveConnections != nil {
+					targets[targetIndex].Attributes["datasource.pool.active-by-jdbc-url"] = append(targets[targetIndex].Attributes["datasource.pool.active-by-jdbc-url"], fmt.Sprintf("%s=%d", dataSourceConnection.JdbcUrl, *dataSourceConnection.ActiveConnections))
+				}
 			}
 		}
 	}
@@ -442,12 +795,39 @@ func (j *jvmDiscovery) enhanceTargetsWithSpringAttributes(targets []discovery_ki
 			if app.UsingHttpClient {
 				targets[targetIndex].Attributes["spring-instance.http-client"] = []string{"true"}
 			}
+			addSpringEnvironment(&targets[targetIndex], app.Environment)
 			addMvcMappings(&targets[targetIndex], app.MvcMappings)
+			if app.MvcMappingsMode != "" {
+				targets[targetIndex].Attributes["spring-instance.mvc-mapping.mode"] = []string{app.MvcMappingsMode}
+			}
 			addHttpClientRequests(&targets[targetIndex], app.HttpClientRequests)
+			if len(app.ThreadPools) > 0 {
+				targets[targetIndex].Attributes["spring-instance.thread-pool"] = app.ThreadPools
+			}
+			addResilienceReport(&targets[targetIndex], buildResilienceReport(app))
 		}
 	}
 }
 
+func addSpringEnvironment(target *discovery_kit_api.Target, environment SpringEnvironment) {
+	values := map[string]string{
+		"spring-instance.spring-boot-version":      environment.BootVersion,
+		"spring-instance.spring-framework-version": environment.FrameworkVersion,
+		"spring-instance.server.port":              environment.ServerPort,
+		"spring-instance.management.port":          environment.ManagementPort,
+		"spring-instance.git.commit":               environment.GitCommit,
+		"spring-instance.build.version":            environment.BuildVersion,
+	}
+	for attribute, value := range values {
+		if value != "" {
+			target.Attributes[attribute] = []string{value}
+		}
+	}
+	if len(environment.ActiveProfiles) > 0 {
+		target.Attributes["spring-instance.active-profile"] = environment.ActiveProfiles
+	}
+}
+
 func addHttpClientRequests(target *discovery_kit_api.Target, requests []HttpRequest) {
 	if len(requests) == 0 {
 		return
@@ -0,0 +1,175 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjvm
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+
+	"codnect.io/chrono"
+	"github.com/rs/zerolog/log"
+	"github.com/steadybit/discovery-kit/go/discovery_kit_api"
+	"github.com/steadybit/extension-jvm/chrono_utils"
+	"github.com/steadybit/extension-jvm/extjvm/jvm"
+)
+
+// agentCommand is a discovery of a client library by a command of the agent plugin shared with the DataSource
+// discovery, the plugin is loaded as soon as one of the marker classes is present. The response of the command is
+// mapped to attributes of the JVM target.
+type agentCommand struct {
+	Name          string
+	Command       string
+	MarkerClasses []string
+	// Attributes decodes the response, nothing has been discovered if no attributes are returned
+	Attributes func(response io.Reader) (map[string][]string, error)
+}
+
+// sortedAttributes sorts the values and drops the attributes without values.
+func sortedAttributes(attributes map[string][]string) map[string][]string {
+	for key, values := range attributes {
+		if len(values) == 0 {
+			delete(attributes, key)
+			continue
+		}
+		slices.Sort(values)
+	}
+	return attributes
+}
+
+type agentCommandApplication struct {
+	Pid        int32
+	Command    string
+	Attributes map[string][]string
+}
+
+type agentCommandApplicationKey struct {
+	Pid     int32
+	Command string
+}
+
+type AgentCommandDiscovery struct {
+	facade       jvm.JavaFacade
+	commands     []agentCommand
+	scheduler    chrono.TaskScheduler
+	applications sync.Map // map[agentCommandApplicationKey]agentCommandApplication
+	tasks        sync.Map // map[Pid int32]discoveryTask
+}
+
+func newAgentCommandDiscovery(facade jvm.JavaFacade, commands ...agentCommand) *AgentCommandDiscovery {
+	return &AgentCommandDiscovery{facade: facade, commands: commands, scheduler: chrono_utils.NewContextTaskScheduler()}
+}
+
+func (d *AgentCommandDiscovery) Attached(jvm jvm.JavaVm) {
+	d.scheduleDiscover(jvm)
+}
+
+func (d *AgentCommandDiscovery) Detached(jvm jvm.JavaVm) {
+	d.cancelDiscover(jvm)
+	for _, c := range d.commands {
+		d.applications.Delete(agentCommandApplicationKey{Pid: jvm.Pid(), Command: c.Command})
+	}
+}
+
+func (d *AgentCommandDiscovery) getApplications() []agentCommandApplication {
+	var result []agentCommandApplication
+	d.applications.Range(func(key, value any) bool {
+		result = append(result, value.(agentCommandApplication))
+		return true
+	})
+	return result
+}
+
+func (d *AgentCommandDiscovery) start() {
+	for _, c := range d.commands {
+		for _, markerClass := range c.MarkerClasses {
+			d.facade.AddAutoloadAgentPlugin(dataSourcePlugin, markerClass)
+		}
+	}
+	d.facade.AddAttachedListener(d)
+}
+
+func (d *AgentCommandDiscovery) stop() {
+	d.facade.RemoveAttachedListener(d)
+	for _, c := range d.commands {
+		for _, markerClass := range c.MarkerClasses {
+			d.facade.RemoveAutoloadAgentPlugin(dataSourcePlugin, markerClass)
+		}
+	}
+	<-d.scheduler.Shutdown()
+	d.tasks = sync.Map{}
+}
+
+func (d *AgentCommandDiscovery) cancelDiscover(vm jvm.JavaVm) {
+	if t, ok := d.tasks.LoadAndDelete(vm.Pid()); ok {
+		t.(*discoveryTask).cancel()
+	}
+}
+
+func (d *AgentCommandDiscovery) scheduleDiscover(javaVm jvm.JavaVm) {
+	t := &discoveryTask{}
+
+	err := t.scheduleOn(d.scheduler, func() {
+		d.discover(javaVm)
+	})
+	if err != nil {
+		log.Error().Err(err).Msgf("Failed to schedule agent command discovery for JVM: %s", javaVm.ToInfoString())
+	}
+
+	d.tasks.Store(javaVm.Pid(), t)
+}
+
+func (d *AgentCommandDiscovery) discover(javaVm jvm.JavaVm) {
+	if !d.facade.HasAgentPlugin(javaVm, dataSourcePlugin) {
+		return
+	}
+
+	for _, c := range d.commands {
+		attributes, err := d.readAttributes(javaVm, c)
+		if err != nil {
+			log.Error().Err(err).Msgf("Failed to read %s on PID %d", c.Name, javaVm.Pid())
+			continue
+		}
+
+		key := agentCommandApplicationKey{Pid: javaVm.Pid(), Command: c.Command}
+		if len(attributes) == 0 {
+			// the clients might be gone, don't keep reporting the attributes of a previous discovery
+			d.applications.Delete(key)
+			continue
+		}
+		_, loaded := d.applications.Swap(key, agentCommandApplication{Pid: javaVm.Pid(), Command: c.Command, Attributes: attributes})
+		if !loaded {
+			log.Debug().Msgf("%s discovered on PID %d: %+v", c.Name, javaVm.Pid(), attributes)
+		}
+	}
+}
+
+func (d *AgentCommandDiscovery) readAttributes(javaVm jvm.JavaVm, c agentCommand) (map[string][]string, error) {
+	attributes, err := d.facade.SendCommandToAgentWithHandler(javaVm, c.Command, "", func(response io.Reader) (any, error) {
+		attributes, err := c.Attributes(response)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s response: %w", c.Command, err)
+		}
+		return attributes, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug().Msgf("Command '%s:%s' to agent on PID %d returned: %+v", c.Command, "", javaVm.Pid(), attributes)
+	return attributes.(map[string][]string), nil
+}
+
+func (j *jvmDiscovery) enhanceTargetsWithAgentCommandAttributes(targets []discovery_kit_api.Target) {
+	for _, app := range j.agentCommands.getApplications() {
+		targetIndex := findTargetByPid(targets, app.Pid)
+		if targetIndex != -1 {
+			for key, values := range app.Attributes {
+				targets[targetIndex].Attributes[key] = slices.Clone(values)
+			}
+		}
+	}
+}
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: MIT
+// SPDX-FileCopyrightText: 2026 Steadybit GmbH
+
+package extjvm
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/steadybit/discovery-kit/go/discovery_kit_api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_agentCommand_Attributes(t *testing.T) {
+	tests := []struct {
+		name     string
+		command  agentCommand
+		response string
+		want     map[string][]string
+	}{
+		{
+			name:     "http client",
+			command:  httpClientCommand,
+			response: `["orders:8080","billing:443"]`,
+			want:     map[string][]string{"java-instance.http-outgoing-calls": {"billing:443", "orders:8080"}},
+		},
+		{
+			name:     "kafka",
+			command:  kafkaCommand,
+			response: `{"bootstrapServers":["kafka:9092"],"topics":["payments","orders"]}`,
+			want:     map[string][]string{"kafka.bootstrap-servers": {"kafka:9092"}, "kafka.topics": {"orders", "payments"}},
+		},
+		{
+			name:     "kafka without bootstrap servers",
+			command:  kafkaCommand,
+			response: `{"bootstrapServers":[],"topics":["orders"]}`,
+			want:     nil,
+		},
+		{
+			name:     "redis",
+			command:  redisCommand,
+			response: `["redis://cache:6379"]`,
+			want:     map[string][]string{"redis.uri": {"redis://cache:6379"}},
+		},
+		{
+			name:     "grpc client only",
+			command:  grpcCommand,
+			response: `{"services":[],"clientServices":["shop.Orders"]}`,
+			want:     map[string][]string{"grpc.client-service": {"shop.Orders"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attributes, err := tt.command.Attributes(strings.NewReader(tt.response))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, attributes)
+		})
+	}
+}
+
+func Test_AgentCommandDiscovery_discover(t *testing.T) {
+	facade := &mockJavaFacade{}
+	fake, err := facade.startFakeJvm()
+	require.NoError(t, err)
+	defer func(fake *FakeJvm) {
+		_ = fake.stop()
+	}(fake)
+
+	facade.On("HasAgentPlugin", mock.Anything, dataSourcePlugin).Return(true)
+	responses := map[string]string{}
+	respond := func(command string) {
+		call := facade.On("SendCommandToAgentWithHandler", mock.Anything, command, "", mock.Anything)
+		call.Run(func(args mock.Arguments) {
+			handler := args.Get(3).(func(response io.Reader) (any, error))
+			result, err := handler(strings.NewReader(responses[command]))
+			call.ReturnArguments = mock.Arguments{result, err}
+		})
+	}
+	respond(redisCommand.Command)
+	respond(grpcCommand.Command)
+	responses[redisCommand.Command] = `["redis://cache:6379"]`
+	responses[grpcCommand.Command] = `{"services":[],"clientServices":[]}`
+
+	discovery := &AgentCommandDiscovery{facade: facade, commands: []agentCommand{redisCommand, grpcCommand}}
+	discovery.discover(fake)
+
+	pid := fake.getTarget().Attributes["process.pid"][0]
+	targets := []discovery_kit_api.Target{{Attributes: map[string][]string{"process.pid": {pid}}}}
+	(&jvmDiscovery{agentCommands: discovery}).enhanceTargetsWithAgentCommandAttributes(targets)
+
+	assert.Equal(t, []string{"redis://cache:6379"}, targets[0].Attributes["redis.uri"])
+	assert.NotContains(t, targets[0].Attributes, "grpc.service")
+
+	// the redis clients are gone on the next discovery
+	responses[redisCommand.Command] = `[]`
+	discovery.discover(fake)
+
+	targets = []discovery_kit_api.Target{{Attributes: map[string][]string{"process.pid": {pid}}}}
+	(&jvmDiscovery{agentCommands: discovery}).enhanceTargetsWithAgentCommandAttributes(targets)
+
+	assert.NotContains(t, targets[0].Attributes, "redis.uri")
+}
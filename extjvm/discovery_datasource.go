@@ -1,9 +1,11 @@
 package extjvm
 
 import (
+	"cmp"
 	"encoding/json"
 	"fmt"
 	"io"
+	"slices"
 	"sync"
 
 	"codnect.io/chrono"
@@ -18,9 +20,12 @@ const (
 )
 
 type dataSourceConnection struct {
-	Pid          int32
-	DatabaseType string
-	JdbcUrl      string
+	Pid                int32
+	DatabaseType       string
+	JdbcUrl            string
+	MaxPoolSize        *int
+	ActiveConnections  *int
+	PoolImplementation string
 }
 
 type dataSourceApplication struct {
@@ -57,6 +62,14 @@ func (d *DataSourceDiscovery) getApplications() []dataSourceApplication {
 	return result
 }
 
+// getPools returns the DataSources of all JVMs including the pool details, sorted by PID.
+func (d *DataSourceDiscovery) getPools() []dataSourceApplication {
+	pools := make([]dataSourceApplication, 0)
+	pools = append(pools, d.getApplications()...)
+	slices.SortFunc(pools, func(a, b dataSourceApplication) int { return cmp.Compare(a.Pid, b.Pid) })
+	return pools
+}
+
 func (d *DataSourceDiscovery) start() {
 	d.facade.AddAutoloadAgentPlugin(dataSourcePlugin, dataSourceMarkerClass)
 	d.facade.AddAttachedListener(d)
@@ -0,0 +1,246 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjvm
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/steadybit/discovery-kit/go/discovery_kit_api"
+	"github.com/steadybit/extension-jvm/extjvm/utils"
+)
+
+var (
+	// Attributes of the discovered JVMs used to resolve the host of an outgoing http call to another JVM.
+	dependencyNameAttributes = []string{
+		"k8s.service.name",
+		"spring-instance.name",
+		"jvm-instance.name",
+	}
+	// JVMs outside of containers share the hostname with all others on the host, so it is only used for containers.
+	dependencyContainerNameAttributes = []string{
+		"instance.hostname",
+		"host.domainname",
+	}
+)
+
+// readProcessNamespace returns the k8s namespace of the pod the process lives in, or "" outside of k8s.
+var readProcessNamespace = func(pid string) string {
+	file, err := os.Open(fmt.Sprintf("/proc/%s/root/etc/resolv.conf", pid))
+	if err != nil {
+		log.Trace().Err(err).Msgf("Failed to read resolv.conf of PID %s", pid)
+		return ""
+	}
+	defer func() { _ = file.Close() }()
+	return parseNamespace(file)
+}
+
+// enhanceTargetsWithDependencies derives the service dependencies from the http calls of the Spring applications. The
+// called hosts are resolved to the other discovered JVMs by k8s service name, application name, hostname or IP,
+// unresolved hosts are reported by their (service) name. Cluster local names only resolve to JVMs in the same k8s
+// namespace.
+func (j *jvmDiscovery) enhanceTargetsWithDependencies(targets []discovery_kit_api.Target) {
+	addresses := make(map[int][]string)
+	namespaces := make(map[int]string)
+	for _, app := range j.spring.getApplications() {
+		caller := findTargetByPid(targets, app.Pid)
+		if caller == -1 {
+			continue
+		}
+
+		for _, request := range app.HttpClientRequests {
+			host := dependencyHost(request.Address)
+			if host == "" {
+				continue
+			}
+
+			callee := resolveDependencyByName(targets, caller, host, namespaces)
+			if callee == -1 && net.ParseIP(host) != nil {
+				callee = resolveDependencyByAddress(targets, caller, host, addresses)
+			}
+
+			calleeName := dependencyServiceName(host)
+			if callee != -1 {
+				calleeName = dependencyTargetName(targets[callee])
+				targets[callee].Attributes["spring-instance.dependent"] = utils.AppendIfMissing(targets[callee].Attributes["spring-instance.dependent"], dependencyTargetName(targets[caller]))
+			}
+			targets[caller].Attributes["spring-instance.dependency"] = utils.AppendIfMissing(targets[caller].Attributes["spring-instance.dependency"], calleeName)
+			targets[caller].Attributes["spring-instance.dependency.edge"] = utils.AppendIfMissing(targets[caller].Attributes["spring-instance.dependency.edge"], dependencyEdge(dependencyTargetName(targets[caller]), calleeName, request))
+		}
+	}
+}
+
+func resolveDependencyByName(targets []discovery_kit_api.Target, caller int, host string, namespaces map[int]string) int {
+	serviceName := dependencyServiceName(host)
+	namespace := dependencyNamespace(host)
+	for i, target := range targets {
+		if i == caller {
+			continue
+		}
+		if namespace != "" && dependencyTargetNamespace(targets, i, namespaces) != namespace {
+			continue
+		}
+		if namespace == "" && !sameDependencyNamespace(targets, caller, i, namespaces) {
+			// short names are resolved in the namespace of the caller
+			continue
+		}
+		attributes := dependencyNameAttributes
+		if len(target.Attributes["container.id.stripped"]) > 0 {
+			attributes = slices.Concat(dependencyNameAttributes, dependencyContainerNameAttributes)
+		}
+		for _, attribute := range attributes {
+			if slices.ContainsFunc(target.Attributes[attribute], func(value string) bool {
+				return strings.EqualFold(value, host) || strings.EqualFold(value, serviceName)
+			}) {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// dependencyTargetNamespace returns the k8s namespace of a JVM in a container, or "" if it is unknown.
+func dependencyTargetNamespace(targets []discovery_kit_api.Target, i int, namespaces map[int]string) string {
+	if len(targets[i].Attributes["container.id.stripped"]) == 0 {
+		return ""
+	}
+	if _, ok := namespaces[i]; !ok {
+		namespaces[i] = readProcessNamespace(targets[i].Attributes["process.pid"][0])
+	}
+	return namespaces[i]
+}
+
+func sameDependencyNamespace(targets []discovery_kit_api.Target, caller int, callee int, namespaces map[int]string) bool {
+	callerNamespace := dependencyTargetNamespace(targets, caller, namespaces)
+	calleeNamespace := dependencyTargetNamespace(targets, callee, namespaces)
+	return callerNamespace == "" || calleeNamespace == "" || callerNamespace == calleeNamespace
+}
+
+func resolveDependencyByAddress(targets []discovery_kit_api.Target, caller int, ip string, addresses map[int][]string) int {
+	for i, target := range targets {
+		if i == caller || len(target.Attributes["container.id.stripped"]) == 0 {
+			// same as for the hostname, the port would be needed to tell JVMs outside of containers apart
+			continue
+		}
+		if _, ok := addresses[i]; !ok {
+			addresses[i] = readProcessAddresses(target.Attributes["process.pid"][0])
+		}
+		if slices.Contains(addresses[i], ip) {
+			return i
+		}
+	}
+	return -1
+}
+
+// readProcessAddresses returns the local IPv4 addresses of the network namespace the process lives in.
+func readProcessAddresses(pid string) []string {
+	file, err := os.Open(fmt.Sprintf("/proc/%s/net/fib_trie", pid))
+	if err != nil {
+		log.Trace().Err(err).Msgf("Failed to read addresses of PID %s", pid)
+		return nil
+	}
+	defer func() { _ = file.Close() }()
+	return parseLocalAddresses(file)
+}
+
+// parseLocalAddresses reads the addresses marked as "/32 host LOCAL" from a fib_trie file, except the loopback ones.
+func parseLocalAddresses(r io.Reader) []string {
+	var result []string
+	var last string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if address, ok := strings.CutPrefix(line, "|-- "); ok {
+			last = address
+		} else if line == "/32 host LOCAL" && last != "" && !strings.HasPrefix(last, "127.") {
+			result = utils.AppendIfMissing(result, last)
+		}
+	}
+	return result
+}
+
+// parseNamespace reads the namespace from the first cluster local search domain of a resolv.conf, e.g. "shop" for
+// "search shop.svc.cluster.local svc.cluster.local cluster.local".
+func parseNamespace(r io.Reader) string {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || fields[0] != "search" {
+			continue
+		}
+		for _, domain := range fields[1:] {
+			if namespace := dependencyNamespace("service." + domain); namespace != "" {
+				return namespace
+			}
+		}
+	}
+	return ""
+}
+
+// dependencyHost returns the host of an address recorded for outgoing http calls, e.g. "payment-service:8080".
+func dependencyHost(address string) string {
+	if host, _, err := net.SplitHostPort(address); err == nil {
+		return strings.ToLower(host)
+	}
+	return strings.ToLower(strings.Trim(address, "[]"))
+}
+
+// dependencyServiceName returns the service of a cluster local name, e.g. "payment-service" for
+// "payment-service.shop.svc.cluster.local", or the host itself otherwise.
+func dependencyServiceName(host string) string {
+	if name, found := cutClusterLocalSuffix(host); found {
+		return strings.Split(name, ".")[0]
+	}
+	return host
+}
+
+// dependencyNamespace returns the namespace of a cluster local name, e.g. "shop" for
+// "payment-service.shop.svc.cluster.local", or "" otherwise.
+func dependencyNamespace(host string) string {
+	if name, found := cutClusterLocalSuffix(host); found {
+		if i := strings.LastIndex(name, "."); i != -1 {
+			return name[i+1:]
+		}
+	}
+	return ""
+}
+
+// cutClusterLocalSuffix returns the host without the ".svc" and cluster domain suffix.
+func cutClusterLocalSuffix(host string) (string, bool) {
+	if net.ParseIP(host) != nil {
+		return host, false
+	}
+	if i := strings.Index(host+".", ".svc."); i != -1 {
+		return host[:i], true
+	}
+	return host, false
+}
+
+func dependencyTargetName(target discovery_kit_api.Target) string {
+	if names := target.Attributes["spring-instance.name"]; len(names) > 0 {
+		return names[0]
+	}
+	return target.Label
+}
+
+func dependencyEdge(caller string, callee string, request HttpRequest) string {
+	var findings []string
+	if request.Timeout == 0 {
+		findings = append(findings, "no timeout")
+	}
+	if !request.CircuitBreaker {
+		findings = append(findings, "no circuit breaker")
+	}
+	if len(findings) == 0 {
+		return fmt.Sprintf("%s → %s", caller, callee)
+	}
+	return fmt.Sprintf("%s → %s (%s)", caller, callee, strings.Join(findings, ", "))
+}
@@ -0,0 +1,156 @@
+// SPDX-License-Identifier: MIT
+// SPDX-FileCopyrightText: 2026 Steadybit GmbH
+
+package extjvm
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/steadybit/discovery-kit/go/discovery_kit_api"
+	"github.com/stretchr/testify/assert"
+)
+
+func stubProcessNamespaces(t *testing.T, namespaces map[string]string) {
+	original := readProcessNamespace
+	readProcessNamespace = func(pid string) string {
+		return namespaces[pid]
+	}
+	t.Cleanup(func() {
+		readProcessNamespace = original
+	})
+}
+
+func Test_enhanceTargetsWithDependencies(t *testing.T) {
+	stubProcessNamespaces(t, map[string]string{"1": "shop", "2": "shop", "3": "shop"})
+	spring := &SpringDiscovery{}
+	spring.applications.Store(int32(1), SpringApplication{
+		Name: "order-service",
+		Pid:  1,
+		HttpClientRequests: []HttpRequest{
+			{Address: "payment-service.shop.svc.cluster.local:8080", Scheme: "http", Timeout: 0, CircuitBreaker: true},
+			{Address: "inventory:8080", Scheme: "http", Timeout: 500, CircuitBreaker: true},
+			{Address: "api.example.com:443", Scheme: "https", Timeout: 0, CircuitBreaker: false},
+		},
+	})
+	targets := []discovery_kit_api.Target{
+		{Label: "order-service", Attributes: map[string][]string{"process.pid": {"1"}, "spring-instance.name": {"order-service"}, "container.id.stripped": {"def"}}},
+		{Label: "payment-service", Attributes: map[string][]string{"process.pid": {"2"}, "spring-instance.name": {"payment-service"}, "container.id.stripped": {"ghi"}}},
+		{Label: "inventory-7d9f", Attributes: map[string][]string{"process.pid": {"3"}, "instance.hostname": {"inventory"}, "container.id.stripped": {"abc"}}},
+	}
+
+	(&jvmDiscovery{spring: spring}).enhanceTargetsWithDependencies(targets)
+
+	assert.Equal(t, []string{"payment-service", "inventory-7d9f", "api.example.com"}, targets[0].Attributes["spring-instance.dependency"])
+	assert.Equal(t, []string{
+		"order-service → payment-service (no timeout)",
+		"order-service → inventory-7d9f",
+		"order-service → api.example.com (no timeout, no circuit breaker)",
+	}, targets[0].Attributes["spring-instance.dependency.edge"])
+	assert.Equal(t, []string{"order-service"}, targets[1].Attributes["spring-instance.dependent"])
+	assert.Equal(t, []string{"order-service"}, targets[2].Attributes["spring-instance.dependent"])
+}
+
+func Test_enhanceTargetsWithDependencies_k8s_service_in_other_namespace(t *testing.T) {
+	stubProcessNamespaces(t, map[string]string{"1": "shop", "2": "staging", "3": "payments"})
+	spring := &SpringDiscovery{}
+	spring.applications.Store(int32(1), SpringApplication{
+		Name: "order-service",
+		Pid:  1,
+		HttpClientRequests: []HttpRequest{
+			{Address: "payment-service.payments.svc:8080", Scheme: "http", Timeout: 500, CircuitBreaker: true},
+		},
+	})
+	targets := []discovery_kit_api.Target{
+		{Label: "order-service", Attributes: map[string][]string{"process.pid": {"1"}, "spring-instance.name": {"order-service"}, "container.id.stripped": {"abc"}}},
+		{Label: "payment-service (staging)", Attributes: map[string][]string{"process.pid": {"2"}, "jvm-instance.name": {"payment-service"}, "container.id.stripped": {"def"}}},
+		{Label: "payment-service (payments)", Attributes: map[string][]string{"process.pid": {"3"}, "jvm-instance.name": {"payment-service"}, "container.id.stripped": {"ghi"}}},
+	}
+
+	(&jvmDiscovery{spring: spring}).enhanceTargetsWithDependencies(targets)
+
+	assert.Equal(t, []string{"payment-service (payments)"}, targets[0].Attributes["spring-instance.dependency"])
+	assert.Empty(t, targets[1].Attributes["spring-instance.dependent"])
+	assert.Equal(t, []string{"order-service"}, targets[2].Attributes["spring-instance.dependent"])
+}
+
+func Test_enhanceTargetsWithDependencies_k8s_service_without_jvm_in_namespace(t *testing.T) {
+	stubProcessNamespaces(t, map[string]string{"1": "shop", "2": "staging"})
+	spring := &SpringDiscovery{}
+	spring.applications.Store(int32(1), SpringApplication{
+		Name: "order-service",
+		Pid:  1,
+		HttpClientRequests: []HttpRequest{
+			{Address: "payment-service.shop.svc.cluster.local:8080", Scheme: "http", Timeout: 500, CircuitBreaker: true},
+			{Address: "payment-service:8080", Scheme: "http", Timeout: 500, CircuitBreaker: true},
+		},
+	})
+	targets := []discovery_kit_api.Target{
+		{Label: "order-service", Attributes: map[string][]string{"process.pid": {"1"}, "spring-instance.name": {"order-service"}, "container.id.stripped": {"abc"}}},
+		{Label: "payment-service (staging)", Attributes: map[string][]string{"process.pid": {"2"}, "spring-instance.name": {"payment-service"}, "container.id.stripped": {"def"}}},
+	}
+
+	(&jvmDiscovery{spring: spring}).enhanceTargetsWithDependencies(targets)
+
+	assert.Equal(t, []string{"payment-service"}, targets[0].Attributes["spring-instance.dependency"])
+	assert.Empty(t, targets[1].Attributes["spring-instance.dependent"])
+}
+
+func Test_parseNamespace(t *testing.T) {
+	assert.Equal(t, "shop", parseNamespace(strings.NewReader("search shop.svc.cluster.local svc.cluster.local cluster.local\nnameserver 10.96.0.10\noptions ndots:5\n")))
+	assert.Equal(t, "", parseNamespace(strings.NewReader("search fritz.box\nnameserver 192.168.178.1\n")))
+}
+
+func Test_parseLocalAddresses(t *testing.T) {
+	fibTrie := `Main:
+  +-- 0.0.0.0/0 3 0 5
+     |-- 0.0.0.0
+        /0 universe UNICAST
+     +-- 10.244.0.0/24 2 0 2
+        |-- 10.244.0.0
+           /24 link UNICAST
+        |-- 10.244.0.12
+           /32 host LOCAL
+     +-- 127.0.0.0/8 2 0 2
+        |-- 127.0.0.1
+           /32 host LOCAL
+Local:
+  +-- 0.0.0.0/0 3 0 5
+        |-- 10.244.0.12
+           /32 host LOCAL
+`
+	assert.Equal(t, []string{"10.244.0.12"}, parseLocalAddresses(strings.NewReader(fibTrie)))
+}
+
+func Test_dependencyServiceName(t *testing.T) {
+	assert.Equal(t, "payment-service", dependencyServiceName(dependencyHost("payment-service.shop.svc.cluster.local:8080")))
+	assert.Equal(t, "payment-service", dependencyServiceName(dependencyHost("payment-service.shop.svc")))
+	assert.Equal(t, "payment-service", dependencyServiceName(dependencyHost("payment-service")))
+	assert.Equal(t, "10.0.0.1", dependencyServiceName(dependencyHost("10.0.0.1:80")))
+	assert.Equal(t, "::1", dependencyServiceName(dependencyHost("[::1]:80")))
+	assert.Equal(t, "shop", dependencyNamespace(dependencyHost("payment-service.shop.svc.cluster.local:8080")))
+	assert.Equal(t, "shop", dependencyNamespace(dependencyHost("payment-service.shop.svc")))
+	assert.Equal(t, "", dependencyNamespace(dependencyHost("payment-service:8080")))
+	assert.Equal(t, "", dependencyNamespace(dependencyHost("svc.example.com")))
+}
+
+func Test_enhanceTargetsWithDependencies_k8s_service_name(t *testing.T) {
+	stubProcessNamespaces(t, map[string]string{"1": "shop", "2": "shop"})
+	spring := &SpringDiscovery{}
+	spring.applications.Store(int32(1), SpringApplication{
+		Name: "order-service",
+		Pid:  1,
+		HttpClientRequests: []HttpRequest{
+			{Address: "payments.shop.svc.cluster.local:8080", Scheme: "http", Timeout: 500, CircuitBreaker: true},
+		},
+	})
+	targets := []discovery_kit_api.Target{
+		{Label: "order-service", Attributes: map[string][]string{"process.pid": {"1"}, "spring-instance.name": {"order-service"}, "container.id.stripped": {"abc"}}},
+		{Label: "payment-service", Attributes: map[string][]string{"process.pid": {"2"}, "spring-instance.name": {"payment-service"}, "k8s.service.name": {"payments"}, "container.id.stripped": {"def"}}},
+	}
+
+	(&jvmDiscovery{spring: spring}).enhanceTargetsWithDependencies(targets)
+
+	assert.Equal(t, []string{"payment-service"}, targets[0].Attributes["spring-instance.dependency"])
+	assert.Equal(t, []string{"order-service"}, targets[1].Attributes["spring-instance.dependent"])
+}
@@ -0,0 +1,224 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjvm
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+
+	"codnect.io/chrono"
+	"github.com/rs/zerolog/log"
+	"github.com/steadybit/discovery-kit/go/discovery_kit_api"
+	"github.com/steadybit/extension-jvm/chrono_utils"
+	"github.com/steadybit/extension-jvm/extjvm/jvm"
+	"github.com/steadybit/extension-jvm/extjvm/utils"
+)
+
+// framework is a web framework besides Spring, discovered by its own agent plugin which is loaded as soon as one of the
+// marker classes is present. The plugin handles the commands "<name>-application-name" and "<name>-endpoints".
+type framework struct {
+	Name          string
+	Plugin        string
+	MarkerClasses []string
+	// frameworks reporting the same endpoints themselves, e.g. Quarkus for JAX-RS
+	SupersededBy []string
+}
+
+var (
+	quarkusFramework = framework{
+		Name:          "quarkus",
+		Plugin:        "discovery-quarkus-javaagent.jar",
+		MarkerClasses: []string{"io.quarkus.runtime.Application"},
+	}
+	micronautFramework = framework{
+		Name:          "micronaut",
+		Plugin:        "discovery-micronaut-javaagent.jar",
+		MarkerClasses: []string{"io.micronaut.context.ApplicationContext"},
+	}
+	jaxrsFramework = framework{
+		Name:          "jaxrs",
+		Plugin:        "discovery-jaxrs-javaagent.jar",
+		MarkerClasses: []string{"jakarta.ws.rs.Path", "javax.ws.rs.Path"},
+		SupersededBy:  []string{quarkusFramework.Name},
+	}
+)
+
+// FrameworkApplication is an application of a discovered framework. The endpoints are reported in the same shape as
+// the Spring MVC mappings, so the controller attacks can handle them alike.
+type FrameworkApplication struct {
+	Name      string
+	Pid       int32
+	Framework string
+	Endpoints []SpringMvcMapping
+}
+
+type frameworkApplicationKey struct {
+	Pid       int32
+	Framework string
+}
+
+type FrameworkDiscovery struct {
+	facade        jvm.JavaFacade
+	frameworks    []framework
+	taskScheduler chrono.TaskScheduler
+	applications  sync.Map // map[frameworkApplicationKey]FrameworkApplication
+	tasks         sync.Map // map[Pid int32]discoveryTask
+}
+
+func newFrameworkDiscovery(facade jvm.JavaFacade, frameworks ...framework) *FrameworkDiscovery {
+	return &FrameworkDiscovery{facade: facade, frameworks: frameworks, taskScheduler: chrono_utils.NewContextTaskScheduler()}
+}
+
+func (d *FrameworkDiscovery) Attached(jvm jvm.JavaVm) {
+	d.scheduleDiscover(jvm)
+}
+
+func (d *FrameworkDiscovery) Detached(jvm jvm.JavaVm) {
+	d.cancelDiscover(jvm)
+	for _, f := range d.frameworks {
+		d.applications.Delete(frameworkApplicationKey{Pid: jvm.Pid(), Framework: f.Name})
+	}
+}
+
+func (d *FrameworkDiscovery) getApplications() []FrameworkApplication {
+	var result []FrameworkApplication
+	d.applications.Range(func(key, value any) bool {
+		result = append(result, value.(FrameworkApplication))
+		return true
+	})
+	return result
+}
+
+func (d *FrameworkDiscovery) findApplications(pid int32) []FrameworkApplication {
+	return slices.DeleteFunc(d.getApplications(), func(application FrameworkApplication) bool { return application.Pid != pid })
+}
+
+func (d *FrameworkDiscovery) start() {
+	for _, f := range d.frameworks {
+		for _, markerClass := range f.MarkerClasses {
+			d.facade.AddAutoloadAgentPlugin(f.Plugin, markerClass)
+		}
+	}
+	d.facade.AddAttachedListener(d)
+}
+
+func (d *FrameworkDiscovery) stop() {
+	d.facade.RemoveAttachedListener(d)
+	for _, f := range d.frameworks {
+		for _, markerClass := range f.MarkerClasses {
+			d.facade.RemoveAutoloadAgentPlugin(f.Plugin, markerClass)
+		}
+	}
+	<-d.taskScheduler.Shutdown()
+	d.tasks = sync.Map{}
+}
+
+func (d *FrameworkDiscovery) cancelDiscover(vm jvm.JavaVm) {
+	if t, ok := d.tasks.LoadAndDelete(vm.Pid()); ok {
+		t.(*discoveryTask).cancel()
+	}
+}
+
+func (d *FrameworkDiscovery) scheduleDiscover(javaVm jvm.JavaVm) {
+	t := &discoveryTask{}
+
+	err := t.scheduleOn(d.taskScheduler, func() {
+		d.discover(javaVm)
+	})
+	if err != nil {
+		log.Error().Err(err).Msgf("Failed to schedule framework discovery for JVM: %s", javaVm.ToInfoString())
+	}
+
+	d.tasks.Store(javaVm.Pid(), t)
+}
+
+func (d *FrameworkDiscovery) discover(javaVm jvm.JavaVm) {
+	for _, f := range d.frameworks {
+		if !d.facade.HasAgentPlugin(javaVm, f.Plugin) || d.isSuperseded(javaVm, f) {
+			continue
+		}
+		application := FrameworkApplication{
+			Name:      d.readApplicationName(javaVm, f),
+			Pid:       javaVm.Pid(),
+			Framework: f.Name,
+			Endpoints: d.readEndpoints(javaVm, f),
+		}
+		_, loaded := d.applications.Swap(frameworkApplicationKey{Pid: javaVm.Pid(), Framework: f.Name}, application)
+		if !loaded {
+			log.Debug().Msgf("%s instance '%s' on PID %d has been discovered: %+v", f.Name, application.Name, javaVm.Pid(), application)
+		}
+	}
+}
+
+func (d *FrameworkDiscovery) isSuperseded(javaVm jvm.JavaVm, f framework) bool {
+	return slices.ContainsFunc(d.frameworks, func(other framework) bool {
+		return slices.Contains(f.SupersededBy, other.Name) && d.facade.HasAgentPlugin(javaVm, other.Plugin)
+	})
+}
+
+func (d *FrameworkDiscovery) readEndpoints(javaVm jvm.JavaVm, f framework) []SpringMvcMapping {
+	command := f.Name + "-endpoints"
+	endpoints, err := d.facade.SendCommandToAgentWithHandler(javaVm, command, "", func(response io.Reader) (any, error) {
+		var endpoints []SpringMvcMapping
+		if err := json.NewDecoder(response).Decode(&endpoints); err != nil {
+			return nil, fmt.Errorf("failed to decode %s response: %w", command, err)
+		}
+		log.Debug().Msgf("Result from command %s agent on PID %d: %v", command, javaVm.Pid(), endpoints)
+		return endpoints, nil
+	})
+	if err != nil {
+		log.Error().Err(err).Msgf("Failed to read %s endpoints on PID %d", f.Name, javaVm.Pid())
+		return nil
+	}
+	return endpoints.([]SpringMvcMapping)
+}
+
+func (d *FrameworkDiscovery) readApplicationName(javaVm jvm.JavaVm, f framework) string {
+	command := f.Name + "-application-name"
+	name, err := d.facade.SendCommandToAgentWithHandler(javaVm, command, "", func(response io.Reader) (any, error) {
+		result, err := jvm.GetCleanSocketCommandResult(response)
+		log.Debug().Msgf("Result from command %s agent on PID %d: %s", command, javaVm.Pid(), result)
+		return result, err
+	})
+	if err != nil {
+		log.Error().Err(err).Msgf("Failed to read %s application name on PID %d", f.Name, javaVm.Pid())
+		return ""
+	}
+	return name.(string)
+}
+
+func (j *jvmDiscovery) enhanceTargetsWithFrameworkAttributes(targets []discovery_kit_api.Target) {
+	for _, app := range j.frameworks.getApplications() {
+		targetIndex := findTargetByPid(targets, app.Pid)
+		if targetIndex != -1 {
+			addFrameworkApplication(&targets[targetIndex], app)
+		}
+	}
+}
+
+func addFrameworkApplication(target *discovery_kit_api.Target, app FrameworkApplication) {
+	prefix := app.Framework + "-instance"
+	target.Attributes["instance.type"] = utils.AppendIfMissing(target.Attributes["instance.type"], app.Framework)
+	if app.Name != "" {
+		target.Attributes["jvm-instance.name"] = utils.AppendIfMissing(target.Attributes["jvm-instance.name"], app.Name)
+		slices.Sort(target.Attributes["jvm-instance.name"])
+		target.Attributes[prefix+".name"] = []string{app.Name}
+		target.Label = app.Name
+	}
+	for _, endpoint := range app.Endpoints {
+		for _, pattern := range endpoint.Patterns {
+			target.Attributes[prefix+".endpoint"] = utils.AppendIfMissing(target.Attributes[prefix+".endpoint"], pattern)
+			for _, method := range endpoint.Methods {
+				target.Attributes[prefix+".endpoint.method"] = utils.AppendIfMissing(target.Attributes[prefix+".endpoint.method"], fmt.Sprintf("%s %s", method, pattern))
+			}
+		}
+		if endpoint.HandlerClass != "" {
+			target.Attributes[prefix+".endpoint.handler-class"] = utils.AppendIfMissing(target.Attributes[prefix+".endpoint.handler-class"], endpoint.HandlerClass)
+		}
+	}
+}
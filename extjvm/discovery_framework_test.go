@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: MIT
+// SPDX-FileCopyrightText: 2026 Steadybit GmbH
+
+package extjvm
+
+import (
+	"testing"
+
+	"github.com/steadybit/discovery-kit/go/discovery_kit_api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_addFrameworkApplication(t *testing.T) {
+	target := discovery_kit_api.Target{Label: "?", Attributes: map[string][]string{"instance.type": {"java"}}}
+
+	addFrameworkApplication(&target, FrameworkApplication{
+		Name:      "orders",
+		Pid:       42,
+		Framework: "micronaut",
+		Endpoints: []SpringMvcMapping{
+			{Methods: []string{"GET"}, Patterns: []string{"/orders"}, HandlerClass: "com.example.OrderController", HandlerName: "list"},
+			{Methods: []string{"POST"}, Patterns: []string{"/orders"}, HandlerClass: "com.example.OrderController", HandlerName: "create"},
+		},
+	})
+
+	assert.Equal(t, "orders", target.Label)
+	assert.Equal(t, []string{"java", "micronaut"}, target.Attributes["instance.type"])
+	assert.Equal(t, []string{"orders"}, target.Attributes["micronaut-instance.name"])
+	assert.Equal(t, []string{"/orders"}, target.Attributes["micronaut-instance.endpoint"])
+	assert.Equal(t, []string{"GET /orders", "POST /orders"}, target.Attributes["micronaut-instance.endpoint.method"])
+	assert.Equal(t, []string{"com.example.OrderController"}, target.Attributes["micronaut-instance.endpoint.handler-class"])
+}
+
+func Test_FrameworkDiscovery_discover_skips_superseded_frameworks(t *testing.T) {
+	facade := &mockJavaFacade{}
+	fake, err := facade.startFakeJvm()
+	require.NoError(t, err)
+	defer func(fake *FakeJvm) {
+		_ = fake.stop()
+	}(fake)
+
+	facade.On("HasAgentPlugin", mock.Anything, quarkusFramework.Plugin).Return(true)
+	facade.On("HasAgentPlugin", mock.Anything, jaxrsFramework.Plugin).Return(true)
+	facade.On("SendCommandToAgentWithHandler", mock.Anything, "quarkus-application-name", "", mock.Anything).Return("orders", nil)
+	facade.On("SendCommandToAgentWithHandler", mock.Anything, "quarkus-endpoints", "", mock.Anything).Return([]SpringMvcMapping{
+		{Methods: []string{"GET"}, Patterns: []string{"/orders"}, HandlerClass: "com.example.OrderResource", HandlerName: "list"},
+	}, nil)
+
+	discovery := &FrameworkDiscovery{facade: facade, frameworks: []framework{quarkusFramework, jaxrsFramework}}
+	discovery.discover(fake)
+
+	applications := discovery.findApplications(fake.Pid())
+	require.Len(t, applications, 1)
+	assert.Equal(t, "quarkus", applications[0].Framework)
+	assert.Equal(t, "orders", applications[0].Name)
+	facade.AssertNotCalled(t, "SendCommandToAgentWithHandler", mock.Anything, "jaxrs-endpoints", "", mock.Anything)
+}
@@ -0,0 +1,27 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjvm
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// grpcCommand discovers the services served and called by grpc-java.
+var grpcCommand = agentCommand{
+	Name:          "grpc",
+	Command:       "java-grpc-services",
+	MarkerClasses: []string{"io.grpc.MethodDescriptor"},
+	Attributes: func(response io.Reader) (map[string][]string, error) {
+		var services struct {
+			Services       []string `json:"services"`
+			ClientServices []string `json:"clientServices"`
+		}
+		if err := json.NewDecoder(response).Decode(&services); err != nil {
+			return nil, err
+		}
+		return sortedAttributes(map[string][]string{"grpc.service": services.Services, "grpc.client-service": services.ClientServices}), nil
+	},
+}
@@ -0,0 +1,28 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjvm
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// httpClientCommand discovers the addresses called by the JDK, OkHttp and Apache HttpClients.
+var httpClientCommand = agentCommand{
+	Name:    "http client",
+	Command: "java-httpclient-addresses",
+	MarkerClasses: []string{
+		"java.net.http.HttpClient",
+		"okhttp3.OkHttpClient",
+		"org.apache.hc.client5.http.impl.classic.CloseableHttpClient",
+	},
+	Attributes: func(response io.Reader) (map[string][]string, error) {
+		var addresses []string
+		if err := json.NewDecoder(response).Decode(&addresses); err != nil {
+			return nil, err
+		}
+		return sortedAttributes(map[string][]string{"java-instance.http-outgoing-calls": addresses}), nil
+	},
+}
@@ -0,0 +1,279 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjvm
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/steadybit/discovery-kit/go/discovery_kit_api"
+	"github.com/steadybit/discovery-kit/go/discovery_kit_commons"
+	"github.com/steadybit/discovery-kit/go/discovery_kit_sdk"
+	"github.com/steadybit/extension-jvm/config"
+	"github.com/steadybit/extension-jvm/extjvm/jvm"
+	"github.com/steadybit/extension-kit/extbuild"
+)
+
+// jvmDataSourceDiscovery publishes one target per JVM and JDBC url, so the databases can be selected independently of
+// the JVMs using them.
+type jvmDataSourceDiscovery struct {
+	jvms       jvmLister
+	datasource *DataSourceDiscovery
+	spring     *SpringDiscovery
+}
+
+var (
+	_ discovery_kit_sdk.TargetDescriber          = (*jvmDataSourceDiscovery)(nil)
+	_ discovery_kit_sdk.AttributeDescriber       = (*jvmDataSourceDiscovery)(nil)
+	_ discovery_kit_sdk.EnrichmentRulesDescriber = (*jvmDataSourceDiscovery)(nil)
+)
+
+type jdbcUrlInfo struct {
+	Host     string
+	Port     string
+	Database string
+}
+
+func NewJvmDataSourceDiscovery(jvms jvmLister, datasource *DataSourceDiscovery, spring *SpringDiscovery) discovery_kit_sdk.TargetDiscovery {
+	discovery := &jvmDataSourceDiscovery{
+		jvms:       jvms,
+		datasource: datasource,
+		spring:     spring,
+	}
+	return discovery_kit_sdk.NewCachedTargetDiscovery(discovery,
+		discovery_kit_sdk.WithRefreshTargetsNow(),
+		discovery_kit_sdk.WithRefreshTargetsInterval(context.Background(), 30*time.Second),
+	)
+}
+
+func (d *jvmDataSourceDiscovery) Describe() discovery_kit_api.DiscoveryDescription {
+	return discovery_kit_api.DiscoveryDescription{
+		Id: dataSourceTargetType,
+		Discover: discovery_kit_api.DescribingEndpointReferenceWithCallInterval{
+			CallInterval: new(config.Config.DiscoveryCallInterval),
+		},
+	}
+}
+
+func (d *jvmDataSourceDiscovery) DescribeTarget() discovery_kit_api.TargetDescription {
+	return discovery_kit_api.TargetDescription{
+		Id:      dataSourceTargetType,
+		Version: extbuild.GetSemverVersionStringOrUnknown(),
+		Icon:    new(targetIcon),
+
+		// Labels used in the UI
+		Label: discovery_kit_api.PluralLabel{One: "JVM datasource", Other: "JVM datasources"},
+
+		// Category for the targets to appear in
+		Category: new(category),
+
+		// Specify attributes shown in table columns and to be used for sorting
+		Table: discovery_kit_api.Table{
+			Columns: []discovery_kit_api.Column{
+				{Attribute: "datasource.database-type"},
+				{Attribute: "datasource.host"},
+				{Attribute: "datasource.database-name"},
+				{Attribute: "jvm-instance.name"},
+				{Attribute: "k8s.namespace"},
+			},
+			OrderBy: []discovery_kit_api.OrderBy{
+				{
+					Attribute: "datasource.host",
+					Direction: "ASC",
+				},
+			},
+		},
+	}
+}
+
+func (d *jvmDataSourceDiscovery) DescribeEnrichmentRules() []discovery_kit_api.TargetEnrichmentRule {
+	return []discovery_kit_api.TargetEnrichmentRule{
+		getJvmToLinkedTargetEnrichmentRule("com.steadybit.extension_jvm.jvm-to-jvm-datasource", dataSourceTargetType),
+	}
+}
+
+func (d *jvmDataSourceDiscovery) DescribeAttributes() []discovery_kit_api.AttributeDescription {
+	return []discovery_kit_api.AttributeDescription{
+		{
+			Attribute: "datasource.jdbc-url",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "JDBC url",
+				Other: "JDBC urls",
+			},
+		},
+		{
+			Attribute: "datasource.database-type",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Database type",
+				Other: "Database types",
+			},
+		},
+		{
+			Attribute: "datasource.host",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Database host",
+				Other: "Database hosts",
+			},
+		},
+		{
+			Attribute: "datasource.port",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Database port",
+				Other: "Database ports",
+			},
+		},
+		{
+			Attribute: "datasource.database-name",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Database name",
+				Other: "Database names",
+			},
+		},
+		{
+			Attribute: "datasource.pool.implementation",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Connection pool",
+				Other: "Connection pools",
+			},
+		},
+		{
+			Attribute: "datasource.pool.max-size",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Maximum pool size",
+				Other: "Maximum pool sizes",
+			},
+		},
+		{
+			Attribute: "datasource.pool.max-size-by-jdbc-url",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Maximum pool size by JDBC url",
+				Other: "Maximum pool sizes by JDBC url",
+			},
+		},
+		{
+			Attribute: "datasource.pool.active-by-jdbc-url",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Active connections by JDBC url",
+				Other: "Active connections by JDBC url",
+			},
+		},
+	}
+}
+
+func (d *jvmDataSourceDiscovery) DiscoverTargets(_ context.Context) ([]discovery_kit_api.Target, error) {
+	javaVms := d.jvms.GetJvms()
+	var targets []discovery_kit_api.Target
+
+	for _, app := range d.datasource.getApplications() {
+		idx := slices.IndexFunc(javaVms, func(javaVm jvm.JavaVm) bool { return javaVm.Pid() == app.Pid })
+		if idx == -1 {
+			continue
+		}
+		for _, connection := range app.DataSourceConnections {
+			targets = append(targets, d.createDataSourceTarget(javaVms[idx], connection))
+		}
+	}
+
+	return discovery_kit_commons.ApplyAttributeExcludes(targets, config.Config.DiscoveryAttributesExcludesJVM), nil
+}
+
+func (d *jvmDataSourceDiscovery) createDataSourceTarget(javaVm jvm.JavaVm, connection dataSourceConnection) discovery_kit_api.Target {
+	target := newJvmLinkedTarget(javaVm, d.spring, dataSourceTargetType, connection.JdbcUrl, connection.JdbcUrl)
+	target.Attributes["datasource.jdbc-url"] = []string{connection.JdbcUrl}
+
+	if connection.DatabaseType != "" {
+		target.Attributes["datasource.database-type"] = []string{connection.DatabaseType}
+	}
+	info := parseJdbcUrl(connection.JdbcUrl)
+	if info.Host != "" {
+		target.Attributes["datasource.host"] = []string{info.Host}
+	}
+	if info.Port != "" {
+		target.Attributes["datasource.port"] = []string{info.Port}
+	}
+	if info.Database != "" {
+		target.Attributes["datasource.database-name"] = []string{info.Database}
+		target.Label = fmt.Sprintf("%s (%s)", info.Database, target.Attributes["jvm-instance.id"][0])
+	}
+	if connection.PoolImplementation != "" {
+		target.Attributes["datasource.pool.implementation"] = []string{connection.PoolImplementation}
+	}
+	if connection.MaxPoolSize != nil {
+		target.Attributes["datasource.pool.max-size"] = []string{strconv.Itoa(*connection.MaxPoolSize)}
+	}
+	return target
+}
+
+// parseJdbcUrl extracts host, port and database name from the common JDBC url formats, e.g.
+// jdbc:postgresql://host:5432/db, jdbc:sqlserver://host:1433;databaseName=db, jdbc:oracle:thin:@host:1521:sid or
+// jdbc:h2:mem:db. Parts which are not present or can't be recognized are left empty.
+func parseJdbcUrl(jdbcUrl string) jdbcUrlInfo {
+	rest := strings.TrimPrefix(jdbcUrl, "jdbc:")
+
+	// jdbc:oracle:thin:user/***@host:port:sid or jdbc:oracle:thin:@//host:port/service
+	if strings.HasPrefix(rest, "oracle:") {
+		if at := strings.LastIndex(rest, "@"); at != -1 {
+			rest = rest[at+1:]
+			if !strings.HasPrefix(rest, "//") {
+				parts := strings.Split(rest, ":")
+				info := jdbcUrlInfo{Host: parts[0]}
+				if len(parts) > 1 {
+					info.Port = parts[1]
+				}
+				if len(parts) > 2 {
+					info.Database = parts[2]
+				}
+				return info
+			}
+			rest = "oracle:" + rest
+		}
+	}
+
+	start := strings.Index(rest, "//")
+	if start == -1 {
+		// embedded databases like jdbc:h2:mem:db or jdbc:hsqldb:file:/data/db
+		if idx := strings.LastIndexAny(rest, ":/"); idx != -1 && idx < len(rest)-1 {
+			return jdbcUrlInfo{Database: strings.SplitN(rest[idx+1:], ";", 2)[0]}
+		}
+		return jdbcUrlInfo{}
+	}
+	rest = rest[start+2:]
+
+	authority := rest
+	path := ""
+	if end := strings.IndexAny(rest, "/;?"); end != -1 {
+		authority = rest[:end]
+		path = rest[end:]
+	}
+	if at := strings.LastIndex(authority, "@"); at != -1 {
+		authority = authority[at+1:]
+	}
+	// only the first host of a multi-host url is reported
+	authority = strings.SplitN(authority, ",", 2)[0]
+
+	info := jdbcUrlInfo{Host: authority}
+	if u, err := url.Parse("//" + authority); err == nil && u.Hostname() != "" {
+		info.Host = u.Hostname()
+		info.Port = u.Port()
+	}
+
+	if strings.HasPrefix(path, "/") {
+		info.Database = strings.TrimPrefix(path, "/")
+		if end := strings.IndexAny(info.Database, "?;"); end != -1 {
+			info.Database = info.Database[:end]
+		}
+	}
+	for _, property := range strings.Split(path, ";") {
+		if key, value, found := strings.Cut(property, "="); found && (strings.EqualFold(key, "databaseName") || strings.EqualFold(key, "database")) {
+			info.Database = value
+		}
+	}
+	return info
+}
@@ -0,0 +1,33 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjvm
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// kafkaCommand discovers the bootstrap servers and topics of the Kafka producers and consumers.
+var kafkaCommand = agentCommand{
+	Name:    "kafka",
+	Command: "java-kafka-clients",
+	MarkerClasses: []string{
+		"org.apache.kafka.clients.producer.KafkaProducer",
+		"org.apache.kafka.clients.consumer.KafkaConsumer",
+	},
+	Attributes: func(response io.Reader) (map[string][]string, error) {
+		var clients struct {
+			BootstrapServers []string `json:"bootstrapServers"`
+			Topics           []string `json:"topics"`
+		}
+		if err := json.NewDecoder(response).Decode(&clients); err != nil {
+			return nil, err
+		}
+		if len(clients.BootstrapServers) == 0 {
+			return nil, nil
+		}
+		return sortedAttributes(map[string][]string{"kafka.bootstrap-servers": clients.BootstrapServers, "kafka.topics": clients.Topics}), nil
+	},
+}
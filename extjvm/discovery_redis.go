@@ -0,0 +1,28 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjvm
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// redisCommand discovers the uris of the Lettuce, Jedis and Spring Data Redis clients.
+var redisCommand = agentCommand{
+	Name:    "redis",
+	Command: "java-redis-uris",
+	MarkerClasses: []string{
+		"io.lettuce.core.RedisChannelHandler",
+		"redis.clients.jedis.Connection",
+		"org.springframework.data.redis.connection.RedisConnectionFactory",
+	},
+	Attributes: func(response io.Reader) (map[string][]string, error) {
+		var uris []string
+		if err := json.NewDecoder(response).Decode(&uris); err != nil {
+			return nil, err
+		}
+		return sortedAttributes(map[string][]string{"redis.uri": uris}), nil
+	},
+}
@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"slices"
 	"sync"
 
 	"codnect.io/chrono"
@@ -16,14 +17,17 @@ import (
 )
 
 const (
-	springMarkerClass                  = "org.springframework.context.ApplicationContext"
-	springBootMarkerClass              = "org.springframework.boot.ApplicationContextFactory"
-	springJdbcTemplateBeanClass        = "org.springframework.jdbc.core.JdbcTemplate"
-	springRestTemplateBeanClass        = "org.springframework.web.client.RestTemplate"
-	springRestTemplateBuilderBeanClass = "org.springframework.boot.web.client.RestTemplateBuilder"
-	springWebclientBeanClass           = "org.springframework.web.reactive.function.client.WebClient"
-	springWebclientBuilderBeanClass    = "org.springframework.web.reactive.function.client.WebClient$Builder"
-	springPlugin                       = "discovery-springboot-javaagent.jar"
+	springMarkerClass     = "org.springframework.context.ApplicationContext"
+	springBootMarkerClass = "org.springframework.boot.ApplicationContextFactory"
+	springPlugin          = "discovery-springboot-javaagent.jar"
+)
+
+// well-known bean categories reported by the agent in the bean inventory, besides cache, scheduler, message-listener
+// and messaging-client
+const (
+	springBeanCategoryHttpClient = "http-client"
+	springBeanCategoryJdbcClient = "jdbc-client"
+	springBeanCategoryExecutor   = "executor"
 )
 
 type SpringMvcMapping struct {
@@ -36,6 +40,15 @@ type SpringMvcMapping struct {
 	HandlerClass      string   `json:"handlerClass"`
 	HandlerName       string   `json:"handlerName"`
 	HandlerDescriptor string   `json:"handlerDescriptor"`
+	// WebFlux router functions are usually lambdas and can't be attacked by their handler method
+	RouterFunction bool `json:"routerFunction"`
+}
+
+// SpringMvcMappings are the mappings reported by the agent together with the mode used to read them: "actuator",
+// "application-context" for applications without actuator or "unavailable".
+type SpringMvcMappings struct {
+	Mode     string             `json:"mode"`
+	Mappings []SpringMvcMapping `json:"mappings"`
 }
 
 type HttpRequest struct {
@@ -45,6 +58,35 @@ type HttpRequest struct {
 	CircuitBreaker bool   `json:"circuitBreaker"`
 }
 
+type ResilienceComponent struct {
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	Library     string `json:"library"`
+	Method      string `json:"method"`
+	Fallback    string `json:"fallback"`
+	MaxAttempts int    `json:"maxAttempts"`
+	Timeout     int    `json:"timeout"`
+}
+
+type SpringEnvironment struct {
+	BootVersion      string   `json:"bootVersion"`
+	FrameworkVersion string   `json:"frameworkVersion"`
+	ActiveProfiles   []string `json:"activeProfiles"`
+	ServerPort       string   `json:"serverPort"`
+	ManagementPort   string   `json:"managementPort"`
+	GitCommit        string   `json:"gitCommit"`
+	BuildVersion     string   `json:"buildVersion"`
+}
+
+// SpringBean is an entry of the bean inventory. The inventory is read from the actuator beans endpoint or, if not
+// available, from the application context. Infrastructure beans and lazy beans not yet created are not reported.
+type SpringBean struct {
+	Name       string   `json:"name"`
+	Type       string   `json:"type"`
+	Scope      string   `json:"scope"`
+	Categories []string `json:"categories"`
+}
+
 type SpringApplication struct {
 	Name               string
 	Pid                int32
@@ -52,7 +94,12 @@ type SpringApplication struct {
 	UsingJdbcTemplate  bool
 	UsingHttpClient    bool
 	MvcMappings        []SpringMvcMapping
+	MvcMappingsMode    string
 	HttpClientRequests []HttpRequest
+	ThreadPools        []string
+	Resilience         []ResilienceComponent
+	Environment        SpringEnvironment
+	Beans              []SpringBean
 }
 
 type SpringDiscovery struct {
@@ -134,14 +181,21 @@ func (d *SpringDiscovery) discover(javaVm jvm.JavaVm) {
 }
 
 func (d *SpringDiscovery) createSpringApplication(javaVm jvm.JavaVm) SpringApplication {
+	mappings := d.readRequestMappings(javaVm)
+	beans := d.readBeanInventory(javaVm)
 	return SpringApplication{
 		Name:               d.readSpringApplicationName(javaVm),
 		Pid:                javaVm.Pid(),
 		SpringBoot:         d.isSpringBootApplication(javaVm),
-		UsingJdbcTemplate:  d.hasJdbcTemplate(javaVm),
-		UsingHttpClient:    d.hasRestTemplate(javaVm) || d.hasWebClient(javaVm),
-		MvcMappings:        d.readRequestMappings(javaVm),
+		UsingJdbcTemplate:  hasBeanOfCategory(beans, springBeanCategoryJdbcClient),
+		UsingHttpClient:    hasBeanOfCategory(beans, springBeanCategoryHttpClient),
+		MvcMappings:        mappings.Mappings,
+		MvcMappingsMode:    mappings.Mode,
 		HttpClientRequests: d.readHttpClientRequest(javaVm),
+		ThreadPools:        beanNamesOfCategory(beans, springBeanCategoryExecutor),
+		Resilience:         d.readResilienceComponents(javaVm),
+		Environment:        d.readEnvironment(javaVm),
+		Beans:              beans,
 	}
 }
 
@@ -161,48 +215,90 @@ func (d *SpringDiscovery) readHttpClientRequest(javaVm jvm.JavaVm) []HttpRequest
 	return requests.([]HttpRequest)
 }
 
-func (d *SpringDiscovery) readRequestMappings(javaVm jvm.JavaVm) []SpringMvcMapping {
+func (d *SpringDiscovery) readResilienceComponents(javaVm jvm.JavaVm) []ResilienceComponent {
+	components, err := d.facade.SendCommandToAgentWithHandler(javaVm, "spring-resilience", "", func(response io.Reader) (any, error) {
+		var components []ResilienceComponent
+		if err := json.NewDecoder(response).Decode(&components); err != nil {
+			return nil, fmt.Errorf("failed to decode spring-resilience response: %w", err)
+		}
+		log.Debug().Msgf("Result from command spring-resilience agent on PID %d: %v", javaVm.Pid(), components)
+		return components, nil
+	})
+	if err != nil {
+		log.Error().Err(err).Msgf("Failed to read resilience patterns on PID %d", javaVm.Pid())
+		return nil
+	}
+	return components.([]ResilienceComponent)
+}
+
+func (d *SpringDiscovery) readEnvironment(javaVm jvm.JavaVm) SpringEnvironment {
+	environment, err := d.facade.SendCommandToAgentWithHandler(javaVm, "spring-environment", "", func(response io.Reader) (any, error) {
+		var environment SpringEnvironment
+		if err := json.NewDecoder(response).Decode(&environment); err != nil {
+			return nil, fmt.Errorf("failed to decode spring-environment response: %w", err)
+		}
+		log.Debug().Msgf("Result from command spring-environment agent on PID %d: %+v", javaVm.Pid(), environment)
+		return environment, nil
+	})
+	if err != nil {
+		log.Error().Err(err).Msgf("Failed to read Spring environment on PID %d", javaVm.Pid())
+		return SpringEnvironment{}
+	}
+	return environment.(SpringEnvironment)
+}
+
+func (d *SpringDiscovery) readRequestMappings(javaVm jvm.JavaVm) SpringMvcMappings {
 	mappings, err := d.facade.SendCommandToAgentWithHandler(javaVm, "spring-mvc-mappings", "", func(response io.Reader) (any, error) {
-		var mappings []SpringMvcMapping
+		var mappings SpringMvcMappings
 		if err := json.NewDecoder(response).Decode(&mappings); err != nil {
 			return nil, fmt.Errorf("failed to decode spring-mvc-mappings response: %w", err)
 
 		}
-		log.Debug().Msgf("Result from command spring-mvc-mappings agent on PID %d: %v", javaVm.Pid(), mappings)
+		log.Debug().Msgf("Result from command spring-mvc-mappings agent on PID %d (mode %s): %v", javaVm.Pid(), mappings.Mode, mappings.Mappings)
 		return mappings, nil
 	})
 	if err != nil {
 		log.Error().Err(err).Msgf("Failed to read Sping MVC mappings on PID %d", javaVm.Pid())
-		return nil
+		return SpringMvcMappings{}
 	}
-	return mappings.([]SpringMvcMapping)
+	return mappings.(SpringMvcMappings)
 }
 
-func (d *SpringDiscovery) hasWebClient(jvm jvm.JavaVm) bool {
-	return d.hasSpringBean(jvm, springWebclientBeanClass) || d.hasSpringBean(jvm, springWebclientBuilderBeanClass)
+func (d *SpringDiscovery) readBeanInventory(javaVm jvm.JavaVm) []SpringBean {
+	beans, err := d.facade.SendCommandToAgentWithHandler(javaVm, "spring-bean-inventory", "", func(response io.Reader) (any, error) {
+		var beans []SpringBean
+		if err := json.NewDecoder(response).Decode(&beans); err != nil {
+			return nil, fmt.Errorf("failed to decode spring-bean-inventory response: %w", err)
+		}
+		log.Debug().Msgf("Result from command spring-bean-inventory agent on PID %d: %d beans", javaVm.Pid(), len(beans))
+		return beans, nil
+	})
+	if err != nil {
+		log.Error().Err(err).Msgf("Failed to read Spring Bean inventory on PID %d", javaVm.Pid())
+		return nil
+	}
+	return beans.([]SpringBean)
 }
 
-func (d *SpringDiscovery) hasRestTemplate(jvm jvm.JavaVm) bool {
-	return d.hasSpringBean(jvm, springRestTemplateBeanClass) || d.hasSpringBean(jvm, springRestTemplateBuilderBeanClass)
+func hasBeanOfCategory(beans []SpringBean, category string) bool {
+	return slices.ContainsFunc(beans, func(bean SpringBean) bool { return slices.Contains(bean.Categories, category) })
 }
 
-func (d *SpringDiscovery) hasJdbcTemplate(jvm jvm.JavaVm) bool {
-	return d.hasSpringBean(jvm, springJdbcTemplateBeanClass)
+// beanNamesOfCategory returns the names of the beans of the category, e.g. the executors to exhaust.
+func beanNamesOfCategory(beans []SpringBean, category string) []string {
+	var names []string
+	for _, bean := range beans {
+		if slices.Contains(bean.Categories, category) {
+			names = append(names, bean.Name)
+		}
+	}
+	return names
 }
 
 func (d *SpringDiscovery) isSpringBootApplication(jvm jvm.JavaVm) bool {
 	return d.facade.HasClassLoaded(jvm, springBootMarkerClass)
 }
 
-func (d *SpringDiscovery) hasSpringBean(javaVm jvm.JavaVm, beanClass string) bool {
-	result, err := d.facade.SendCommandToAgent(javaVm, "spring-bean", beanClass)
-	if err != nil {
-		log.Error().Err(err).Msgf("Failed to check Spring Bean %s on PID %d", beanClass, javaVm.Pid())
-		return false
-	}
-	return result
-}
-
 func (d *SpringDiscovery) readSpringApplicationName(javaVm jvm.JavaVm) string {
 	name, err := d.facade.SendCommandToAgentWithHandler(javaVm, "spring-main-context", "", func(response io.Reader) (any, error) {
 		result, err := jvm.GetCleanSocketCommandResult(response)
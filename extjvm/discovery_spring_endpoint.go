@@ -0,0 +1,196 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjvm
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/steadybit/discovery-kit/go/discovery_kit_api"
+	"github.com/steadybit/discovery-kit/go/discovery_kit_commons"
+	"github.com/steadybit/discovery-kit/go/discovery_kit_sdk"
+	"github.com/steadybit/extension-jvm/config"
+	"github.com/steadybit/extension-jvm/extjvm/jvm"
+	"github.com/steadybit/extension-jvm/extjvm/utils"
+	"github.com/steadybit/extension-kit/extbuild"
+)
+
+// springEndpointDiscovery publishes one target per request mapping pattern and http method of each Spring application,
+// so single endpoints can be selected and attacked.
+type springEndpointDiscovery struct {
+	jvms   jvmLister
+	spring *SpringDiscovery
+}
+
+var (
+	_ discovery_kit_sdk.TargetDescriber          = (*springEndpointDiscovery)(nil)
+	_ discovery_kit_sdk.AttributeDescriber       = (*springEndpointDiscovery)(nil)
+	_ discovery_kit_sdk.EnrichmentRulesDescriber = (*springEndpointDiscovery)(nil)
+)
+
+func NewSpringEndpointDiscovery(jvms jvmLister, spring *SpringDiscovery) discovery_kit_sdk.TargetDiscovery {
+	discovery := &springEndpointDiscovery{
+		jvms:   jvms,
+		spring: spring,
+	}
+	return discovery_kit_sdk.NewCachedTargetDiscovery(discovery,
+		discovery_kit_sdk.WithRefreshTargetsNow(),
+		discovery_kit_sdk.WithRefreshTargetsInterval(context.Background(), 30*time.Second),
+	)
+}
+
+func (d *springEndpointDiscovery) Describe() discovery_kit_api.DiscoveryDescription {
+	return discovery_kit_api.DiscoveryDescription{
+		Id: springEndpointTargetType,
+		Discover: discovery_kit_api.DescribingEndpointReferenceWithCallInterval{
+			CallInterval: new(config.Config.DiscoveryCallInterval),
+		},
+	}
+}
+
+func (d *springEndpointDiscovery) DescribeTarget() discovery_kit_api.TargetDescription {
+	return discovery_kit_api.TargetDescription{
+		Id:      springEndpointTargetType,
+		Version: extbuild.GetSemverVersionStringOrUnknown(),
+		Icon:    new(targetIcon),
+
+		// Labels used in the UI
+		Label: discovery_kit_api.PluralLabel{One: "Spring endpoint", Other: "Spring endpoints"},
+
+		// Category for the targets to appear in
+		Category: new(category),
+
+		// Specify attributes shown in table columns and to be used for sorting
+		Table: discovery_kit_api.Table{
+			Columns: []discovery_kit_api.Column{
+				{Attribute: "spring-endpoint.method"},
+				{Attribute: "spring-endpoint.pattern"},
+				{Attribute: "spring-instance.name"},
+				{Attribute: "k8s.namespace"},
+			},
+			OrderBy: []discovery_kit_api.OrderBy{
+				{
+					Attribute: "spring-endpoint.pattern",
+					Direction: "ASC",
+				},
+			},
+		},
+	}
+}
+
+func (d *springEndpointDiscovery) DescribeEnrichmentRules() []discovery_kit_api.TargetEnrichmentRule {
+	return []discovery_kit_api.TargetEnrichmentRule{
+		getJvmToLinkedTargetEnrichmentRule("com.steadybit.extension_jvm.jvm-to-spring-endpoint", springEndpointTargetType),
+	}
+}
+
+func (d *springEndpointDiscovery) DescribeAttributes() []discovery_kit_api.AttributeDescription {
+	return []discovery_kit_api.AttributeDescription{
+		{
+			Attribute: "spring-endpoint.pattern",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Request mapping",
+				Other: "Request mappings",
+			},
+		},
+		{
+			Attribute: "spring-endpoint.method",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Http method",
+				Other: "Http methods",
+			},
+		},
+		{
+			Attribute: "spring-endpoint.handler-class",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Handler class",
+				Other: "Handler classes",
+			},
+		},
+		{
+			Attribute: "spring-endpoint.handler-method",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Handler method",
+				Other: "Handler methods",
+			},
+		},
+		{
+			Attribute: "spring-endpoint.consumes",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Consumed media type",
+				Other: "Consumed media types",
+			},
+		},
+		{
+			Attribute: "spring-endpoint.produces",
+			Label: discovery_kit_api.PluralLabel{
+				One:   "Produced media type",
+				Other: "Produced media types",
+			},
+		},
+	}
+}
+
+func (d *springEndpointDiscovery) DiscoverTargets(_ context.Context) ([]discovery_kit_api.Target, error) {
+	javaVms := d.jvms.GetJvms()
+	var targets []discovery_kit_api.Target
+
+	for _, app := range d.spring.getApplications() {
+		idx := slices.IndexFunc(javaVms, func(javaVm jvm.JavaVm) bool { return javaVm.Pid() == app.Pid })
+		if idx == -1 {
+			continue
+		}
+		targets = append(targets, d.createEndpointTargets(javaVms[idx], app.MvcMappings)...)
+	}
+
+	return discovery_kit_commons.ApplyAttributeExcludes(targets, config.Config.DiscoveryAttributesExcludesJVM), nil
+}
+
+func (d *springEndpointDiscovery) createEndpointTargets(javaVm jvm.JavaVm, mappings []SpringMvcMapping) []discovery_kit_api.Target {
+	var targets []discovery_kit_api.Target
+	for _, mapping := range mappings {
+		// a mapping without methods matches all of them
+		methods := mapping.Methods
+		if len(methods) == 0 {
+			methods = []string{"*"}
+		}
+
+		for _, pattern := range mapping.Patterns {
+			for _, method := range methods {
+				endpoint := fmt.Sprintf("%s %s", method, pattern)
+
+				// mappings can share pattern and method, e.g. when they differ in params or headers
+				idx := slices.IndexFunc(targets, func(target discovery_kit_api.Target) bool { return target.Label == endpoint })
+				if idx == -1 {
+					target := newJvmLinkedTarget(javaVm, d.spring, springEndpointTargetType, endpoint, endpoint)
+					target.Attributes["spring-endpoint.pattern"] = []string{pattern}
+					target.Attributes["spring-endpoint.method"] = []string{method}
+					targets = append(targets, target)
+					idx = len(targets) - 1
+				}
+
+				addEndpointHandlerAttributes(&targets[idx], mapping)
+			}
+		}
+	}
+	return targets
+}
+
+func addEndpointHandlerAttributes(target *discovery_kit_api.Target, mapping SpringMvcMapping) {
+	if mapping.HandlerClass != "" {
+		target.Attributes["spring-endpoint.handler-class"] = utils.AppendIfMissing(target.Attributes["spring-endpoint.handler-class"], mapping.HandlerClass)
+	}
+	if mapping.HandlerName != "" {
+		target.Attributes["spring-endpoint.handler-method"] = utils.AppendIfMissing(target.Attributes["spring-endpoint.handler-method"], mapping.HandlerName)
+	}
+	for _, consumes := range mapping.Consumes {
+		target.Attributes["spring-endpoint.consumes"] = utils.AppendIfMissing(target.Attributes["spring-endpoint.consumes"], consumes)
+	}
+	for _, produces := range mapping.Produces {
+		target.Attributes["spring-endpoint.produces"] = utils.AppendIfMissing(target.Attributes["spring-endpoint.produces"], produces)
+	}
+}
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: MIT
+// SPDX-FileCopyrightText: 2026 Steadybit GmbH
+
+package extjvm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_springEndpointDiscovery_createEndpointTargets(t *testing.T) {
+	facade := &mockJavaFacade{}
+	spring := &SpringDiscovery{}
+
+	fake, err := facade.startFakeJvm()
+	require.NoError(t, err)
+	defer func(fake *FakeJvm) {
+		_ = fake.stop()
+	}(fake)
+
+	spring.applications.Store(fake.Pid(), SpringApplication{Name: "customers", Pid: fake.Pid()})
+	discovery := &springEndpointDiscovery{jvms: facade, spring: spring}
+
+	targets := discovery.createEndpointTargets(fake, []SpringMvcMapping{
+		{
+			Methods:      []string{"GET", "HEAD"},
+			Patterns:     []string{"/customers"},
+			Produces:     []string{"application/json"},
+			HandlerClass: "com.steadybit.demo.CustomerController",
+			HandlerName:  "customers",
+		},
+		{
+			Methods:      []string{"GET"},
+			Patterns:     []string{"/customers"},
+			Params:       []string{"format=csv"},
+			Produces:     []string{"text/csv"},
+			HandlerClass: "com.steadybit.demo.CustomerController",
+			HandlerName:  "customersAsCsv",
+		},
+		{
+			Patterns:     []string{"/ping"},
+			HandlerClass: "com.steadybit.demo.PingController",
+			HandlerName:  "ping",
+		},
+	})
+
+	require.Len(t, targets, 3)
+	assert.Equal(t, "GET /customers", targets[0].Label)
+	assert.Equal(t, []string{"/customers"}, targets[0].Attributes["spring-endpoint.pattern"])
+	assert.Equal(t, []string{"GET"}, targets[0].Attributes["spring-endpoint.method"])
+	assert.Equal(t, []string{"com.steadybit.demo.CustomerController"}, targets[0].Attributes["spring-endpoint.handler-class"])
+	assert.Equal(t, []string{"customers", "customersAsCsv"}, targets[0].Attributes["spring-endpoint.handler-method"])
+	assert.Equal(t, []string{"application/json", "text/csv"}, targets[0].Attributes["spring-endpoint.produces"])
+	assert.Equal(t, []string{"customers", "fake"}, targets[0].Attributes["jvm-instance.name"])
+	assert.Equal(t, []string{"customers"}, targets[0].Attributes["spring-instance.name"])
+	assert.Equal(t, "HEAD /customers", targets[1].Label)
+	assert.Equal(t, "* /ping", targets[2].Label)
+	assert.Equal(t, springEndpointTargetType, targets[2].TargetType)
+}
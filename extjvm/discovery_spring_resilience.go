@@ -0,0 +1,103 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package extjvm
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/steadybit/discovery-kit/go/discovery_kit_api"
+	"github.com/steadybit/extension-jvm/extjvm/utils"
+)
+
+var resilienceAttributes = map[string]string{
+	"circuit-breaker": "spring-instance.resilience.circuit-breakers",
+	"retry":           "spring-instance.resilience.retries",
+	"bulkhead":        "spring-instance.resilience.bulkheads",
+	"time-limiter":    "spring-instance.resilience.time-limiters",
+	"rate-limiter":    "spring-instance.resilience.rate-limiters",
+}
+
+// ResilienceReport is the checklist of risky calls and missing resilience patterns of a Spring application.
+type ResilienceReport struct {
+	Application string                `json:"application"`
+	Pid         int32                 `json:"pid"`
+	Score       *int                  `json:"score,omitempty"`
+	Findings    []string              `json:"findings"`
+	Components  []ResilienceComponent `json:"components"`
+}
+
+// buildResilienceReport aggregates the outgoing http calls without circuit breaker or timeout and the resilience
+// patterns configured via Resilience4j and Spring Retry. The score is the percentage of passed checks. The recorded
+// calls don't tell which of them are wrapped by a Resilience4j TimeLimiter, so calls without timeout are reported
+// regardless and the TimeLimiters are only listed as components.
+func buildResilienceReport(app SpringApplication) ResilienceReport {
+	report := ResilienceReport{
+		Application: app.Name,
+		Pid:         app.Pid,
+		Findings:    []string{},
+		Components:  app.Resilience,
+	}
+	checks := 0
+
+	for _, request := range app.HttpClientRequests {
+		checks += 2
+		if !request.CircuitBreaker {
+			report.Findings = append(report.Findings, fmt.Sprintf("missing-circuit-breaker: %s", request.Address))
+		}
+		if request.Timeout == 0 {
+			report.Findings = append(report.Findings, fmt.Sprintf("missing-timeout: %s", request.Address))
+		}
+	}
+
+	if len(app.HttpClientRequests) > 0 {
+		checks++
+		if !slices.ContainsFunc(app.Resilience, func(component ResilienceComponent) bool { return component.Type == "bulkhead" }) {
+			report.Findings = append(report.Findings, fmt.Sprintf("missing-bulkhead: %d outgoing calls", len(app.HttpClientRequests)))
+		}
+	}
+
+	for _, component := range app.Resilience {
+		if component.Type != "circuit-breaker" && component.Type != "retry" {
+			continue
+		}
+		checks++
+		if component.Fallback == "" {
+			report.Findings = append(report.Findings, fmt.Sprintf("missing-fallback: %s %s", component.Type, component.Name))
+		}
+	}
+
+	if checks > 0 {
+		report.Score = new((checks - len(report.Findings)) * 100 / checks)
+	}
+	return report
+}
+
+func addResilienceReport(target *discovery_kit_api.Target, report ResilienceReport) {
+	if len(report.Findings) > 0 {
+		target.Attributes["spring-instance.resilience.findings"] = report.Findings
+	}
+	if report.Score != nil {
+		target.Attributes["spring-instance.resilience.score"] = []string{fmt.Sprintf("%d", *report.Score)}
+	}
+	for _, component := range report.Components {
+		if attribute, ok := resilienceAttributes[component.Type]; ok {
+			target.Attributes[attribute] = utils.AppendIfMissing(target.Attributes[attribute], component.Name)
+		}
+		if component.Fallback != "" {
+			target.Attributes["spring-instance.resilience.fallbacks"] = utils.AppendIfMissing(target.Attributes["spring-instance.resilience.fallbacks"], fmt.Sprintf("%s: %s", component.Name, component.Fallback))
+		}
+	}
+}
+
+func (d *SpringDiscovery) getResilienceReports() []ResilienceReport {
+	reports := make([]ResilienceReport, 0)
+	for _, app := range d.getApplications() {
+		reports = append(reports, buildResilienceReport(app))
+	}
+	slices.SortFunc(reports, func(a, b ResilienceReport) int { return cmp.Compare(a.Pid, b.Pid) })
+	return reports
+}
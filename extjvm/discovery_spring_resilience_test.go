@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: MIT
+// SPDX-FileCopyrightText: 2026 Steadybit GmbH
+
+package extjvm
+
+import (
+	"testing"
+
+	"github.com/steadybit/discovery-kit/go/discovery_kit_api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_buildResilienceReport(t *testing.T) {
+	app := SpringApplication{
+		Name: "order-service",
+		Pid:  42,
+		HttpClientRequests: []HttpRequest{
+			{Address: "payment-service:8080", CircuitBreaker: true, Timeout: 1000},
+			{Address: "stock-service:8080"},
+		},
+		Resilience: []ResilienceComponent{
+			{Type: "circuit-breaker", Name: "payment", Library: "resilience4j", Fallback: "paymentFallback"},
+			{Type: "retry", Name: "payment", Library: "resilience4j", MaxAttempts: 3},
+		},
+	}
+
+	report := buildResilienceReport(app)
+
+	assert.Equal(t, "order-service", report.Application)
+	assert.Equal(t, []string{
+		"missing-circuit-breaker: stock-service:8080",
+		"missing-timeout: stock-service:8080",
+		"missing-bulkhead: 2 outgoing calls",
+		"missing-fallback: retry payment",
+	}, report.Findings)
+	require.NotNil(t, report.Score)
+	assert.Equal(t, 42, *report.Score)
+
+	target := discovery_kit_api.Target{Attributes: map[string][]string{}}
+	addResilienceReport(&target, report)
+	assert.Equal(t, []string{"42"}, target.Attributes["spring-instance.resilience.score"])
+	assert.Equal(t, []string{"payment"}, target.Attributes["spring-instance.resilience.circuit-breakers"])
+	assert.Equal(t, []string{"payment"}, target.Attributes["spring-instance.resilience.retries"])
+	assert.Equal(t, []string{"payment: paymentFallback"}, target.Attributes["spring-instance.resilience.fallbacks"])
+}
+
+func Test_buildResilienceReport_with_time_limiter(t *testing.T) {
+	app := SpringApplication{
+		Name: "order-service",
+		Pid:  42,
+		HttpClientRequests: []HttpRequest{
+			{Address: "stock-service:8080", CircuitBreaker: true},
+		},
+		Resilience: []ResilienceComponent{
+			{Type: "time-limiter", Name: "stock", Library: "resilience4j", Timeout: 2000},
+			{Type: "bulkhead", Name: "stock", Library: "resilience4j"},
+		},
+	}
+
+	report := buildResilienceReport(app)
+
+	// it is unknown whether the TimeLimiter wraps the call
+	assert.Equal(t, []string{"missing-timeout: stock-service:8080"}, report.Findings)
+	assert.Contains(t, report.Components, ResilienceComponent{Type: "time-limiter", Name: "stock", Library: "resilience4j", Timeout: 2000})
+	require.NotNil(t, report.Score)
+	assert.Equal(t, 66, *report.Score)
+
+	target := discovery_kit_api.Target{Attributes: map[string][]string{}}
+	addResilienceReport(&target, report)
+	assert.Equal(t, []string{"stock"}, target.Attributes["spring-instance.resilience.time-limiters"])
+}
+
+func Test_buildResilienceReport_without_checks(t *testing.T) {
+	report := buildResilienceReport(SpringApplication{Name: "batch", Pid: 1})
+
+	assert.Empty(t, report.Findings)
+	assert.Nil(t, report.Score)
+}
@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: MIT
+// SPDX-FileCopyrightText: 2026 Steadybit GmbH
+
+package extjvm
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/steadybit/discovery-kit/go/discovery_kit_api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_addSpringEnvironment(t *testing.T) {
+	target := discovery_kit_api.Target{Attributes: map[string][]string{}}
+
+	addSpringEnvironment(&target, SpringEnvironment{
+		BootVersion:      "3.3.4",
+		FrameworkVersion: "6.1.13",
+		ActiveProfiles:   []string{"canary", "kubernetes"},
+		ServerPort:       "8080",
+		GitCommit:        "1a2b3c4",
+	})
+
+	assert.Equal(t, map[string][]string{
+		"spring-instance.spring-boot-version":      {"3.3.4"},
+		"spring-instance.spring-framework-version": {"6.1.13"},
+		"spring-instance.active-profile":           {"canary", "kubernetes"},
+		"spring-instance.server.port":              {"8080"},
+		"spring-instance.git.commit":               {"1a2b3c4"},
+	}, target.Attributes)
+}
+
+func Test_SpringDiscovery_readRequestMappings(t *testing.T) {
+	facade := &mockJavaFacade{}
+	fake, err := facade.startFakeJvm()
+	require.NoError(t, err)
+	defer func(fake *FakeJvm) {
+		_ = fake.stop()
+	}(fake)
+
+	call := facade.On("SendCommandToAgentWithHandler", mock.Anything, "spring-mvc-mappings", "", mock.Anything)
+	call.Run(func(args mock.Arguments) {
+		handler := args.Get(3).(func(response io.Reader) (any, error))
+		result, err := handler(strings.NewReader(`{"mode":"application-context","mappings":[{"handlerClass":"com.example.CustomerController","handlerName":"customers","methods":["GET"],"patterns":["/customers"]}]}`))
+		call.ReturnArguments = mock.Arguments{result, err}
+	})
+
+	mappings := (&SpringDiscovery{facade: facade}).readRequestMappings(fake)
+
+	assert.Equal(t, SpringMvcMappings{
+		Mode: "application-context",
+		Mappings: []SpringMvcMapping{
+			{HandlerClass: "com.example.CustomerController", HandlerName: "customers", Methods: []string{"GET"}, Patterns: []string{"/customers"}},
+		},
+	}, mappings)
+}
+
+func Test_SpringDiscovery_readBeanInventory(t *testing.T) {
+	facade := &mockJavaFacade{}
+	fake, err := facade.startFakeJvm()
+	require.NoError(t, err)
+	defer func(fake *FakeJvm) {
+		_ = fake.stop()
+	}(fake)
+
+	call := facade.On("SendCommandToAgentWithHandler", mock.Anything, "spring-bean-inventory", "", mock.Anything)
+	call.Run(func(args mock.Arguments) {
+		handler := args.Get(3).(func(response io.Reader) (any, error))
+		result, err := handler(strings.NewReader(`[{"name":"customerRepository","type":"com.example.CustomerRepository","scope":"singleton"},{"name":"restTemplate","type":"org.springframework.web.client.RestTemplate","scope":"singleton","categories":["http-client"]},{"name":"taskExecutor","type":"org.springframework.scheduling.concurrent.ThreadPoolTaskExecutor","scope":"singleton","categories":["executor"]}]`))
+		call.ReturnArguments = mock.Arguments{result, err}
+	})
+
+	beans := (&SpringDiscovery{facade: facade}).readBeanInventory(fake)
+
+	assert.Equal(t, []SpringBean{
+		{Name: "customerRepository", Type: "com.example.CustomerRepository", Scope: "singleton"},
+		{Name: "restTemplate", Type: "org.springframework.web.client.RestTemplate", Scope: "singleton", Categories: []string{"http-client"}},
+		{Name: "taskExecutor", Type: "org.springframework.scheduling.concurrent.ThreadPoolTaskExecutor", Scope: "singleton", Categories: []string{"executor"}},
+	}, beans)
+	assert.Equal(t, []string{"taskExecutor"}, beanNamesOfCategory(beans, springBeanCategoryExecutor))
+	assert.True(t, hasBeanOfCategory(beans, springBeanCategoryHttpClient))
+	assert.False(t, hasBeanOfCategory(beans, springBeanCategoryJdbcClient))
+}
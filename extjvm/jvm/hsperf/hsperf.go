@@ -121,6 +121,38 @@ func (d Data) GetStringProperty(key string) string {
 	return ""
 }
 
+func (d Data) GetLongProperty(key string) (int64, bool) {
+	if value, ok := d.entries[key].(int64); ok {
+		return value, true
+	}
+	return 0, false
+}
+
+// MaxHeapSize returns the maximum heap size in bytes based on the capacity of the young and old generation or 0 if unknown.
+func (d Data) MaxHeapSize() int64 {
+	young, _ := d.GetLongProperty("sun.gc.generation.0.maxCapacity")
+	old, _ := d.GetLongProperty("sun.gc.generation.1.maxCapacity")
+	if policy, ok := d.entries["sun.gc.policy.name"].(string); ok && policy == "GarbageFirst" {
+		// G1 reports the whole heap as maximum capacity for both generations
+		return max(young, old)
+	}
+	return young + old
+}
+
+// UsedHeapSize returns the used heap in bytes summed over the spaces of the young and old generation.
+func (d Data) UsedHeapSize() int64 {
+	var used int64
+	for key, value := range d.entries {
+		if !strings.HasPrefix(key, "sun.gc.generation.0.space.") && !strings.HasPrefix(key, "sun.gc.generation.1.space.") {
+			continue
+		}
+		if v, ok := value.(int64); ok && strings.HasSuffix(key, ".used") {
+			used += v
+		}
+	}
+	return used
+}
+
 func findAlternateTempDir(p *process.Process) string {
 	if cmdline, err := p.CmdlineSlice(); err == nil {
 		for _, arg := range cmdline {
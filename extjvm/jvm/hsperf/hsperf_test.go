@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: MIT
+// SPDX-FileCopyrightText: 2026 Steadybit GmbH
+
+package hsperf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestData_MaxHeapSize(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries map[string]any
+		want    int64
+	}{
+		{
+			name: "generational collector",
+			entries: map[string]any{
+				"sun.gc.policy.name":               "ParMarkSweep",
+				"sun.gc.generation.0.maxCapacity":  int64(100),
+				"sun.gc.generation.1.maxCapacity":  int64(200),
+				"sun.gc.generation.2.maxCapacity":  int64(50),
+				"sun.gc.generation.0.space.0.size": int64(10),
+			},
+			want: 300,
+		},
+		{
+			name: "g1",
+			entries: map[string]any{
+				"sun.gc.policy.name":              "GarbageFirst",
+				"sun.gc.generation.0.maxCapacity": int64(512),
+				"sun.gc.generation.1.maxCapacity": int64(512),
+			},
+			want: 512,
+		},
+		{
+			name:    "unknown",
+			entries: map[string]any{},
+			want:    0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Data{entries: tt.entries}.MaxHeapSize())
+		})
+	}
+}
+
+func TestData_UsedHeapSize(t *testing.T) {
+	data := Data{entries: map[string]any{
+		"sun.gc.generation.0.space.0.used": int64(10),
+		"sun.gc.generation.0.space.1.used": int64(5),
+		"sun.gc.generation.0.space.1.size": int64(50),
+		"sun.gc.generation.1.space.0.used": int64(100),
+		"sun.gc.generation.2.space.0.used": int64(1000),
+	}}
+	assert.Equal(t, int64(115), data.UsedHeapSize())
+}
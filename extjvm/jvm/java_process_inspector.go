@@ -141,19 +141,9 @@ func (i *JavaProcessInspector) createJvmUsingHsperfdata(ctx context.Context, p *
 		return nil, nil
 	}
 
-	tempFile := filepath.Join(os.TempDir(), fmt.Sprintf("hsperfdata_%d", p.Pid))
-	if err := utils.RootCommandContext(ctx, "cp", path, tempFile).Run(); err != nil {
-		return nil, fmt.Errorf("error while copying hsperfdata: %w", err)
-	}
-	defer func() {
-		if err := utils.RootCommandContext(ctx, "rm", tempFile).Run(); err != nil {
-			log.Warn().Msgf("Error while removing temp file %s: %s", tempFile, err)
-		}
-	}()
-
-	data, err := hsperf.ReadData(tempFile)
+	data, err := readHsperfdata(ctx, p.Pid, path)
 	if err != nil {
-		return nil, fmt.Errorf("error while reading hsperfdata: %w", err)
+		return nil, err
 	}
 
 	if !data.IsAttachable() {
@@ -178,6 +168,43 @@ func (i *JavaProcessInspector) createJvmUsingHsperfdata(ctx context.Context, p *
 	return vm, nil
 }
 
+// ReadHsperfdata reads the current hsperfdata of the given JVM.
+func ReadHsperfdata(ctx context.Context, javaVm JavaVm) (hsperf.Data, error) {
+	p, err := process.NewProcessWithContext(ctx, javaVm.Pid())
+	if err != nil {
+		return hsperf.Data{}, err
+	}
+
+	var path string
+	if vm, ok := javaVm.(JavaVmInContainer); ok {
+		path = hsperf.FindHsperfdataFileContainer(ctx, p, vm.PidInContainer())
+	} else {
+		path = hsperf.FindHsperfdataFile(ctx, p)
+	}
+	if path == "" {
+		return hsperf.Data{}, fmt.Errorf("no hsperfdata found for pid %d", javaVm.Pid())
+	}
+	return readHsperfdata(ctx, javaVm.Pid(), path)
+}
+
+func readHsperfdata(ctx context.Context, pid int32, path string) (hsperf.Data, error) {
+	tempFile := filepath.Join(os.TempDir(), fmt.Sprintf("hsperfdata_%d", pid))
+	if err := utils.RootCommandContext(ctx, "cp", path, tempFile).Run(); err != nil {
+		return hsperf.Data{}, fmt.Errorf("error while copying hsperfdata: %w", err)
+	}
+	defer func() {
+		if err := utils.RootCommandContext(ctx, "rm", tempFile).Run(); err != nil {
+			log.Warn().Msgf("Error while removing temp file %s: %s", tempFile, err)
+		}
+	}()
+
+	data, err := hsperf.ReadData(tempFile)
+	if err != nil {
+		return hsperf.Data{}, fmt.Errorf("error while reading hsperfdata: %w", err)
+	}
+	return data, nil
+}
+
 func createJvmFromProcess(p *process.Process, source string) *defaultJavaVm {
 	discoveredVia := "os-process"
 	if source != "os-process" {
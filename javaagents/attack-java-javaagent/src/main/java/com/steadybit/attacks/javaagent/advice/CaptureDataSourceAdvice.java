@@ -0,0 +1,17 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.advice;
+
+import com.steadybit.javaagent.instrumentation.InstrumentationPluginDispatcher;
+import com.steadybit.javaagent.instrumentation.Registration;
+import com.steadybit.shaded.net.bytebuddy.asm.Advice;
+
+public class CaptureDataSourceAdvice {
+
+    @Advice.OnMethodExit(suppress = Throwable.class)
+    static void exit(@Registration int registration, @Advice.This Object dataSource, @Advice.Return Object connection) {
+        InstrumentationPluginDispatcher.find(registration).exec(7, dataSource, connection);
+    }
+}
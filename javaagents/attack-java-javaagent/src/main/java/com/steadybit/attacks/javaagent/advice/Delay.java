@@ -1,15 +0,0 @@
-/*
- * Copyright 2020 steadybit GmbH. All rights reserved.
- */
-
-package com.steadybit.attacks.javaagent.advice;
-
-import java.lang.annotation.ElementType;
-import java.lang.annotation.Retention;
-import java.lang.annotation.RetentionPolicy;
-import java.lang.annotation.Target;
-
-@Retention(RetentionPolicy.RUNTIME)
-@Target(ElementType.PARAMETER)
-public @interface Delay {
-}
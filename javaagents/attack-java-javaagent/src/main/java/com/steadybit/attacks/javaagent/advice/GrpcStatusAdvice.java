@@ -0,0 +1,20 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.advice;
+
+import com.steadybit.javaagent.instrumentation.InstrumentationPluginDispatcher;
+import com.steadybit.javaagent.instrumentation.Registration;
+import com.steadybit.shaded.net.bytebuddy.asm.Advice;
+
+public class GrpcStatusAdvice {
+
+    @Advice.OnMethodEnter
+    static void enter(@Registration int registration, @Advice.This Object owner, @Advice.Argument(0) Object argument) {
+        Object exception = InstrumentationPluginDispatcher.find(registration).exec(5, owner, argument);
+        if (exception instanceof RuntimeException) {
+            throw (RuntimeException) exception;
+        }
+    }
+}
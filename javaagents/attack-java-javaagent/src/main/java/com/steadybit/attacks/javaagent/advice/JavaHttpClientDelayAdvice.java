@@ -0,0 +1,20 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.advice;
+
+import com.steadybit.javaagent.instrumentation.InstrumentationPluginDispatcher;
+import com.steadybit.javaagent.instrumentation.Registration;
+import com.steadybit.shaded.net.bytebuddy.asm.Advice;
+
+public class JavaHttpClientDelayAdvice {
+    @Advice.OnMethodEnter
+    static void enter(@Registration int registration, @Advice.This Object owner, @Advice.Origin("#m") String method,
+                      @Advice.AllArguments Object[] arguments) throws Throwable {
+        Object timeout = InstrumentationPluginDispatcher.find(registration).exec(2, owner, method, arguments);
+        if (timeout instanceof Throwable) {
+            throw (Throwable) timeout;
+        }
+    }
+}
@@ -0,0 +1,29 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.advice;
+
+import com.steadybit.javaagent.instrumentation.InstrumentationPluginDispatcher;
+import com.steadybit.javaagent.instrumentation.Registration;
+import com.steadybit.shaded.net.bytebuddy.asm.Advice;
+import com.steadybit.shaded.net.bytebuddy.implementation.bytecode.assign.Assigner;
+
+public class JavaHttpClientStatusAdvice {
+    @Advice.OnMethodEnter(skipOn = Advice.OnNonDefaultValue.class)
+    static Object enter(@Registration int registration, @Advice.This Object owner, @Advice.Origin("#m") String method,
+                        @Advice.AllArguments Object[] arguments) {
+        return InstrumentationPluginDispatcher.find(registration).exec(1, owner, method, arguments);
+    }
+
+    @Advice.OnMethodExit
+    // java:S1226 This is how bytebuddy assigns new response values.
+    @SuppressWarnings("java:S1226")
+    static void exit(@Advice.Return(readOnly = false, typing = Assigner.Typing.DYNAMIC) Object response, @Advice.Enter Object simulated) throws Throwable {
+        if (simulated instanceof Throwable) {
+            throw (Throwable) simulated;
+        } else if (simulated != null) {
+            response = simulated;
+        }
+    }
+}
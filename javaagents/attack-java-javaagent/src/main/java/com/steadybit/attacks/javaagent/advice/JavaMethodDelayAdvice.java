@@ -4,20 +4,34 @@
 
 package com.steadybit.attacks.javaagent.advice;
 
+import com.steadybit.javaagent.instrumentation.InstrumentationPluginDispatcher;
+import com.steadybit.javaagent.instrumentation.Registration;
 import com.steadybit.shaded.net.bytebuddy.asm.Advice;
+import com.steadybit.shaded.net.bytebuddy.implementation.bytecode.assign.Assigner;
 
-import java.util.concurrent.ThreadLocalRandom;
+import java.lang.reflect.Method;
 
 public class JavaMethodDelayAdvice {
 
     @Advice.OnMethodEnter
-    static void enter(@Delay long delay, @Jitter boolean delayJitter) {
-        long millis;
-        if (delayJitter) {
-            double jitterValue = 1.3d - ThreadLocalRandom.current().nextDouble(0.6d);
-            millis = Math.round(jitterValue * delay);
-        } else {
-            millis = delay;
+    static Long enter(@Registration int registration, @Advice.AllArguments Object[] arguments, @Advice.Origin Method method) {
+        if (!Boolean.TRUE.equals(InstrumentationPluginDispatcher.find(registration).exec(3, arguments))) {
+            return null;
+        }
+
+        Long millis = (Long) InstrumentationPluginDispatcher.find(registration).exec(2);
+        if (millis == null) {
+            return null;
+        }
+
+        if (Boolean.TRUE.equals(InstrumentationPluginDispatcher.find(registration).exec(4, method.getReturnType()))) {
+            //reactive results are delayed on exit, so the calling event-loop thread is not blocked.
+            return millis;
+        }
+
+        if (Boolean.TRUE.equals(InstrumentationPluginDispatcher.find(registration).exec(7))) {
+            //blocking the event-loop thread would stall all requests, not only the attacked ones.
+            return null;
         }
 
         try {
@@ -26,5 +40,14 @@ public class JavaMethodDelayAdvice {
             //ignore the interruption and restore interruption flag.
             Thread.currentThread().interrupt();
         }
+        return null;
+    }
+
+    @Advice.OnMethodExit
+    static void exit(@Registration int registration, @Advice.Enter Long millis,
+                     @Advice.Return(readOnly = false, typing = Assigner.Typing.DYNAMIC) Object returned) {
+        if (millis != null && returned != null) {
+            returned = InstrumentationPluginDispatcher.find(registration).exec(5, returned, millis);
+        }
     }
 }
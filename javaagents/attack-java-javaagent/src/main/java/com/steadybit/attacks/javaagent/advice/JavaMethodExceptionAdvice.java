@@ -4,19 +4,28 @@
 
 package com.steadybit.attacks.javaagent.advice;
 
+import com.steadybit.javaagent.instrumentation.InstrumentationPluginDispatcher;
+import com.steadybit.javaagent.instrumentation.Registration;
 import com.steadybit.shaded.net.bytebuddy.asm.Advice;
 
 import java.util.concurrent.ThreadLocalRandom;
 
 public class JavaMethodExceptionAdvice {
     @Advice.OnMethodEnter
-    static void enter(@ErrorRate int errorRate) {
-        if (errorRate < 100) {
-            if (ThreadLocalRandom.current().nextInt(100) < errorRate) {
-                throw new RuntimeException("Exception injected by steadybit");
-            }
-        } else {
-            throw new RuntimeException("Exception injected by steadybit");
+    static void enter(@ErrorRate int errorRate, @Registration int registration, @Advice.AllArguments Object[] arguments, @Advice.Origin Class<?> type)
+            throws Throwable {
+        if (!Boolean.TRUE.equals(InstrumentationPluginDispatcher.find(registration).exec(3, arguments))) {
+            return;
         }
+
+        if (errorRate < 100 && ThreadLocalRandom.current().nextInt(100) >= errorRate) {
+            return;
+        }
+
+        Object exception = InstrumentationPluginDispatcher.find(registration).exec(5, type);
+        if (exception instanceof Throwable) {
+            throw (Throwable) exception;
+        }
+        throw new RuntimeException("Exception injected by steadybit");
     }
 }
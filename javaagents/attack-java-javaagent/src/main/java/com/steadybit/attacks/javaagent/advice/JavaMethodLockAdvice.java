@@ -0,0 +1,22 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.advice;
+
+import com.steadybit.javaagent.instrumentation.InstrumentationPluginDispatcher;
+import com.steadybit.javaagent.instrumentation.Registration;
+import com.steadybit.shaded.net.bytebuddy.asm.Advice;
+
+public class JavaMethodLockAdvice {
+
+    @Advice.OnMethodEnter
+    static void enter(@Registration int registration, @Advice.AllArguments Object[] arguments, @Advice.Origin Class<?> type,
+                      @Advice.Origin("#m") String method) {
+        if (!Boolean.TRUE.equals(InstrumentationPluginDispatcher.find(registration).exec(3, arguments))) {
+            return;
+        }
+
+        InstrumentationPluginDispatcher.find(registration).exec(6, type, method);
+    }
+}
@@ -0,0 +1,30 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.advice;
+
+import com.steadybit.javaagent.instrumentation.InstrumentationPluginDispatcher;
+import com.steadybit.javaagent.instrumentation.Registration;
+import com.steadybit.shaded.net.bytebuddy.asm.Advice;
+import com.steadybit.shaded.net.bytebuddy.implementation.bytecode.assign.Assigner;
+
+import java.lang.reflect.Method;
+
+public class JavaMethodReturnValueAdvice {
+
+    @Advice.OnMethodEnter(skipOn = Advice.OnNonDefaultValue.class)
+    static Object[] enter(@Registration int registration, @Advice.AllArguments Object[] arguments, @Advice.Origin Method method) {
+        if (!Boolean.TRUE.equals(InstrumentationPluginDispatcher.find(registration).exec(3, arguments))) {
+            return null;
+        }
+        return (Object[]) InstrumentationPluginDispatcher.find(registration).exec(4, method.getReturnType());
+    }
+
+    @Advice.OnMethodExit
+    static void exit(@Advice.Enter Object[] holder, @Advice.Return(readOnly = false, typing = Assigner.Typing.DYNAMIC) Object returned) {
+        if (holder != null) {
+            returned = holder[0];
+        }
+    }
+}
@@ -0,0 +1,38 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.advice;
+
+import com.steadybit.javaagent.instrumentation.InstrumentationPluginDispatcher;
+import com.steadybit.javaagent.instrumentation.Registration;
+import com.steadybit.shaded.net.bytebuddy.asm.Advice;
+
+public class JdbcStatementDelayAdvice {
+
+    @Advice.OnMethodEnter
+    static boolean enter(@Registration int registration, @Advice.This Object statement, @Advice.Origin("#m") String method,
+                         @Advice.AllArguments Object[] arguments) {
+        if (!Boolean.TRUE.equals(InstrumentationPluginDispatcher.find(registration).exec(8, statement, method, arguments))) {
+            return false;
+        }
+
+        Long millis = (Long) InstrumentationPluginDispatcher.find(registration).exec(2);
+        if (millis != null) {
+            try {
+                Thread.sleep(millis);
+            } catch (InterruptedException e) {
+                //ignore the interruption and restore interruption flag.
+                Thread.currentThread().interrupt();
+            }
+        }
+        return true;
+    }
+
+    @Advice.OnMethodExit(onThrowable = Throwable.class)
+    static void exit(@Registration int registration, @Advice.Enter boolean executing) {
+        if (executing) {
+            InstrumentationPluginDispatcher.find(registration).exec(9);
+        }
+    }
+}
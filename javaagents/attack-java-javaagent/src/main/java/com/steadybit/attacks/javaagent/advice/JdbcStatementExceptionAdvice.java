@@ -0,0 +1,36 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.advice;
+
+import com.steadybit.javaagent.instrumentation.InstrumentationPluginDispatcher;
+import com.steadybit.javaagent.instrumentation.Registration;
+import com.steadybit.shaded.net.bytebuddy.asm.Advice;
+
+import java.sql.SQLException;
+
+public class JdbcStatementExceptionAdvice {
+
+    @Advice.OnMethodEnter
+    static boolean enter(@Registration int registration, @Advice.This Object statement, @Advice.Origin("#m") String method,
+                         @Advice.AllArguments Object[] arguments) throws SQLException {
+        if (!Boolean.TRUE.equals(InstrumentationPluginDispatcher.find(registration).exec(8, statement, method, arguments))) {
+            return false;
+        }
+
+        Object exception = InstrumentationPluginDispatcher.find(registration).exec(5);
+        if (exception instanceof SQLException) {
+            InstrumentationPluginDispatcher.find(registration).exec(9);
+            throw (SQLException) exception;
+        }
+        return true;
+    }
+
+    @Advice.OnMethodExit(onThrowable = Throwable.class)
+    static void exit(@Registration int registration, @Advice.Enter boolean executing) {
+        if (executing) {
+            InstrumentationPluginDispatcher.find(registration).exec(9);
+        }
+    }
+}
@@ -4,17 +4,18 @@
 
 package com.steadybit.attacks.javaagent.advice;
 
+import com.steadybit.javaagent.instrumentation.InstrumentationPluginDispatcher;
+import com.steadybit.javaagent.instrumentation.Registration;
 import com.steadybit.shaded.net.bytebuddy.asm.Advice;
 import org.springframework.jdbc.core.JdbcTemplate;
 
 import javax.sql.DataSource;
 import java.sql.Connection;
 import java.sql.SQLException;
-import java.util.concurrent.ThreadLocalRandom;
 
 public class JdbcTemplateDelayAdvice {
     @Advice.OnMethodEnter
-    static void enter(@Delay long delay, @Jitter boolean delayJitter, @JdbcUrl String jdbcUrl, @Advice.This JdbcTemplate jdbcTemplate) {
+    static void enter(@Registration int registration, @JdbcUrl String jdbcUrl, @Advice.This JdbcTemplate jdbcTemplate) {
         DataSource dataSource = jdbcTemplate.getDataSource();
         if (!jdbcUrl.equals("*") && dataSource != null) {
             try (Connection connection = dataSource.getConnection()) {
@@ -27,12 +28,9 @@ public class JdbcTemplateDelayAdvice {
             }
         }
 
-        long millis;
-        if (delayJitter) {
-            double jitterValue = 1.3d - ThreadLocalRandom.current().nextDouble(0.6d);
-            millis = Math.round(jitterValue * delay);
-        } else {
-            millis = delay;
+        Long millis = (Long) InstrumentationPluginDispatcher.find(registration).exec(2);
+        if (millis == null) {
+            return;
         }
 
         try {
@@ -42,4 +40,4 @@ public class JdbcTemplateDelayAdvice {
             Thread.currentThread().interrupt();
         }
     }
-}
\ No newline at end of file
+}
@@ -0,0 +1,22 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.advice;
+
+import com.steadybit.javaagent.instrumentation.InstrumentationPluginDispatcher;
+import com.steadybit.javaagent.instrumentation.Registration;
+import com.steadybit.shaded.net.bytebuddy.asm.Advice;
+
+public class JerseyRequestAdvice {
+
+    @Advice.OnMethodEnter
+    static void enter(@Registration int registration, @Advice.Argument(0) Object request) {
+        InstrumentationPluginDispatcher.find(registration).exec(8, request);
+    }
+
+    @Advice.OnMethodExit(onThrowable = Throwable.class)
+    static void exit(@Registration int registration) {
+        InstrumentationPluginDispatcher.find(registration).exec(8, (Object) null);
+    }
+}
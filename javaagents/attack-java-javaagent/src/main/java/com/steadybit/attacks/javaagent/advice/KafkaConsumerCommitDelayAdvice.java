@@ -0,0 +1,24 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.advice;
+
+import com.steadybit.javaagent.instrumentation.InstrumentationPluginDispatcher;
+import com.steadybit.javaagent.instrumentation.Registration;
+import com.steadybit.shaded.net.bytebuddy.asm.Advice;
+
+public class KafkaConsumerCommitDelayAdvice {
+
+    @Advice.OnMethodEnter
+    static boolean enter(@Registration int registration, @Advice.This Object consumer) {
+        return Boolean.TRUE.equals(InstrumentationPluginDispatcher.find(registration).exec(8, consumer));
+    }
+
+    @Advice.OnMethodExit(onThrowable = Throwable.class)
+    static void exit(@Registration int registration, @Advice.Enter boolean committing) {
+        if (committing) {
+            InstrumentationPluginDispatcher.find(registration).exec(9);
+        }
+    }
+}
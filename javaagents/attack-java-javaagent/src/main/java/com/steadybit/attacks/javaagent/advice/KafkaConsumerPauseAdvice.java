@@ -0,0 +1,34 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.advice;
+
+import com.steadybit.javaagent.instrumentation.InstrumentationPluginDispatcher;
+import com.steadybit.javaagent.instrumentation.Registration;
+import com.steadybit.shaded.net.bytebuddy.asm.Advice;
+
+import java.util.Collection;
+
+public class KafkaConsumerPauseAdvice {
+
+    @Advice.OnMethodEnter
+    static Object enter(@Registration int registration, @Advice.This Object consumer) {
+        return InstrumentationPluginDispatcher.find(registration).exec(10, consumer);
+    }
+
+    /**
+     * Resumes the partitions without the dispatcher, as the plugin is already deregistered if the attack is reset
+     * while polling and the partitions would stay paused.
+     */
+    @Advice.OnMethodExit(onThrowable = Throwable.class)
+    static void exit(@Advice.This Object consumer, @Advice.Enter Object paused) {
+        if (paused != null) {
+            try {
+                consumer.getClass().getMethod("resume", Collection.class).invoke(consumer, paused);
+            } catch (Exception ignored) {
+                // the consumer has been closed
+            }
+        }
+    }
+}
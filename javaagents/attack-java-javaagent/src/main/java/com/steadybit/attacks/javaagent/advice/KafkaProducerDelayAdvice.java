@@ -0,0 +1,27 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.advice;
+
+import com.steadybit.javaagent.instrumentation.InstrumentationPluginDispatcher;
+import com.steadybit.javaagent.instrumentation.Registration;
+import com.steadybit.shaded.net.bytebuddy.asm.Advice;
+
+public class KafkaProducerDelayAdvice {
+
+    @Advice.OnMethodEnter
+    static void enter(@Registration int registration, @Advice.This Object producer, @Advice.Argument(0) Object record) {
+        Long millis = (Long) InstrumentationPluginDispatcher.find(registration).exec(2, producer, record);
+        if (millis == null) {
+            return;
+        }
+
+        try {
+            Thread.sleep(millis);
+        } catch (InterruptedException e) {
+            //ignore the interruption and restore interruption flag.
+            Thread.currentThread().interrupt();
+        }
+    }
+}
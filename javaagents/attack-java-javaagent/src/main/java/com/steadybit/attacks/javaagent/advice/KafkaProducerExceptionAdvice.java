@@ -0,0 +1,28 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.advice;
+
+import com.steadybit.javaagent.instrumentation.InstrumentationPluginDispatcher;
+import com.steadybit.javaagent.instrumentation.Registration;
+import com.steadybit.shaded.net.bytebuddy.asm.Advice;
+import com.steadybit.shaded.net.bytebuddy.implementation.bytecode.assign.Assigner;
+
+public class KafkaProducerExceptionAdvice {
+
+    @Advice.OnMethodEnter(skipOn = Advice.OnNonDefaultValue.class)
+    static Object enter(@Registration int registration, @Advice.This Object producer, @Advice.Argument(0) Object record,
+                        @Advice.Argument(1) Object callback) {
+        return InstrumentationPluginDispatcher.find(registration).exec(5, producer, record, callback);
+    }
+
+    @Advice.OnMethodExit
+    // java:S1226 This is how bytebuddy assigns new return values.
+    @SuppressWarnings("java:S1226")
+    static void exit(@Advice.Return(readOnly = false, typing = Assigner.Typing.DYNAMIC) Object future, @Advice.Enter Object failed) {
+        if (failed != null) {
+            future = failed;
+        }
+    }
+}
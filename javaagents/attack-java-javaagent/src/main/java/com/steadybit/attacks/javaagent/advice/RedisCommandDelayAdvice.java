@@ -0,0 +1,25 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.advice;
+
+import com.steadybit.javaagent.instrumentation.InstrumentationPluginDispatcher;
+import com.steadybit.javaagent.instrumentation.Registration;
+import com.steadybit.shaded.net.bytebuddy.asm.Advice;
+
+public class RedisCommandDelayAdvice {
+
+    @Advice.OnMethodEnter
+    static void enter(@Registration int registration, @Advice.This Object owner, @Advice.Argument(0) Object command) {
+        Long millis = (Long) InstrumentationPluginDispatcher.find(registration).exec(2, owner, command);
+        if (millis != null) {
+            try {
+                Thread.sleep(millis);
+            } catch (InterruptedException e) {
+                //ignore the interruption and restore interruption flag.
+                Thread.currentThread().interrupt();
+            }
+        }
+    }
+}
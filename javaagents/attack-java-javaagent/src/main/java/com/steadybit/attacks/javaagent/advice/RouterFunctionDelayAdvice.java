@@ -0,0 +1,28 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.advice;
+
+import com.steadybit.javaagent.instrumentation.InstrumentationPluginDispatcher;
+import com.steadybit.javaagent.instrumentation.Registration;
+import com.steadybit.shaded.net.bytebuddy.asm.Advice;
+import com.steadybit.shaded.net.bytebuddy.implementation.bytecode.assign.Assigner;
+
+public class RouterFunctionDelayAdvice {
+
+    @Advice.OnMethodExit
+    static void exit(@Registration int registration, @Advice.Argument(0) Object exchange,
+                     @Advice.Return(readOnly = false, typing = Assigner.Typing.DYNAMIC) Object result) {
+        if (result == null || !Boolean.TRUE.equals(InstrumentationPluginDispatcher.find(registration).exec(6, exchange))) {
+            return;
+        }
+
+        Long millis = (Long) InstrumentationPluginDispatcher.find(registration).exec(2);
+        if (millis == null) {
+            return;
+        }
+
+        result = InstrumentationPluginDispatcher.find(registration).exec(5, result, millis);
+    }
+}
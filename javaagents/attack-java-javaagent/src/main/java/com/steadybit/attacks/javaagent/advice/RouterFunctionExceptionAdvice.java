@@ -0,0 +1,32 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.advice;
+
+import com.steadybit.javaagent.instrumentation.InstrumentationPluginDispatcher;
+import com.steadybit.javaagent.instrumentation.Registration;
+import com.steadybit.shaded.net.bytebuddy.asm.Advice;
+
+import java.util.concurrent.ThreadLocalRandom;
+
+public class RouterFunctionExceptionAdvice {
+    @Advice.OnMethodEnter
+    static void enter(@ErrorRate int errorRate, @Registration int registration, @Advice.Argument(0) Object exchange, @Advice.Origin Class<?> type)
+            throws Throwable {
+        if (!Boolean.TRUE.equals(InstrumentationPluginDispatcher.find(registration).exec(6, exchange))) {
+            return;
+        }
+
+        if (errorRate < 100 && ThreadLocalRandom.current().nextInt(100) >= errorRate) {
+            return;
+        }
+
+        // thrown within the dispatcher's flatMap and therefore emitted as error signal
+        Object exception = InstrumentationPluginDispatcher.find(registration).exec(5, type);
+        if (exception instanceof Throwable) {
+            throw (Throwable) exception;
+        }
+        throw new RuntimeException("Exception injected by steadybit");
+    }
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.instrumentation;
+
+import com.steadybit.javaagent.instrumentation.Registration;
+import com.steadybit.javaagent.log.Logger;
+import com.steadybit.javaagent.log.RemoteAgentLogger;
+import com.steadybit.shaded.net.bytebuddy.agent.builder.AgentBuilder;
+import com.steadybit.shaded.net.bytebuddy.asm.Advice;
+import com.steadybit.shaded.net.bytebuddy.description.method.MethodDescription;
+import com.steadybit.shaded.net.bytebuddy.description.type.TypeDescription;
+import com.steadybit.shaded.net.bytebuddy.matcher.ElementMatcher;
+import org.json.JSONObject;
+
+import java.lang.instrument.Instrumentation;
+
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.named;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.namedOneOf;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.takesArguments;
+
+/**
+ * Base for attacks on the calls of grpc-java.
+ * <p>
+ * Client calls are attacked when started ({@code ClientCallImpl.start}), which all stubs do. Server calls are attacked
+ * when the call handlers of the stubs are started ({@code ServerCalls.*ServerCallHandler.startCall}), before the
+ * service implementation is invoked. A failure is thrown as {@code StatusRuntimeException}, which gRPC reports as the
+ * status of the call. Asynchronous client stubs receive it when starting the call, not through the observer.
+ */
+public abstract class AbstractGrpcInstrumentation extends ClassTransformationPlugin {
+    private static final Logger log = RemoteAgentLogger.getLogger(AbstractGrpcInstrumentation.class);
+    private final boolean server;
+    private final String service;
+    private final String method;
+
+    protected AbstractGrpcInstrumentation(Instrumentation instrumentation, JSONObject config, boolean server) {
+        super(instrumentation);
+        this.server = server;
+        this.service = config.optString("service", "*");
+        this.method = config.optString("method", "*");
+    }
+
+    @Override
+    protected AgentBuilder doInstall(AgentBuilder agentBuilder) {
+        ElementMatcher<TypeDescription> types = this.server ? namedOneOf(GrpcCalls.SERVER_CALL_HANDLERS) : named(GrpcCalls.CLIENT_CALL);
+        ElementMatcher<MethodDescription> methods = named(this.server ? "startCall" : "start").and(takesArguments(2));
+        return agentBuilder.type(types)
+                .transform(new AgentBuilder.Transformer.ForAdvice(Advice.withCustomMapping() //
+                        .bind(Registration.class, this.getRegistration())) //
+                        .include(this.getAdvice().getClassLoader()) //
+                        .advice(methods, this.getAdvice().getName()));
+    }
+
+    protected abstract Class<?> getAdvice();
+
+    protected abstract int getCode();
+
+    /**
+     * @return the value handed to the advice for a matching call.
+     */
+    protected abstract Object attack(Object call, String fullMethodName);
+
+    /**
+     * @param owner    the instrumented {@code ClientCallImpl} or call handler
+     * @param argument the first argument, the {@code ServerCall} for call handlers
+     */
+    @Override
+    public Object exec(int code, Object owner, Object argument) {
+        if (code != this.getCode()) {
+            return null;
+        }
+
+        Object call = this.server ? argument : owner;
+        try {
+            String fullMethodName = GrpcCalls.getFullMethodName(call);
+            return GrpcCalls.matches(this.service, this.method, fullMethodName) ? this.attack(call, fullMethodName) : null;
+        } catch (ReflectiveOperationException | RuntimeException e) {
+            log.debug("Could not attack gRPC call " + call.getClass().getName() + ": " + e.getMessage());
+            return null;
+        }
+    }
+}
@@ -0,0 +1,102 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.instrumentation;
+
+import com.steadybit.javaagent.instrumentation.InstrumentationPluginDispatcher;
+import com.steadybit.javaagent.instrumentation.Registration;
+import com.steadybit.javaagent.log.Logger;
+import com.steadybit.javaagent.log.RemoteAgentLogger;
+import com.steadybit.shaded.net.bytebuddy.agent.builder.AgentBuilder;
+import com.steadybit.shaded.net.bytebuddy.asm.Advice;
+import com.steadybit.shaded.net.bytebuddy.description.method.MethodDescription;
+import com.steadybit.shaded.net.bytebuddy.matcher.ElementMatcher;
+import org.json.JSONArray;
+import org.json.JSONObject;
+
+import java.lang.instrument.Instrumentation;
+import java.util.List;
+import java.util.stream.Collectors;
+
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.hasSuperType;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.isAbstract;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.isSynthetic;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.nameStartsWith;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.named;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.not;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.takesArguments;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.takesNoArguments;
+
+/**
+ * Base for the attacks on the plain Java http clients (JDK HttpClient, OkHttp and Apache HttpClient 5).
+ * <p>
+ * Only the synchronous calls are attacked: {@code HttpClient.send}, {@code Call.execute} and
+ * {@code CloseableHttpClient.doExecute}, which all {@code execute} variants of the Apache client delegate to.
+ */
+public abstract class AbstractJavaHttpClientInstrumentation extends ClassTransformationPlugin {
+    private static final Logger log = RemoteAgentLogger.getLogger(AbstractJavaHttpClientInstrumentation.class);
+    private static final JSONArray EMPTY_ARRAY = new JSONArray();
+    private final ElementMatcher<MethodDescription> sendMethod = named("send").and(takesArguments(2)).and(not(isAbstract()));
+    private final ElementMatcher<MethodDescription> executeMethod = named("execute").and(takesNoArguments()).and(not(isAbstract()));
+    private final ElementMatcher<MethodDescription> doExecuteMethod = named("doExecute").and(takesArguments(3)).and(not(isAbstract()));
+    private final HttpMatcher matcher;
+
+    protected AbstractJavaHttpClientInstrumentation(Instrumentation instrumentation, JSONObject config) {
+        super(instrumentation);
+        List<String> httpMethods = config.optJSONArray("httpMethods", EMPTY_ARRAY).toList().stream().map(Object::toString).collect(Collectors.toList());
+        this.matcher = new HttpMatcher(httpMethods, config.optString("hostAddress", "*"), config.optString("urlPath", "/**"));
+    }
+
+    @Override
+    protected AgentBuilder doInstall(AgentBuilder agentBuilder) {
+        String advice = this.getAdviceClass().getName();
+        return agentBuilder
+                // the JDK HttpClient is loaded by the platform classloader, which is ignored by default
+                .ignore(isSynthetic().or(nameStartsWith("com.steadybit.shaded.")))
+                // the facade handed out by HttpClient.newBuilder() delegates to the implementation, only attack it once
+                .type(hasSuperType(named(JavaHttpClientRequest.JDK_HTTP_CLIENT)).and(not(isAbstract())).and(not(named(JavaHttpClientRequest.JDK_HTTP_CLIENT_IMPL))))
+                .assureReadEdgeTo(this.getInstrumentation(), InstrumentationPluginDispatcher.class)
+                .transform(new AgentBuilder.Transformer.ForAdvice(Advice.withCustomMapping() //
+                        .bind(Registration.class, this.getRegistration())) //
+                        .include(this.getAdviceClass().getClassLoader()) //
+                        .advice(this.sendMethod, advice))
+                .type(hasSuperType(named(JavaHttpClientRequest.OKHTTP_CALL)).and(not(isAbstract())))
+                .transform(new AgentBuilder.Transformer.ForAdvice(Advice.withCustomMapping() //
+                        .bind(Registration.class, this.getRegistration())) //
+                        .include(this.getAdviceClass().getClassLoader()) //
+                        .advice(this.executeMethod, advice))
+                .type(hasSuperType(named(JavaHttpClientRequest.APACHE_HTTP_CLIENT)).and(not(isAbstract())))
+                .transform(new AgentBuilder.Transformer.ForAdvice(Advice.withCustomMapping() //
+                        .bind(Registration.class, this.getRegistration())) //
+                        .include(this.getAdviceClass().getClassLoader()) //
+                        .advice(this.doExecuteMethod, advice));
+    }
+
+    protected abstract Class<?> getAdviceClass();
+
+    protected abstract int getCode();
+
+    /**
+     * @return {@code null} to proceed with the call, a response to return instead or a {@link Throwable} to throw.
+     */
+    protected abstract Object attack(JavaHttpClientRequest request) throws ReflectiveOperationException;
+
+    @Override
+    public Object exec(int code, Object owner, Object method, Object arguments) {
+        if (code != this.getCode()) {
+            return null;
+        }
+
+        try {
+            JavaHttpClientRequest request = JavaHttpClientRequest.of(owner, (String) method, (Object[]) arguments);
+            if (request == null || !this.matcher.test(request.getMethod(), request.getUri())) {
+                return null;
+            }
+            return this.attack(request);
+        } catch (ReflectiveOperationException | RuntimeException e) {
+            log.debug("Could not attack http client call on " + owner.getClass().getName() + ": " + e.getMessage());
+            return null;
+        }
+    }
+}
@@ -4,9 +4,12 @@
 
 package com.steadybit.attacks.javaagent.instrumentation;
 
+import com.steadybit.attacks.javaagent.advice.JerseyRequestAdvice;
+import com.steadybit.javaagent.instrumentation.Registration;
 import com.steadybit.javaagent.log.Logger;
 import com.steadybit.javaagent.log.RemoteAgentLogger;
 import com.steadybit.shaded.net.bytebuddy.agent.builder.AgentBuilder;
+import com.steadybit.shaded.net.bytebuddy.asm.Advice;
 import com.steadybit.shaded.net.bytebuddy.description.method.MethodDescription;
 import com.steadybit.shaded.net.bytebuddy.description.type.TypeDescription;
 import com.steadybit.shaded.net.bytebuddy.matcher.ElementMatcher;
@@ -22,42 +25,80 @@ import java.util.concurrent.atomic.AtomicBoolean;
 import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.isDeclaredBy;
 import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.named;
 import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.none;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.takesArguments;
 
-public abstract class AbstractJavaMethodInstrumentation extends ClassTransformation {
+public abstract class AbstractJavaMethodInstrumentation extends ClassTransformationPlugin {
 
     private static final Logger log = RemoteAgentLogger.getLogger(AbstractJavaMethodInstrumentation.class);
+    //binds the processed request for the header condition, as Jersey has no static holder for it
+    private static final String JERSEY_SERVER_RUNTIME = "org.glassfish.jersey.server.ServerRuntime";
 
     private ElementMatcher.Junction<? super TypeDescription> typeMatcher;
     private ElementMatcher.Junction<? super MethodDescription> methodMatcher;
     private final AtomicBoolean typeMatched = new AtomicBoolean(false);
     private final AtomicBoolean methodMatched = new AtomicBoolean(false);
+    private final ArgumentMatcher argumentMatcher;
+    private final RequestHeaderMatcher requestHeaderMatcher;
+    private final RouterFunctionMatcher routerFunctionMatcher;
 
     protected AbstractJavaMethodInstrumentation(Instrumentation instrumentation, JSONObject config) {
         super(instrumentation);
         this.initializeMatchers(config);
+        this.argumentMatcher = ArgumentMatcher.fromConfig(config);
+        this.requestHeaderMatcher = RequestHeaderMatcher.fromConfig(config);
+        this.routerFunctionMatcher = RouterFunctionMatcher.fromConfig(config);
     }
 
     @Override
     protected AgentBuilder doInstall(AgentBuilder agentBuilder) {
-        return this.doInstall(agentBuilder, typeDefinitions -> {
-            boolean matches = this.typeMatcher.matches(typeDefinitions);
+        AgentBuilder builder = this.doInstall(agentBuilder, this.trackTypeMatches(this.typeMatcher), this.trackMethodMatches(this.methodMatcher));
+        if (this.requestHeaderMatcher != null) {
+            builder = builder.type(named(JERSEY_SERVER_RUNTIME)) //
+                    .transform(new AgentBuilder.Transformer.ForAdvice(Advice.withCustomMapping() //
+                            .bind(Registration.class, this.getRegistration())) //
+                            .include(JerseyRequestAdvice.class.getClassLoader()) //
+                            .advice(named("process").and(takesArguments(1)), JerseyRequestAdvice.class.getName()));
+        }
+        if (this.routerFunctionMatcher != null) {
+            builder = this.doInstallRouterFunction(builder, this.trackTypeMatches(named(RouterFunctionMatcher.HANDLER_FUNCTION_ADAPTER)),
+                    this.trackMethodMatches(named("handle").and(takesArguments(2)).and(isDeclaredBy(named(RouterFunctionMatcher.HANDLER_FUNCTION_ADAPTER)))));
+        }
+        return builder;
+    }
+
+    private ElementMatcher<? super TypeDescription> trackTypeMatches(ElementMatcher<? super TypeDescription> matcher) {
+        return typeDefinitions -> {
+            boolean matches = matcher.matches(typeDefinitions);
             if (matches) {
                 log.debug("Matched type: " + typeDefinitions);
                 this.typeMatched.set(true);
             }
             return matches;
-        }, methodDescription -> {
-            boolean matches = this.methodMatcher.matches(methodDescription);
+        };
+    }
+
+    private ElementMatcher<? super MethodDescription> trackMethodMatches(ElementMatcher<? super MethodDescription> matcher) {
+        return methodDescription -> {
+            boolean matches = matcher.matches(methodDescription);
             if (matches) {
                 log.debug("Matched method: " + methodDescription);
                 this.methodMatched.set(true);
             }
             return matches;
-        });
+        };
     }
 
     protected abstract AgentBuilder doInstall(AgentBuilder agentBuilder, ElementMatcher<? super TypeDescription> typeMatcher, ElementMatcher<? super MethodDescription> methodMatcher);
 
+    /**
+     * Installs the advice for the WebFlux router functions configured in the attack. The advice has to match the exchange
+     * using {@code exec(6, exchange)}. Attacks not supporting router functions ignore them.
+     */
+    protected AgentBuilder doInstallRouterFunction(AgentBuilder agentBuilder, ElementMatcher<? super TypeDescription> typeMatcher,
+                                                   ElementMatcher<? super MethodDescription> methodMatcher) {
+        return agentBuilder;
+    }
+
     private void initializeMatchers(JSONObject config) {
         this.typeMatcher = none();
         this.methodMatcher = none();
@@ -86,6 +127,21 @@ public abstract class AbstractJavaMethodInstrumentation extends ClassTransformat
         }
     }
 
+    @Override
+    public Object exec(int code, Object arg1) {
+        if (code == 3) {
+            return (this.argumentMatcher == null || this.argumentMatcher.test((Object[]) arg1))
+                    && (this.requestHeaderMatcher == null || this.requestHeaderMatcher.test());
+        }
+        if (code == 6) {
+            return this.routerFunctionMatcher != null && this.routerFunctionMatcher.test(arg1, this.requestHeaderMatcher);
+        }
+        if (code == 8) {
+            this.requestHeaderMatcher.bind(arg1);
+        }
+        return null;
+    }
+
     @Override
     protected AdviceApplied getAdviceApplied() {
         if (this.typeMatched.get() && this.methodMatched.get()) {
@@ -0,0 +1,131 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.instrumentation;
+
+import com.steadybit.javaagent.instrumentation.Registration;
+import com.steadybit.javaagent.util.WeakConcurrentMap;
+import com.steadybit.shaded.net.bytebuddy.agent.builder.AgentBuilder;
+import com.steadybit.shaded.net.bytebuddy.asm.Advice;
+import com.steadybit.shaded.net.bytebuddy.description.method.MethodDescription;
+import com.steadybit.shaded.net.bytebuddy.matcher.ElementMatcher;
+import org.json.JSONObject;
+
+import java.lang.instrument.Instrumentation;
+import java.sql.Connection;
+import java.sql.SQLException;
+import java.sql.Statement;
+import java.util.Locale;
+
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.hasSuperType;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.isAbstract;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.isInterface;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.isPublic;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.named;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.namedOneOf;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.not;
+
+/**
+ * Base for attacks on the execution of {@link Statement}s, independent of the framework issuing them.
+ * <p>
+ * Connection pools wrap the statements of the driver, so a single execution passes several instrumented statements.
+ * Only the outermost one is attacked: it is marked by code 8 and unmarked by code 9 when the execution completes.
+ */
+public abstract class AbstractJdbcStatementInstrumentation extends ClassTransformationPlugin {
+    private static final String[] READ_METHODS = { "executeQuery" };
+    private static final String[] WRITE_METHODS = { "executeUpdate", "executeLargeUpdate", "executeBatch", "executeLargeBatch" };
+    private final String jdbcUrl;
+    private final String operations;
+    private final WeakConcurrentMap<Connection, String> urls = new WeakConcurrentMap.WithInlinedExpunction<>();
+    private final ThreadLocal<Boolean> executing = new ThreadLocal<>();
+
+    protected AbstractJdbcStatementInstrumentation(Instrumentation instrumentation, JSONObject config) {
+        super(instrumentation);
+        this.jdbcUrl = config.optString("jdbc-url", "*");
+        this.operations = config.optString("operations", "*");
+    }
+
+    @Override
+    protected AgentBuilder doInstall(AgentBuilder agentBuilder) {
+        ElementMatcher.Junction<MethodDescription> methods = isPublic().and(not(isAbstract()))
+                .and(namedOneOf(READ_METHODS).or(namedOneOf(WRITE_METHODS)).or(named("execute")));
+        return agentBuilder.type(hasSuperType(named("java.sql.Statement")).and(not(isInterface()))) //
+                .transform(new AgentBuilder.Transformer.ForAdvice(Advice.withCustomMapping() //
+                        .bind(Registration.class, this.getRegistration())) //
+                        .include(this.getAdvice().getClassLoader()) //
+                        .advice(methods, this.getAdvice().getName()));
+    }
+
+    protected abstract Class<?> getAdvice();
+
+    @Override
+    public Object exec(int code) {
+        if (code == 9) {
+            this.executing.remove();
+        }
+        return null;
+    }
+
+    @Override
+    public Object exec(int code, Object arg1, Object arg2, Object arg3) {
+        if (code == 8) {
+            if (this.executing.get() != null) {
+                return false;
+            }
+            if (this.matchesOperation((String) arg2, (Object[]) arg3) && this.matchesJdbcUrl((Statement) arg1)) {
+                this.executing.set(Boolean.TRUE);
+                return true;
+            }
+            return false;
+        }
+        return null;
+    }
+
+    private boolean matchesOperation(String method, Object[] arguments) {
+        if ("*".equals(this.operations) || this.operations.isEmpty()) {
+            return true;
+        }
+
+        boolean read;
+        if (contains(READ_METHODS, method)) {
+            read = true;
+        } else if (contains(WRITE_METHODS, method)) {
+            read = false;
+        } else if (arguments.length > 0 && arguments[0] instanceof String) {
+            String sql = ((String) arguments[0]).trim().toLowerCase(Locale.ROOT);
+            read = sql.startsWith("select") || sql.startsWith("with");
+        } else {
+            //execute() of a prepared statement, the operation is unknown
+            return false;
+        }
+        return read ? "r".equalsIgnoreCase(this.operations) : "w".equalsIgnoreCase(this.operations);
+    }
+
+    private boolean matchesJdbcUrl(Statement statement) {
+        if ("*".equals(this.jdbcUrl) || this.jdbcUrl.isEmpty()) {
+            return true;
+        }
+        try {
+            Connection connection = statement.getConnection();
+            String url = this.urls.get(connection);
+            if (url == null) {
+                url = connection.getMetaData().getURL();
+                this.urls.put(connection, url);
+            }
+            return JdbcUrls.matches(this.jdbcUrl, url);
+        } catch (SQLException e) {
+            //if we can't obtain the url we skip the attack
+            return false;
+        }
+    }
+
+    private static boolean contains(String[] values, String value) {
+        for (String v : values) {
+            if (v.equals(value)) {
+                return true;
+            }
+        }
+        return false;
+    }
+}
@@ -0,0 +1,86 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.instrumentation;
+
+import com.steadybit.javaagent.instrumentation.Registration;
+import com.steadybit.javaagent.log.Logger;
+import com.steadybit.javaagent.log.RemoteAgentLogger;
+import com.steadybit.shaded.net.bytebuddy.agent.builder.AgentBuilder;
+import com.steadybit.shaded.net.bytebuddy.asm.Advice;
+import com.steadybit.shaded.net.bytebuddy.description.method.MethodDescription;
+import com.steadybit.shaded.net.bytebuddy.matcher.ElementMatcher;
+import org.json.JSONObject;
+
+import java.lang.instrument.Instrumentation;
+
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.declaresMethod;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.named;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.not;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.takesArgument;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.takesArguments;
+
+/**
+ * Base for attacks on the commands sent by the Redis clients, independent of the framework issuing them.
+ * <p>
+ * Lettuce dispatches all commands of a connection through {@code RedisChannelHandler.dispatch}, Jedis sends them
+ * through {@code Connection.sendCommand}. Since Jedis 4 all variants of {@code sendCommand} delegate to the one taking
+ * {@code CommandArguments}, older versions to the one taking the raw arguments, so only that one is attacked.
+ */
+public abstract class AbstractRedisCommandInstrumentation extends ClassTransformationPlugin {
+    private static final Logger log = RemoteAgentLogger.getLogger(AbstractRedisCommandInstrumentation.class);
+    private final ElementMatcher.Junction<MethodDescription> lettuceDispatch = named("dispatch").and(takesArguments(1))
+            .and(takesArgument(0, named(RedisCommands.LETTUCE_COMMAND)));
+    private final ElementMatcher.Junction<MethodDescription> jedisSendArguments = named("sendCommand").and(takesArguments(1))
+            .and(takesArgument(0, named(RedisCommands.JEDIS_COMMAND_ARGUMENTS)));
+    private final ElementMatcher.Junction<MethodDescription> jedisSendRaw = named("sendCommand").and(takesArguments(2))
+            .and(takesArgument(1, byte[][].class));
+    private final String operations;
+
+    protected AbstractRedisCommandInstrumentation(Instrumentation instrumentation, JSONObject config) {
+        super(instrumentation);
+        this.operations = config.optString("operations", "*");
+    }
+
+    @Override
+    protected AgentBuilder doInstall(AgentBuilder agentBuilder) {
+        return agentBuilder.type(named(RedisCommands.LETTUCE_CHANNEL_HANDLER))
+                .transform(this.advice(this.lettuceDispatch))
+                .type(named(RedisCommands.JEDIS_CONNECTION).and(declaresMethod(this.jedisSendArguments)))
+                .transform(this.advice(this.jedisSendArguments))
+                .type(named(RedisCommands.JEDIS_CONNECTION).and(not(declaresMethod(this.jedisSendArguments))))
+                .transform(this.advice(this.jedisSendRaw));
+    }
+
+    private AgentBuilder.Transformer advice(ElementMatcher<? super MethodDescription> method) {
+        return new AgentBuilder.Transformer.ForAdvice(Advice.withCustomMapping() //
+                .bind(Registration.class, this.getRegistration())) //
+                .include(this.getAdvice().getClassLoader()) //
+                .advice(method, this.getAdvice().getName());
+    }
+
+    protected abstract Class<?> getAdvice();
+
+    protected abstract int getCode();
+
+    /**
+     * @return the value handed to the advice for a matching command.
+     */
+    protected abstract Object attack(Object owner, String command);
+
+    @Override
+    public Object exec(int code, Object owner, Object command) {
+        if (code != this.getCode()) {
+            return null;
+        }
+
+        try {
+            String name = RedisCommands.getName(command);
+            return RedisCommands.matches(this.operations, name) ? this.attack(owner, name) : null;
+        } catch (ReflectiveOperationException | RuntimeException e) {
+            log.debug("Could not attack redis command on " + owner.getClass().getName() + ": " + e.getMessage());
+            return null;
+        }
+    }
+}
@@ -0,0 +1,100 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.instrumentation;
+
+import org.json.JSONObject;
+
+import java.lang.reflect.Field;
+import java.lang.reflect.Method;
+import java.util.Map;
+import java.util.function.Predicate;
+import java.util.regex.Pattern;
+
+/**
+ * Matches the arguments of an intercepted method call against a configured condition.
+ * <p>
+ * The argument at the given index is optionally navigated using a property path (e.g. {@code tenant.id}), where
+ * each segment is resolved using a getter, a no-arg method, a field or a map key. The resulting value is compared
+ * using its string representation.
+ */
+public class ArgumentMatcher {
+    private final int index;
+    private final String[] propertyPath;
+    private final Predicate<String> condition;
+
+    public ArgumentMatcher(int index, String propertyPath, String condition, String value) {
+        this.index = index;
+        this.propertyPath = propertyPath == null || propertyPath.isEmpty() ? new String[0] : propertyPath.split("\\.");
+        this.condition = parseCondition(condition, value);
+    }
+
+    /**
+     * @return the matcher for the attack config or {@code null} if no argument matching is configured.
+     */
+    public static ArgumentMatcher fromConfig(JSONObject config) {
+        JSONObject matcher = config.optJSONObject("argumentMatcher");
+        if (matcher == null) {
+            return null;
+        }
+        return new ArgumentMatcher(matcher.optInt("index", 0), matcher.optString("propertyPath", ""), matcher.optString("condition", "EQUALS"),
+                matcher.optString("value", ""));
+    }
+
+    private static Predicate<String> parseCondition(String condition, String value) {
+        if ("REGEX".equalsIgnoreCase(condition)) {
+            Pattern pattern = Pattern.compile(value);
+            return s -> pattern.matcher(s).matches();
+        }
+        return value::equals;
+    }
+
+    public boolean test(Object[] arguments) {
+        if (arguments == null || this.index < 0 || this.index >= arguments.length) {
+            return false;
+        }
+
+        Object value = arguments[this.index];
+        for (String property : this.propertyPath) {
+            if (value == null) {
+                break;
+            }
+            value = readProperty(value, property);
+        }
+        return this.condition.test(String.valueOf(value));
+    }
+
+    private static Object readProperty(Object target, String property) {
+        if (target instanceof Map) {
+            return ((Map<?, ?>) target).get(property);
+        }
+
+        Class<?> clazz = target.getClass();
+        String capitalized = Character.toUpperCase(property.charAt(0)) + property.substring(1);
+        for (String methodName : new String[]{"get" + capitalized, "is" + capitalized, property}) {
+            try {
+                Method method = clazz.getMethod(methodName);
+                method.setAccessible(true);
+                return method.invoke(target);
+            } catch (NoSuchMethodException e) {
+                //try next candidate
+            } catch (Exception e) {
+                return null;
+            }
+        }
+
+        for (Class<?> c = clazz; c != null; c = c.getSuperclass()) {
+            try {
+                Field field = c.getDeclaredField(property);
+                field.setAccessible(true);
+                return field.get(target);
+            } catch (NoSuchFieldException e) {
+                //try superclass
+            } catch (Exception e) {
+                return null;
+            }
+        }
+        return null;
+    }
+}
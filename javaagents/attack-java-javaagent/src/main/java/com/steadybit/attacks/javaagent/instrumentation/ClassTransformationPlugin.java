@@ -30,7 +30,7 @@ public abstract class ClassTransformationPlugin extends InstrumentationPlugin im
     public AdviceApplied install() {
         InstrumentationPluginDispatcher.register(this);
         this.transformer = this.doInstall(this.createAgentBuilder()).installOn(this.instrumentation);
-        return AdviceApplied.UNKNOWN;
+        return this.getAdviceApplied();
     }
 
     protected AgentBuilder createAgentBuilder() {
@@ -41,6 +41,10 @@ public abstract class ClassTransformationPlugin extends InstrumentationPlugin im
 
     protected abstract AgentBuilder doInstall(AgentBuilder agentBuilder);
 
+    protected Instrumentation getInstrumentation() {
+        return this.instrumentation;
+    }
+
     @Override
     public void reset() {
         InstrumentationPluginDispatcher.deregister(this);
@@ -57,4 +61,8 @@ public abstract class ClassTransformationPlugin extends InstrumentationPlugin im
             throw new RuntimeException(e);
         }
     }
+
+    protected AdviceApplied getAdviceApplied() {
+        return AdviceApplied.UNKNOWN;
+    }
 }
@@ -0,0 +1,89 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.instrumentation;
+
+import org.json.JSONObject;
+
+import java.util.concurrent.ThreadLocalRandom;
+
+/**
+ * Determines the delay for a single invocation of the delay attacks.
+ * <p>
+ * Without a {@code delayDistribution} in the attack config the configured delay is used, optionally with a random
+ * +/-30% jitter. The jitter is not applied to the other distributions as they are random by definition, except for
+ * the ramp, which increases (or decreases) the delay linearly over the attack duration.
+ */
+public class DelayDistribution {
+    // z-score of the 99th percentile of the standard normal distribution
+    private static final double Z_99 = 2.326348;
+    private final String type;
+    private final long delay;
+    private final boolean jitter;
+    private final double a;
+    private final double b;
+    private final long duration;
+    private final long startNanos;
+
+    private DelayDistribution(String type, long delay, boolean jitter, double a, double b, long duration) {
+        this.type = type;
+        this.delay = delay;
+        this.jitter = jitter;
+        this.a = a;
+        this.b = b;
+        this.duration = duration;
+        this.startNanos = System.nanoTime();
+    }
+
+    public static DelayDistribution fromConfig(JSONObject config) {
+        long delay = config.optLong("delay", 500L);
+        boolean jitter = config.optBoolean("delayJitter", false);
+        long duration = config.optLong("duration", 0L);
+        JSONObject distribution = config.optJSONObject("delayDistribution");
+        if (distribution == null) {
+            return new DelayDistribution("FIXED", delay, jitter, 0, 0, duration);
+        }
+
+        String type = distribution.optString("type", "FIXED");
+        switch (type) {
+        case "UNIFORM":
+            return new DelayDistribution(type, delay, false, distribution.optLong("min", delay), distribution.optLong("max", delay), duration);
+        case "NORMAL":
+            return new DelayDistribution(type, delay, false, distribution.optLong("mean", delay), distribution.optLong("stddev", 0L), duration);
+        case "LONG_TAIL":
+            long p50 = Math.max(1L, distribution.optLong("p50", delay));
+            long p99 = Math.max(p50, distribution.optLong("p99", p50));
+            return new DelayDistribution(type, delay, false, Math.log(p50), Math.log((double) p99 / p50) / Z_99, duration);
+        case "RAMP":
+            return new DelayDistribution(type, delay, false, distribution.optLong("from", delay), distribution.optLong("to", delay), duration);
+        default:
+            return new DelayDistribution("FIXED", delay, jitter, 0, 0, duration);
+        }
+    }
+
+    /**
+     * @return the delay in milliseconds for the next invocation.
+     */
+    public long next() {
+        ThreadLocalRandom random = ThreadLocalRandom.current();
+        switch (this.type) {
+        case "UNIFORM":
+            return this.b > this.a ? random.nextLong((long) this.a, (long) this.b + 1) : (long) this.a;
+        case "NORMAL":
+            return Math.max(0L, Math.round(this.a + random.nextGaussian() * this.b));
+        case "LONG_TAIL":
+            // log-normal distribution with the given median and 99th percentile
+            return Math.round(Math.exp(this.a + random.nextGaussian() * this.b));
+        case "RAMP":
+            double progress = this.duration > 0 ? Math.min(1d, (System.nanoTime() - this.startNanos) / 1_000_000d / this.duration) : 1d;
+            return Math.round(this.a + (this.b - this.a) * progress);
+        default:
+            if (this.jitter) {
+                double jitterValue = 1.3d - random.nextDouble(0.6d);
+                return Math.round(jitterValue * this.delay);
+            }
+            return this.delay;
+        }
+    }
+}
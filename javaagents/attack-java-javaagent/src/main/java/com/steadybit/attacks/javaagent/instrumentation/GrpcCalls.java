@@ -0,0 +1,68 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.instrumentation;
+
+import java.lang.reflect.Field;
+
+/**
+ * Reflective access to the calls of grpc-java owned by the application.
+ */
+final class GrpcCalls {
+    static final String CLIENT_CALL = "io.grpc.internal.ClientCallImpl";
+    static final String[] SERVER_CALL_HANDLERS = { "io.grpc.stub.ServerCalls$UnaryServerCallHandler", "io.grpc.stub.ServerCalls$StreamingServerCallHandler" };
+    private static final String SERVER_CALL = "io.grpc.ServerCall";
+    private static final String METHOD_DESCRIPTOR = "io.grpc.MethodDescriptor";
+
+    private GrpcCalls() {
+        //util
+    }
+
+    /**
+     * @return the full method name, e.g. {@code com.example.OrderService/PlaceOrder}, of a {@code ClientCallImpl} or
+     * a {@code ServerCall}.
+     */
+    static String getFullMethodName(Object call) throws ReflectiveOperationException {
+        Object methodDescriptor;
+        if (call.getClass().getName().equals(CLIENT_CALL)) {
+            Field field = call.getClass().getDeclaredField("method");
+            field.setAccessible(true);
+            methodDescriptor = field.get(call);
+        } else {
+            methodDescriptor = loadClass(call, SERVER_CALL).getMethod("getMethodDescriptor").invoke(call);
+        }
+        return (String) loadClass(methodDescriptor, METHOD_DESCRIPTOR).getMethod("getFullMethodName").invoke(methodDescriptor);
+    }
+
+    static boolean matches(String service, String method, String fullMethodName) {
+        int separator = fullMethodName.lastIndexOf('/');
+        String actualService = separator >= 0 ? fullMethodName.substring(0, separator) : fullMethodName;
+        String actualMethod = fullMethodName.substring(separator + 1);
+        return matches(service, actualService) && matches(method, actualMethod);
+    }
+
+    private static boolean matches(String expected, String actual) {
+        return expected == null || expected.isEmpty() || "*".equals(expected) || expected.equals(actual);
+    }
+
+    /**
+     * Creates the {@code StatusRuntimeException} for the given status code, gRPC reports it as the status of the call.
+     */
+    static RuntimeException createException(Object call, String code, String description) {
+        try {
+            Class<?> statusClass = loadClass(call, "io.grpc.Status");
+            Class<?> codeClass = loadClass(call, "io.grpc.Status$Code");
+            Object status = statusClass.getMethod("fromCode", codeClass).invoke(null, codeClass.getMethod("valueOf", String.class).invoke(null, code));
+            status = statusClass.getMethod("withDescription", String.class).invoke(status, description);
+            return (RuntimeException) statusClass.getMethod("asRuntimeException").invoke(status);
+        } catch (ReflectiveOperationException | RuntimeException e) {
+            return new IllegalStateException(code + ": " + description);
+        }
+    }
+
+    private static Class<?> loadClass(Object target, String type) throws ClassNotFoundException {
+        ClassLoader classLoader = target.getClass().getClassLoader();
+        return Class.forName(type, false, classLoader != null ? classLoader : ClassLoader.getSystemClassLoader());
+    }
+}
@@ -0,0 +1,40 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.instrumentation;
+
+import com.steadybit.attacks.javaagent.advice.GrpcStatusAdvice;
+import org.json.JSONObject;
+
+import java.lang.instrument.Instrumentation;
+import java.util.concurrent.ThreadLocalRandom;
+
+public class GrpcClientStatusInstrumentation extends AbstractGrpcInstrumentation {
+    private final String status;
+    private final int errorRate;
+
+    public GrpcClientStatusInstrumentation(Instrumentation instrumentation, JSONObject config) {
+        super(instrumentation, config, false);
+        this.status = config.optString("status", "UNAVAILABLE");
+        this.errorRate = config.optInt("erroneousCallRate", 100);
+    }
+
+    @Override
+    protected Class<?> getAdvice() {
+        return GrpcStatusAdvice.class;
+    }
+
+    @Override
+    protected int getCode() {
+        return 5;
+    }
+
+    @Override
+    protected Object attack(Object call, String fullMethodName) {
+        if (this.errorRate >= 100 || ThreadLocalRandom.current().nextInt(100) < this.errorRate) {
+            return GrpcCalls.createException(call, this.status, "Status injected by steadybit on " + fullMethodName);
+        }
+        return null;
+    }
+}
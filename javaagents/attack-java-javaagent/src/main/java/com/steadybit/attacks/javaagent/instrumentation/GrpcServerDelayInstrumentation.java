@@ -0,0 +1,34 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.instrumentation;
+
+import com.steadybit.attacks.javaagent.advice.GrpcDelayAdvice;
+import org.json.JSONObject;
+
+import java.lang.instrument.Instrumentation;
+
+public class GrpcServerDelayInstrumentation extends AbstractGrpcInstrumentation {
+    private final DelayDistribution delay;
+
+    public GrpcServerDelayInstrumentation(Instrumentation instrumentation, JSONObject config) {
+        super(instrumentation, config, true);
+        this.delay = DelayDistribution.fromConfig(config);
+    }
+
+    @Override
+    protected Class<?> getAdvice() {
+        return GrpcDelayAdvice.class;
+    }
+
+    @Override
+    protected int getCode() {
+        return 2;
+    }
+
+    @Override
+    protected Object attack(Object call, String fullMethodName) {
+        return this.delay.next();
+    }
+}
@@ -4,6 +4,9 @@
 
 package com.steadybit.attacks.javaagent.instrumentation;
 
+import java.util.List;
+import java.util.concurrent.ThreadLocalRandom;
+
 /**
  * Modelled after https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_next_upstream
  */
@@ -20,4 +23,48 @@ public class HttpClientFailureCause {
     public static final String HTTP_404 = "HTTP_404";
     public static final String HTTP_429 = "HTTP_429";
     public static final String HTTP_4XX = "HTTP_4XX";
+
+    private static final int[] HTTP_4XX_CODES = {400, 401, 402, 403, 404, 405, 406, 407, 408, 409, 410, 411, 412, 413, 414, 415, 416, 417, 421, 422, 423, 424, 425, 426, 428, 429, 431, 451};
+    private static final int[] HTTP_5XX_CODES = {500, 501, 502, 503, 504, 505, 506, 507, 508, 509, 510, 511};
+
+    /**
+     * Picks one of the failure causes randomly and returns the status code to simulate. -1 stands for a timeout, -2 for
+     * a connection/protocol error.
+     */
+    static Integer determineStatusCode(List<String> failureCauses) {
+        if (failureCauses.isEmpty()) {
+            return 500;
+        }
+
+        ThreadLocalRandom threadLocalRandom = ThreadLocalRandom.current();
+        String failureCause = failureCauses.get(threadLocalRandom.nextInt(failureCauses.size()));
+
+        if (ERROR.equals(failureCause)) {
+            return -2;
+        } else if (TIMEOUT.equals(failureCause)) {
+            return -1;
+        } else if (HTTP_4XX.equals(failureCause)) {
+            return HTTP_4XX_CODES[threadLocalRandom.nextInt(0, HTTP_4XX_CODES.length)];
+        } else if (HTTP_5XX.equals(failureCause)) {
+            return HTTP_5XX_CODES[threadLocalRandom.nextInt(0, HTTP_5XX_CODES.length)];
+        } else if (HTTP_400.equals(failureCause)) {
+            return 400;
+        } else if (HTTP_403.equals(failureCause)) {
+            return 403;
+        } else if (HTTP_404.equals(failureCause)) {
+            return 404;
+        } else if (HTTP_429.equals(failureCause)) {
+            return 429;
+        } else if (HTTP_500.equals(failureCause)) {
+            return 500;
+        } else if (HTTP_502.equals(failureCause)) {
+            return 502;
+        } else if (HTTP_503.equals(failureCause)) {
+            return 503;
+        } else if (HTTP_504.equals(failureCause)) {
+            return 504;
+        }
+
+        return null;
+    }
 }
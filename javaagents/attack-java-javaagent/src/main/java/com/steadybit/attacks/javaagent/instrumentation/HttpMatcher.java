@@ -10,6 +10,7 @@ import java.lang.invoke.MethodType;
 import java.net.URI;
 import java.util.List;
 import java.util.function.Predicate;
+import java.util.regex.Pattern;
 
 public class HttpMatcher {
     private final List<String> httpMethods;
@@ -36,9 +37,15 @@ public class HttpMatcher {
         // PathPattern pattern = parser.parse(urlPath);
         // return url -> return pattern.matches(PathContainer.parsePath(url));
         // ```
+        // Applications without Spring (e.g. using the JDK HttpClient, OkHttp or Apache HttpClient directly) fall back
+        // to a simple Ant-style matcher.
+        ClassLoader classLoader = Thread.currentThread().getContextClassLoader();
+        if (classLoader == null || !ClassInjectionHelper.hasClass(classLoader, "org.springframework.web.util.pattern.PathPatternParser")) {
+            return parseAntPattern(urlPath);
+        }
+
         try {
             MethodHandles.Lookup lu = MethodHandles.publicLookup();
-            ClassLoader classLoader = Thread.currentThread().getContextClassLoader();
 
             Class<?> parserClazz = classLoader.loadClass("org.springframework.web.util.pattern.PathPatternParser");
             Object parser = lu.findConstructor(parserClazz, MethodType.methodType(void.class)).invoke();
@@ -67,6 +74,33 @@ public class HttpMatcher {
         }
     }
 
+    static Predicate<String> parseAntPattern(String urlPath) {
+        String pattern = urlPath;
+        String suffix = "";
+        if (pattern.endsWith("/**")) {
+            // like Spring, "/api/**" matches "/api" as well
+            pattern = pattern.substring(0, pattern.length() - 3);
+            suffix = "(/.*)?";
+        }
+
+        StringBuilder regex = new StringBuilder();
+        for (int i = 0; i < pattern.length(); i++) {
+            char c = pattern.charAt(i);
+            if (c == '*' && i + 1 < pattern.length() && pattern.charAt(i + 1) == '*') {
+                regex.append(".*");
+                i++;
+            } else if (c == '*') {
+                regex.append("[^/]*");
+            } else if (c == '?') {
+                regex.append("[^/]");
+            } else {
+                regex.append(Pattern.quote(String.valueOf(c)));
+            }
+        }
+        Pattern compiled = Pattern.compile(regex + suffix, Pattern.CASE_INSENSITIVE);
+        return path -> path != null && compiled.matcher(path).matches();
+    }
+
     public boolean test(String httpMethod, URI uri) {
         int port = uri.getPort();
         if (port == -1) {
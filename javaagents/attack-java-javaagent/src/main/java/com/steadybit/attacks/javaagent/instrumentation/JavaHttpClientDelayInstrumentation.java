@@ -0,0 +1,52 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.instrumentation;
+
+import com.steadybit.attacks.javaagent.advice.JavaHttpClientDelayAdvice;
+import org.json.JSONObject;
+
+import java.lang.instrument.Instrumentation;
+
+/**
+ * Delays calls of the JDK HttpClient, OkHttp and Apache HttpClient 5. If the delay exceeds the configured response
+ * timeout of the request the call fails with a timeout after waiting for it, as it would in the real world.
+ */
+public class JavaHttpClientDelayInstrumentation extends AbstractJavaHttpClientInstrumentation {
+    private final DelayDistribution delay;
+
+    public JavaHttpClientDelayInstrumentation(Instrumentation instrumentation, JSONObject config) {
+        super(instrumentation, config);
+        this.delay = DelayDistribution.fromConfig(config);
+    }
+
+    @Override
+    protected Class<?> getAdviceClass() {
+        return JavaHttpClientDelayAdvice.class;
+    }
+
+    @Override
+    protected int getCode() {
+        return 2;
+    }
+
+    @Override
+    protected Object attack(JavaHttpClientRequest request) {
+        long millis = this.delay.next();
+        long readTimeout = request.getReadTimeout();
+
+        try {
+            if (readTimeout == 0 || readTimeout >= millis) {
+                Thread.sleep(millis);
+            } else {
+                Thread.sleep(readTimeout);
+                return request.createTimeoutException("Simulated socket timeout through a scheduled Steadybit experiment.");
+            }
+        } catch (InterruptedException e) {
+            //ignore the interruption and restore interruption flag.
+            Thread.currentThread().interrupt();
+        }
+        return null;
+    }
+}
@@ -0,0 +1,218 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.instrumentation;
+
+import java.io.IOException;
+import java.lang.reflect.Field;
+import java.lang.reflect.Method;
+import java.lang.reflect.Proxy;
+import java.net.SocketTimeoutException;
+import java.net.URI;
+import java.util.Collections;
+import java.util.Map;
+import java.util.Optional;
+import java.util.function.BiPredicate;
+
+/**
+ * A request of one of the plain Java http clients: the JDK HttpClient, OkHttp and Apache HttpClient 5.
+ * <p>
+ * The agent is compiled for Java 8 and the client classes are owned by the application, so everything is accessed
+ * reflectively through the public API types of the respective client.
+ */
+class JavaHttpClientRequest {
+    static final String JDK_HTTP_CLIENT = "java.net.http.HttpClient";
+    static final String JDK_HTTP_CLIENT_IMPL = "jdk.internal.net.http.HttpClientImpl";
+    static final String OKHTTP_CALL = "okhttp3.Call";
+    static final String APACHE_HTTP_CLIENT = "org.apache.hc.client5.http.impl.classic.CloseableHttpClient";
+
+    private enum Client {
+        JDK, OKHTTP, APACHE
+    }
+
+    private final Client client;
+    private final Object owner;
+    private final Object request;
+    private final Object context;
+    private final String method;
+    private final URI uri;
+
+    private JavaHttpClientRequest(Client client, Object owner, Object request, Object context, String method, URI uri) {
+        this.client = client;
+        this.owner = owner;
+        this.request = request;
+        this.context = context;
+        this.method = method;
+        this.uri = uri;
+    }
+
+    /**
+     * @param owner     the instrumented HttpClient, Call or CloseableHttpClient
+     * @param method    the name of the instrumented method
+     * @param arguments the arguments of the instrumented method
+     */
+    static JavaHttpClientRequest of(Object owner, String method, Object[] arguments) throws ReflectiveOperationException {
+        if ("send".equals(method)) {
+            Object request = arguments[0];
+            return new JavaHttpClientRequest(Client.JDK, owner, request, null,
+                    (String) invoke(request, "java.net.http.HttpRequest", "method"),
+                    (URI) invoke(request, "java.net.http.HttpRequest", "uri"));
+        } else if ("execute".equals(method)) {
+            Object request = invoke(owner, OKHTTP_CALL, "request");
+            Object url = invoke(request, "okhttp3.Request", "url");
+            return new JavaHttpClientRequest(Client.OKHTTP, owner, request, null,
+                    (String) invoke(request, "okhttp3.Request", "method"),
+                    (URI) invoke(url, "okhttp3.HttpUrl", "uri"));
+        } else if ("doExecute".equals(method)) {
+            Object target = arguments[0];
+            Object request = arguments[1];
+            URI uri = (URI) invoke(request, "org.apache.hc.core5.http.HttpRequest", "getUri");
+            if (!uri.isAbsolute() && target != null) {
+                uri = URI.create(invoke(target, "org.apache.hc.core5.http.HttpHost", "toURI") + uri.toString());
+            }
+            return new JavaHttpClientRequest(Client.APACHE, owner, request, arguments[2],
+                    (String) invoke(request, "org.apache.hc.core5.http.HttpRequest", "getMethod"), uri);
+        }
+        return null;
+    }
+
+    String getMethod() {
+        return this.method;
+    }
+
+    URI getUri() {
+        return this.uri;
+    }
+
+    /**
+     * @return the configured response timeout in milliseconds, 0 if none is configured or could be read.
+     */
+    long getReadTimeout() {
+        try {
+            if (this.client == Client.JDK) {
+                Optional<?> timeout = (Optional<?>) invoke(this.request, "java.net.http.HttpRequest", "timeout");
+                return timeout.isPresent() ? (Long) invoke(timeout.get(), "java.time.Duration", "toMillis") : 0;
+            } else if (this.client == Client.OKHTTP) {
+                Field clientField = this.owner.getClass().getDeclaredField("client");
+                clientField.setAccessible(true);
+                return ((Number) invoke(clientField.get(this.owner), "okhttp3.OkHttpClient", "readTimeoutMillis")).longValue();
+            } else if (this.client == Client.APACHE && this.context != null) {
+                Object requestConfig = this.context.getClass().getMethod("getRequestConfig").invoke(this.context);
+                Object timeout = requestConfig != null ? invoke(requestConfig, "org.apache.hc.client5.http.config.RequestConfig", "getResponseTimeout") : null;
+                return timeout != null ? ((Number) invoke(timeout, "org.apache.hc.core5.util.TimeValue", "toMilliseconds")).longValue() : 0;
+            }
+        } catch (Exception e) {
+            //ignore
+        }
+        return 0;
+    }
+
+    /**
+     * Creates the response or exception for a simulated status code: -1 stands for a timeout, -2 for a
+     * connection/protocol error.
+     */
+    Object createResponse(int status) throws ReflectiveOperationException {
+        if (status == -1) {
+            return this.createTimeoutException("Simulated socket timeout through a scheduled Steadybit experiment.");
+        } else if (status == -2) {
+            return new IOException("Simulated connection/HTTP protocol error through a scheduled Steadybit experiment.");
+        }
+
+        if (this.client == Client.JDK) {
+            return this.createJdkResponse(status);
+        } else if (this.client == Client.OKHTTP) {
+            return this.createOkHttpResponse(status);
+        } else {
+            return this.createApacheResponse(status);
+        }
+    }
+
+    IOException createTimeoutException(String message) {
+        if (this.client == Client.JDK) {
+            try {
+                return (IOException) loadClass(this.request, "java.net.http.HttpTimeoutException").getConstructor(String.class).newInstance(message);
+            } catch (ReflectiveOperationException e) {
+                //fall back to a plain socket timeout
+            }
+        }
+        return new SocketTimeoutException(message);
+    }
+
+    private Object createJdkResponse(int status) throws ReflectiveOperationException {
+        Class<?> responseClass = loadClass(this.request, "java.net.http.HttpResponse");
+        Class<?> headersClass = loadClass(this.request, "java.net.http.HttpHeaders");
+        Class<?> versionClass = loadClass(this.request, "java.net.http.HttpClient$Version");
+        BiPredicate<String, String> all = (name, value) -> true;
+        Object headers = headersClass.getMethod("of", Map.class, BiPredicate.class).invoke(null, Collections.emptyMap(), all);
+        Object version = versionClass.getMethod("valueOf", String.class).invoke(null, "HTTP_1_1");
+
+        return Proxy.newProxyInstance(responseClass.getClassLoader() != null ? responseClass.getClassLoader() : ClassLoader.getSystemClassLoader(),
+                new Class<?>[]{responseClass}, (proxy, method, args) -> {
+                    switch (method.getName()) {
+                        case "statusCode":
+                            return status;
+                        case "request":
+                            return this.request;
+                        case "previousResponse":
+                        case "sslSession":
+                            return Optional.empty();
+                        case "headers":
+                            return headers;
+                        case "uri":
+                            return this.uri;
+                        case "version":
+                            return version;
+                        case "hashCode":
+                            return System.identityHashCode(proxy);
+                        case "equals":
+                            return proxy == args[0];
+                        case "toString":
+                            return "(" + this.method + " " + this.uri + ") " + status;
+                        default:
+                            return null;
+                    }
+                });
+    }
+
+    private Object createOkHttpResponse(int status) throws ReflectiveOperationException {
+        Class<?> builderClass = loadClass(this.owner, "okhttp3.Response$Builder");
+        Class<?> protocolClass = loadClass(this.owner, "okhttp3.Protocol");
+        Class<?> mediaTypeClass = loadClass(this.owner, "okhttp3.MediaType");
+        Class<?> bodyClass = loadClass(this.owner, "okhttp3.ResponseBody");
+
+        Object body = bodyClass.getMethod("create", mediaTypeClass, String.class).invoke(null, null, "");
+        Object builder = builderClass.getConstructor().newInstance();
+        builderClass.getMethod("request", loadClass(this.owner, "okhttp3.Request")).invoke(builder, this.request);
+        builderClass.getMethod("protocol", protocolClass).invoke(builder, protocolClass.getMethod("valueOf", String.class).invoke(null, "HTTP_1_1"));
+        builderClass.getMethod("code", int.class).invoke(builder, status);
+        builderClass.getMethod("message", String.class).invoke(builder, "Injected by steadybit");
+        builderClass.getMethod("body", bodyClass).invoke(builder, body);
+        return builderClass.getMethod("build").invoke(builder);
+    }
+
+    private Object createApacheResponse(int status) throws ReflectiveOperationException {
+        Class<?> responseClass = loadClass(this.owner, "org.apache.hc.core5.http.message.BasicClassicHttpResponse");
+        Class<?> entityClass = loadClass(this.owner, "org.apache.hc.core5.http.HttpEntity");
+        Class<?> classicResponseClass = loadClass(this.owner, "org.apache.hc.core5.http.ClassicHttpResponse");
+        Class<?> closeableResponseClass = loadClass(this.owner, "org.apache.hc.client5.http.impl.classic.CloseableHttpResponse");
+
+        Object response = responseClass.getConstructor(int.class, String.class).newInstance(status, "Injected by steadybit");
+        Object entity = loadClass(this.owner, "org.apache.hc.core5.http.io.entity.StringEntity").getConstructor(String.class).newInstance("");
+        responseClass.getMethod("setEntity", entityClass).invoke(response, entity);
+
+        Method adapt = closeableResponseClass.getDeclaredMethod("adapt", classicResponseClass);
+        adapt.setAccessible(true);
+        return adapt.invoke(null, response);
+    }
+
+    private static Object invoke(Object target, String type, String method) throws ReflectiveOperationException {
+        // Resolve the method on the public API type, the implementations might not be accessible (e.g. jdk.internal)
+        return loadClass(target, type).getMethod(method).invoke(target);
+    }
+
+    private static Class<?> loadClass(Object target, String type) throws ClassNotFoundException {
+        ClassLoader classLoader = target.getClass().getClassLoader();
+        return Class.forName(type, false, classLoader != null ? classLoader : ClassLoader.getSystemClassLoader());
+    }
+}
@@ -0,0 +1,49 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.instrumentation;
+
+import com.steadybit.attacks.javaagent.advice.JavaHttpClientStatusAdvice;
+import org.json.JSONArray;
+import org.json.JSONObject;
+
+import java.lang.instrument.Instrumentation;
+import java.util.List;
+import java.util.concurrent.ThreadLocalRandom;
+import java.util.stream.Collectors;
+
+/**
+ * Returns a status code or fails calls of the JDK HttpClient, OkHttp and Apache HttpClient 5 without executing them.
+ */
+public class JavaHttpClientStatusInstrumentation extends AbstractJavaHttpClientInstrumentation {
+    private static final JSONArray EMPTY_ARRAY = new JSONArray();
+    private final int errorRate;
+    private final List<String> failureCauses;
+
+    public JavaHttpClientStatusInstrumentation(Instrumentation instrumentation, JSONObject config) {
+        super(instrumentation, config);
+        this.errorRate = config.optInt("erroneousCallRate", 100);
+        this.failureCauses = config.optJSONArray("failureCauses", EMPTY_ARRAY).toList().stream().map(Object::toString).collect(Collectors.toList());
+    }
+
+    @Override
+    protected Class<?> getAdviceClass() {
+        return JavaHttpClientStatusAdvice.class;
+    }
+
+    @Override
+    protected int getCode() {
+        return 1;
+    }
+
+    @Override
+    protected Object attack(JavaHttpClientRequest request) throws ReflectiveOperationException {
+        if (this.errorRate < 100 && ThreadLocalRandom.current().nextInt(100) >= this.errorRate) {
+            return null;
+        }
+
+        Integer status = HttpClientFailureCause.determineStatusCode(this.failureCauses);
+        return status != null ? request.createResponse(status) : null;
+    }
+}
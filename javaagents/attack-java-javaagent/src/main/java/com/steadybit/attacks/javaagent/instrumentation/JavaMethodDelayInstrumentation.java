@@ -4,9 +4,11 @@
 
 package com.steadybit.attacks.javaagent.instrumentation;
 
-import com.steadybit.attacks.javaagent.advice.Delay;
 import com.steadybit.attacks.javaagent.advice.JavaMethodDelayAdvice;
-import com.steadybit.attacks.javaagent.advice.Jitter;
+import com.steadybit.attacks.javaagent.advice.RouterFunctionDelayAdvice;
+import com.steadybit.javaagent.instrumentation.Registration;
+import com.steadybit.javaagent.log.Logger;
+import com.steadybit.javaagent.log.RemoteAgentLogger;
 import com.steadybit.shaded.net.bytebuddy.agent.builder.AgentBuilder;
 import com.steadybit.shaded.net.bytebuddy.asm.Advice;
 import com.steadybit.shaded.net.bytebuddy.description.method.MethodDescription;
@@ -16,14 +18,20 @@ import org.json.JSONObject;
 
 import java.lang.instrument.Instrumentation;
 
+/**
+ * Delays the matched methods. Asynchronous results are delayed without blocking, see {@link ReactiveDelay}. With
+ * {@code skipOnEventLoop} set, as done by the controller delay, other results are not delayed on event-loop threads, as
+ * blocking these would stall all requests handled by them.
+ */
 public class JavaMethodDelayInstrumentation extends AbstractJavaMethodInstrumentation {
-    private final long delay;
-    private final boolean delayJitter;
+    private static final Logger log = RemoteAgentLogger.getLogger(JavaMethodDelayInstrumentation.class);
+    private final DelayDistribution delay;
+    private final boolean skipOnEventLoop;
 
     public JavaMethodDelayInstrumentation(Instrumentation instrumentation, JSONObject config) {
         super(instrumentation, config);
-        this.delay = config.optLong("delay", 500L);
-        this.delayJitter = config.optBoolean("delayJitter", false);
+        this.delay = DelayDistribution.fromConfig(config);
+        this.skipOnEventLoop = config.optBoolean("skipOnEventLoop", false);
     }
 
     @Override
@@ -31,9 +39,54 @@ public class JavaMethodDelayInstrumentation extends AbstractJavaMethodInstrument
                                      ElementMatcher<? super MethodDescription> methodMatcher) {
         return agentBuilder.type(typeMatcher) //
                 .transform(new AgentBuilder.Transformer.ForAdvice(Advice.withCustomMapping() //
-                        .bind(Delay.class, this.delay) //
-                        .bind(Jitter.class, this.delayJitter)) //
+                        .bind(Registration.class, this.getRegistration())) //
                         .include(JavaMethodDelayAdvice.class.getClassLoader()) //
                         .advice(methodMatcher, JavaMethodDelayAdvice.class.getName()));
     }
+
+    @Override
+    protected AgentBuilder doInstallRouterFunction(AgentBuilder agentBuilder, ElementMatcher<? super TypeDescription> typeMatcher,
+                                                   ElementMatcher<? super MethodDescription> methodMatcher) {
+        return agentBuilder.type(typeMatcher) //
+                .transform(new AgentBuilder.Transformer.ForAdvice(Advice.withCustomMapping() //
+                        .bind(Registration.class, this.getRegistration())) //
+                        .include(RouterFunctionDelayAdvice.class.getClassLoader()) //
+                        .advice(methodMatcher, RouterFunctionDelayAdvice.class.getName()));
+    }
+
+    @Override
+    public Object exec(int code) {
+        if (code == 2) {
+            return this.delay.next();
+        }
+        if (code == 7) {
+            return this.skipOnEventLoop && this.isEventLoopThread();
+        }
+        return null;
+    }
+
+    @Override
+    public Object exec(int code, Object arg1) {
+        if (code == 4) {
+            return ReactiveDelay.isReactiveType((Class<?>) arg1);
+        }
+        return super.exec(code, arg1);
+    }
+
+    @Override
+    public Object exec(int code, Object arg1, Object arg2) {
+        if (code == 5) {
+            return ReactiveDelay.delay(arg1, (Long) arg2);
+        }
+        return null;
+    }
+
+    private boolean isEventLoopThread() {
+        Thread thread = Thread.currentThread();
+        if (ReactiveDelay.isEventLoopThread(thread)) {
+            log.debug("Not delaying blocking call on event-loop thread " + thread.getName());
+            return true;
+        }
+        return false;
+    }
 }
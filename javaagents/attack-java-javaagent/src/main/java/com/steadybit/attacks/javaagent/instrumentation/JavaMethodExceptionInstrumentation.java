@@ -6,6 +6,10 @@ package com.steadybit.attacks.javaagent.instrumentation;
 
 import com.steadybit.attacks.javaagent.advice.ErrorRate;
 import com.steadybit.attacks.javaagent.advice.JavaMethodExceptionAdvice;
+import com.steadybit.attacks.javaagent.advice.RouterFunctionExceptionAdvice;
+import com.steadybit.javaagent.instrumentation.Registration;
+import com.steadybit.javaagent.log.Logger;
+import com.steadybit.javaagent.log.RemoteAgentLogger;
 import com.steadybit.shaded.net.bytebuddy.agent.builder.AgentBuilder;
 import com.steadybit.shaded.net.bytebuddy.asm.Advice;
 import com.steadybit.shaded.net.bytebuddy.description.method.MethodDescription;
@@ -16,11 +20,17 @@ import org.json.JSONObject;
 import java.lang.instrument.Instrumentation;
 
 public class JavaMethodExceptionInstrumentation extends AbstractJavaMethodInstrumentation {
+    private static final Logger log = RemoteAgentLogger.getLogger(JavaMethodExceptionInstrumentation.class);
+    private static final String DEFAULT_MESSAGE = "Exception injected by steadybit";
     private final int errorRate;
+    private final String exceptionClass;
+    private final String exceptionMessage;
 
     public JavaMethodExceptionInstrumentation(Instrumentation instrumentation, JSONObject config) {
         super(instrumentation, config);
         this.errorRate = config.optInt("erroneousCallRate", 100);
+        this.exceptionClass = config.optString("exceptionClass", "");
+        this.exceptionMessage = config.optString("exceptionMessage", DEFAULT_MESSAGE);
     }
 
     @Override
@@ -29,8 +39,54 @@ public class JavaMethodExceptionInstrumentation extends AbstractJavaMethodInstru
 
         return agentBuilder.type(typeMatcher) //
                 .transform(new AgentBuilder.Transformer.ForAdvice(Advice.withCustomMapping()//
-                        .bind(ErrorRate.class, this.errorRate)) //
+                        .bind(ErrorRate.class, this.errorRate) //
+                        .bind(Registration.class, this.getRegistration())) //
                         .include(JavaMethodExceptionAdvice.class.getClassLoader()) //
                         .advice(methodMatcher, JavaMethodExceptionAdvice.class.getName()));
     }
+
+    @Override
+    protected AgentBuilder doInstallRouterFunction(AgentBuilder agentBuilder, ElementMatcher<? super TypeDescription> typeMatcher,
+                                                   ElementMatcher<? super MethodDescription> methodMatcher) {
+        return agentBuilder.type(typeMatcher) //
+                .transform(new AgentBuilder.Transformer.ForAdvice(Advice.withCustomMapping()//
+                        .bind(ErrorRate.class, this.errorRate) //
+                        .bind(Registration.class, this.getRegistration())) //
+                        .include(RouterFunctionExceptionAdvice.class.getClassLoader()) //
+                        .advice(methodMatcher, RouterFunctionExceptionAdvice.class.getName()));
+    }
+
+    @Override
+    public Object exec(int code, Object arg1) {
+        if (code == 5) {
+            return this.createException((Class<?>) arg1);
+        }
+        return super.exec(code, arg1);
+    }
+
+    /**
+     * Creates the configured exception using the classloader of the attacked class. If the class can't be
+     * instantiated, a {@link RuntimeException} is returned instead.
+     */
+    Throwable createException(Class<?> type) {
+        if (this.exceptionClass.isEmpty()) {
+            return new RuntimeException(this.exceptionMessage);
+        }
+
+        try {
+            ClassLoader classLoader = type != null && type.getClassLoader() != null ? type.getClassLoader() : Thread.currentThread().getContextClassLoader();
+            Class<?> clazz = Class.forName(this.exceptionClass, true, classLoader);
+            if (!Throwable.class.isAssignableFrom(clazz)) {
+                throw new IllegalArgumentException(this.exceptionClass + " is not a Throwable");
+            }
+            try {
+                return (Throwable) clazz.getConstructor(String.class).newInstance(this.exceptionMessage);
+            } catch (NoSuchMethodException e) {
+                return (Throwable) clazz.getConstructor().newInstance();
+            }
+        } catch (Exception | LinkageError e) {
+            log.debug("Could not instantiate " + this.exceptionClass + ": " + e.getMessage());
+            return new RuntimeException(this.exceptionClass + ": " + this.exceptionMessage, e);
+        }
+    }
 }
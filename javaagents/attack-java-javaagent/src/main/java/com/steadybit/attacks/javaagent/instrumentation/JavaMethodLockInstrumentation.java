@@ -0,0 +1,132 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.instrumentation;
+
+import com.steadybit.attacks.javaagent.advice.JavaMethodLockAdvice;
+import com.steadybit.javaagent.instrumentation.Registration;
+import com.steadybit.shaded.net.bytebuddy.agent.builder.AgentBuilder;
+import com.steadybit.shaded.net.bytebuddy.asm.Advice;
+import com.steadybit.shaded.net.bytebuddy.description.method.MethodDescription;
+import com.steadybit.shaded.net.bytebuddy.description.type.TypeDescription;
+import com.steadybit.shaded.net.bytebuddy.matcher.ElementMatcher;
+import org.json.JSONArray;
+import org.json.JSONObject;
+
+import java.lang.instrument.Instrumentation;
+import java.util.HashSet;
+import java.util.Set;
+import java.util.concurrent.locks.ReentrantLock;
+
+/**
+ * Makes calls of the attacked methods acquire a shared lock and hold it for the configured time.
+ * <p>
+ * In mode <code>CONTENTION</code> all calls contend for a single lock. In mode <code>DEADLOCK</code> the first method
+ * acquires the first and then the second lock, while the second method acquires them in opposite order, so concurrent
+ * calls deadlock. The locks are {@link ReentrantLock}s, which are reported by thread dumps and
+ * {@link java.lang.management.ThreadMXBean#findDeadlockedThreads()}, but in contrast to monitors can be released on
+ * reset by interrupting the waiting threads.
+ */
+public class JavaMethodLockInstrumentation extends AbstractJavaMethodInstrumentation {
+    private final long holdTime;
+    private final String secondMethod;
+    private final SteadybitLock firstLock = new SteadybitLock();
+    private final SteadybitLock secondLock;
+    private final Set<Thread> waiting = new HashSet<>();
+    private volatile boolean released;
+
+    public JavaMethodLockInstrumentation(Instrumentation instrumentation, JSONObject config) {
+        super(instrumentation, config);
+        this.holdTime = config.optLong("holdTime", 1000L);
+        boolean deadlock = "DEADLOCK".equals(config.optString("lockMode"));
+        JSONArray methods = config.optJSONArray("methods");
+        this.secondMethod = deadlock && methods != null && methods.length() > 1 ? methods.getString(1) : null;
+        this.secondLock = this.secondMethod != null ? new SteadybitLock() : null;
+    }
+
+    @Override
+    protected AgentBuilder doInstall(AgentBuilder agentBuilder, ElementMatcher<? super TypeDescription> typeMatcher,
+                                     ElementMatcher<? super MethodDescription> methodMatcher) {
+        return agentBuilder.type(typeMatcher) //
+                .transform(new AgentBuilder.Transformer.ForAdvice(Advice.withCustomMapping() //
+                        .bind(Registration.class, this.getRegistration())) //
+                        .include(JavaMethodLockAdvice.class.getClassLoader()) //
+                        .advice(methodMatcher, JavaMethodLockAdvice.class.getName()));
+    }
+
+    @Override
+    public Object exec(int code, Object arg1, Object arg2) {
+        if (code == 6) {
+            String method = ((Class<?>) arg1).getName() + "#" + arg2;
+            if (method.equals(this.secondMethod)) {
+                this.acquire(this.secondLock, this.firstLock);
+            } else {
+                this.acquire(this.firstLock, this.secondLock);
+            }
+        }
+        return null;
+    }
+
+    @Override
+    public void reset() {
+        super.reset();
+        this.released = true;
+        synchronized (this.waiting) {
+            for (Thread thread : this.waiting) {
+                thread.interrupt();
+            }
+        }
+    }
+
+    private void acquire(ReentrantLock outer, ReentrantLock inner) {
+        if (!this.interruptibly(outer::lockInterruptibly)) {
+            return;
+        }
+        try {
+            if (this.interruptibly(() -> Thread.sleep(this.holdTime)) && inner != null && this.interruptibly(inner::lockInterruptibly)) {
+                inner.unlock();
+            }
+        } finally {
+            outer.unlock();
+        }
+    }
+
+    private boolean interruptibly(Interruptible action) {
+        Thread thread = Thread.currentThread();
+        synchronized (this.waiting) {
+            if (this.released) {
+                return false;
+            }
+            this.waiting.add(thread);
+        }
+        try {
+            action.run();
+            return true;
+        } catch (InterruptedException e) {
+            if (!this.released) {
+                //not interrupted by us, restore interruption flag.
+                thread.interrupt();
+            }
+            return false;
+        } finally {
+            synchronized (this.waiting) {
+                this.waiting.remove(thread);
+            }
+            if (this.released) {
+                //clear the interruption caused by the reset.
+                Thread.interrupted();
+            }
+        }
+    }
+
+    private interface Interruptible {
+        void run() throws InterruptedException;
+    }
+
+    /**
+     * Dedicated type, so the locks held by the attack are easy to spot in thread dumps.
+     */
+    private static class SteadybitLock extends ReentrantLock {
+    }
+}
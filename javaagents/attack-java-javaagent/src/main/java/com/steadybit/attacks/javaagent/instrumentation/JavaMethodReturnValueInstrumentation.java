@@ -0,0 +1,268 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.instrumentation;
+
+import com.steadybit.attacks.javaagent.advice.JavaMethodReturnValueAdvice;
+import com.steadybit.javaagent.instrumentation.Registration;
+import com.steadybit.javaagent.log.Logger;
+import com.steadybit.javaagent.log.RemoteAgentLogger;
+import com.steadybit.shaded.net.bytebuddy.agent.builder.AgentBuilder;
+import com.steadybit.shaded.net.bytebuddy.asm.Advice;
+import com.steadybit.shaded.net.bytebuddy.description.method.MethodDescription;
+import com.steadybit.shaded.net.bytebuddy.description.type.TypeDescription;
+import com.steadybit.shaded.net.bytebuddy.matcher.ElementMatcher;
+import org.json.JSONArray;
+import org.json.JSONObject;
+import org.json.JSONTokener;
+
+import java.lang.instrument.Instrumentation;
+import java.lang.reflect.Array;
+import java.lang.reflect.Method;
+import java.math.BigDecimal;
+import java.math.BigInteger;
+import java.util.ArrayList;
+import java.util.Arrays;
+import java.util.HashMap;
+import java.util.HashSet;
+import java.util.List;
+import java.util.Map;
+import java.util.Optional;
+import java.util.Set;
+import java.util.concurrent.ConcurrentHashMap;
+import java.util.concurrent.ThreadLocalRandom;
+import java.util.stream.Stream;
+
+/**
+ * Replaces the return value of the attacked methods with a configured value.
+ * <p>
+ * The value is parsed once and converted to the declared return type of the intercepted method. Methods with a return
+ * type not able to represent the value are not instrumented; if none of the matched methods is compatible, the attack
+ * fails to install.
+ */
+public class JavaMethodReturnValueInstrumentation extends AbstractJavaMethodInstrumentation {
+    private static final Logger log = RemoteAgentLogger.getLogger(JavaMethodReturnValueInstrumentation.class);
+    private static final List<Class<?>> NUMBER_TYPES = Arrays.asList(int.class, Integer.class, long.class, Long.class, double.class, Double.class,
+            float.class, Float.class, short.class, Short.class, byte.class, Byte.class, BigInteger.class);
+    private final int errorRate;
+    private final String type;
+    private final String value;
+    private final BigDecimal number;
+    private final Object json;
+    private final Map<ClassLoader, Optional<JacksonReader>> jacksonReaders = new ConcurrentHashMap<>();
+    private final Set<String> incompatibleMethods = ConcurrentHashMap.newKeySet();
+    private volatile boolean compatibleMethodMatched;
+
+    public JavaMethodReturnValueInstrumentation(Instrumentation instrumentation, JSONObject config) {
+        super(instrumentation, config);
+        this.errorRate = config.optInt("erroneousCallRate", 100);
+        JSONObject returnValue = config.optJSONObject("returnValue");
+        this.type = returnValue != null ? returnValue.optString("type", "NULL") : "NULL";
+        this.value = returnValue != null ? returnValue.optString("value", "") : "";
+        this.number = this.type.equals("NUMBER") ? new BigDecimal(this.value) : null;
+        this.json = this.type.equals("JSON") ? new JSONTokener(this.value).nextValue() : null;
+    }
+
+    @Override
+    public AdviceApplied install() {
+        AdviceApplied applied = super.install();
+        if (!this.compatibleMethodMatched && !this.incompatibleMethods.isEmpty()) {
+            this.reset();
+            throw new IllegalArgumentException("Return value " + this.type + " is not compatible with the return type of " + this.incompatibleMethods);
+        }
+        return applied;
+    }
+
+    @Override
+    protected AgentBuilder doInstall(AgentBuilder agentBuilder, ElementMatcher<? super TypeDescription> typeMatcher,
+                                     ElementMatcher<? super MethodDescription> methodMatcher) {
+        ElementMatcher<MethodDescription> compatibleMethodMatcher = method -> methodMatcher.matches(method) && this.trackCompatibility(method);
+        return agentBuilder.type(typeMatcher) //
+                .transform(new AgentBuilder.Transformer.ForAdvice(Advice.withCustomMapping()//
+                        .bind(Registration.class, this.getRegistration())) //
+                        .include(JavaMethodReturnValueAdvice.class.getClassLoader()) //
+                        .advice(compatibleMethodMatcher, JavaMethodReturnValueAdvice.class.getName()));
+    }
+
+    private boolean trackCompatibility(MethodDescription method) {
+        if (this.isCompatible(method.getReturnType().asErasure())) {
+            this.compatibleMethodMatched = true;
+            return true;
+        }
+        log.debug("Skipping method with incompatible return type: " + method);
+        this.incompatibleMethods.add(method.toString());
+        return false;
+    }
+
+    boolean isCompatible(TypeDescription returnType) {
+        if (returnType.represents(void.class)) {
+            return false;
+        }
+        switch (this.type) {
+        case "NULL":
+            return !returnType.isPrimitive();
+        case "EMPTY":
+            return returnType.isAssignableFrom(ArrayList.class) || returnType.isAssignableFrom(HashSet.class) || returnType.isAssignableFrom(HashMap.class)
+                    || returnType.represents(Optional.class) || returnType.isAssignableFrom(String.class) || returnType.isArray()
+                    || returnType.represents(Stream.class);
+        case "BOOLEAN":
+            return returnType.represents(boolean.class) || returnType.isAssignableFrom(Boolean.class);
+        case "NUMBER":
+            return NUMBER_TYPES.stream().anyMatch(returnType::represents) || returnType.isAssignableFrom(BigDecimal.class);
+        case "STRING":
+            return returnType.isAssignableFrom(String.class);
+        case "JSON":
+            //Jackson is able to read almost any type, it is only known at runtime if it is available
+            return true;
+        default:
+            return false;
+        }
+    }
+
+    @Override
+    public Object exec(int code, Object arg1) {
+        if (code == 4) {
+            if (this.errorRate < 100 && ThreadLocalRandom.current().nextInt(100) >= this.errorRate) {
+                return null;
+            }
+            try {
+                return this.createValue((Class<?>) arg1);
+            } catch (Exception e) {
+                log.debug("Could not create return value " + this.type + " for " + arg1 + ": " + e.getMessage());
+                return null;
+            }
+        }
+        return super.exec(code, arg1);
+    }
+
+    /**
+     * @return the value wrapped in an array or {@code null} if the value is not compatible with the return type.
+     */
+    Object[] createValue(Class<?> returnType) throws Exception {
+        switch (this.type) {
+        case "NULL":
+            return returnType.isPrimitive() ? null : new Object[]{null};
+        case "EMPTY":
+            return wrap(emptyValue(returnType));
+        case "BOOLEAN":
+            if (returnType == boolean.class || returnType.isAssignableFrom(Boolean.class)) {
+                return new Object[]{Boolean.parseBoolean(this.value)};
+            }
+            return null;
+        case "NUMBER":
+            return wrap(numberValue(returnType, this.number));
+        case "STRING":
+            return returnType.isAssignableFrom(String.class) ? new Object[]{this.value} : null;
+        case "JSON":
+            return wrap(this.jsonValue(returnType));
+        default:
+            return null;
+        }
+    }
+
+    private static Object[] wrap(Object value) {
+        return value != null ? new Object[]{value} : null;
+    }
+
+    private static Object emptyValue(Class<?> returnType) {
+        if (returnType.isAssignableFrom(ArrayList.class)) {
+            return new ArrayList<>();
+        }
+        if (returnType.isAssignableFrom(HashSet.class)) {
+            return new HashSet<>();
+        }
+        if (returnType.isAssignableFrom(HashMap.class)) {
+            return new HashMap<>();
+        }
+        if (returnType == Optional.class) {
+            return Optional.empty();
+        }
+        if (returnType.isAssignableFrom(String.class)) {
+            return "";
+        }
+        if (returnType.isArray()) {
+            return Array.newInstance(returnType.getComponentType(), 0);
+        }
+        if (returnType == Stream.class) {
+            return Stream.empty();
+        }
+        return null;
+    }
+
+    private static Object numberValue(Class<?> returnType, BigDecimal number) {
+        if (returnType == int.class || returnType == Integer.class) {
+            return number.intValue();
+        }
+        if (returnType == long.class || returnType == Long.class) {
+            return number.longValue();
+        }
+        if (returnType == double.class || returnType == Double.class) {
+            return number.doubleValue();
+        }
+        if (returnType == float.class || returnType == Float.class) {
+            return number.floatValue();
+        }
+        if (returnType == short.class || returnType == Short.class) {
+            return number.shortValue();
+        }
+        if (returnType == byte.class || returnType == Byte.class) {
+            return number.byteValue();
+        }
+        if (returnType == BigInteger.class) {
+            return number.toBigInteger();
+        }
+        if (returnType.isAssignableFrom(BigDecimal.class)) {
+            return number;
+        }
+        return null;
+    }
+
+    private Object jsonValue(Class<?> returnType) throws Exception {
+        if (returnType == String.class) {
+            return this.value;
+        }
+
+        ClassLoader classLoader = returnType.getClassLoader() != null ? returnType.getClassLoader() : Thread.currentThread().getContextClassLoader();
+        Optional<JacksonReader> jackson = classLoader != null ? this.jacksonReaders.computeIfAbsent(classLoader, this::createJacksonReader) : Optional.empty();
+        if (jackson.isPresent()) {
+            return jackson.get().read(returnType);
+        }
+
+        Object parsed = this.json instanceof JSONObject ? ((JSONObject) this.json).toMap()
+                : this.json instanceof JSONArray ? ((JSONArray) this.json).toList() : this.json;
+        return returnType.isInstance(parsed) ? parsed : null;
+    }
+
+    private Optional<JacksonReader> createJacksonReader(ClassLoader classLoader) {
+        try {
+            return Optional.of(new JacksonReader(classLoader, this.value));
+        } catch (ClassNotFoundException e) {
+            return Optional.empty();
+        } catch (Exception | LinkageError e) {
+            log.debug("Could not read return value using Jackson: " + e.getMessage());
+            return Optional.empty();
+        }
+    }
+
+    /**
+     * Reads the value once into a Jackson tree, which is converted into the return type on every call.
+     */
+    private static class JacksonReader {
+        private final Object mapper;
+        private final Object tree;
+        private final Method treeToValue;
+
+        JacksonReader(ClassLoader classLoader, String json) throws Exception {
+            Class<?> mapperClass = Class.forName("com.fasterxml.jackson.databind.ObjectMapper", false, classLoader);
+            this.mapper = mapperClass.getConstructor().newInstance();
+            mapperClass.getMethod("findAndRegisterModules").invoke(this.mapper);
+            this.tree = mapperClass.getMethod("readTree", String.class).invoke(this.mapper, json);
+            this.treeToValue = mapperClass.getMethod("treeToValue", Class.forName("com.fasterxml.jackson.core.TreeNode", false, classLoader), Class.class);
+        }
+
+        Object read(Class<?> returnType) throws Exception {
+            return this.treeToValue.invoke(this.mapper, this.tree, returnType);
+        }
+    }
+}
@@ -0,0 +1,191 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.instrumentation;
+
+import com.steadybit.attacks.javaagent.advice.CaptureDataSourceAdvice;
+import com.steadybit.javaagent.instrumentation.Registration;
+import com.steadybit.javaagent.log.Logger;
+import com.steadybit.javaagent.log.RemoteAgentLogger;
+import com.steadybit.shaded.net.bytebuddy.agent.builder.AgentBuilder;
+import com.steadybit.shaded.net.bytebuddy.asm.Advice;
+import org.json.JSONObject;
+
+import javax.sql.DataSource;
+import java.lang.instrument.Instrumentation;
+import java.lang.reflect.Method;
+import java.sql.Connection;
+import java.sql.SQLException;
+import java.util.ArrayList;
+import java.util.Collections;
+import java.util.IdentityHashMap;
+import java.util.List;
+import java.util.Set;
+
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.hasSuperType;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.isAbstract;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.isPublic;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.named;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.not;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.takesArguments;
+
+/**
+ * Borrows and holds connections of JDBC connection pools (HikariCP, Tomcat JDBC, DBCP2) up to a percentage of their
+ * maximum size.
+ * <p>
+ * The pools are captured when the application obtains a connection from them. The connections are borrowed on a
+ * separate thread, as borrowing blocks as soon as the pool is exhausted, and are returned on reset.
+ */
+public class JdbcConnectionPoolExhaustionInstrumentation extends ClassTransformationPlugin {
+    private static final Logger log = RemoteAgentLogger.getLogger(JdbcConnectionPoolExhaustionInstrumentation.class);
+    private static final String[] MAX_POOL_SIZE_METHODS = { "getMaximumPoolSize", "getMaxActive", "getMaxTotal" };
+    private final String jdbcUrl;
+    private final int poolUtilization;
+    private final Set<Object> seen = Collections.newSetFromMap(new IdentityHashMap<>());
+    private final List<Thread> borrowers = new ArrayList<>();
+    private final List<Connection> borrowed = new ArrayList<>();
+    private final ThreadLocal<Boolean> borrowing = new ThreadLocal<>();
+    private volatile boolean released;
+
+    public JdbcConnectionPoolExhaustionInstrumentation(Instrumentation instrumentation, JSONObject config) {
+        super(instrumentation);
+        String jdbcUrl = config.optString("jdbc-url", "*");
+        this.jdbcUrl = jdbcUrl.isEmpty() ? "*" : jdbcUrl;
+        this.poolUtilization = Math.max(1, Math.min(100, config.optInt("poolUtilization", 100)));
+    }
+
+    @Override
+    protected AgentBuilder doInstall(AgentBuilder agentBuilder) {
+        return agentBuilder.type(hasSuperType(named("javax.sql.DataSource")).and(not(isAbstract()))) //
+                .transform(new AgentBuilder.Transformer.ForAdvice(Advice.withCustomMapping() //
+                        .bind(Registration.class, this.getRegistration())) //
+                        .include(CaptureDataSourceAdvice.class.getClassLoader()) //
+                        .advice(named("getConnection").and(isPublic()).and(takesArguments(0)), CaptureDataSourceAdvice.class.getName()));
+    }
+
+    @Override
+    public Object exec(int code, Object arg1, Object arg2) {
+        if (code == 7 && this.borrowing.get() == null) {
+            this.capture((DataSource) arg1, (Connection) arg2);
+        }
+        return null;
+    }
+
+    @Override
+    public void reset() {
+        super.reset();
+        synchronized (this) {
+            this.released = true;
+            for (Thread borrower : this.borrowers) {
+                borrower.interrupt();
+            }
+            for (Connection connection : this.borrowed) {
+                try {
+                    connection.close();
+                } catch (SQLException e) {
+                    log.debug("Could not return connection to pool: " + e.getMessage());
+                }
+            }
+            this.borrowed.clear();
+        }
+    }
+
+    int getBorrowed() {
+        synchronized (this) {
+            return this.borrowed.size();
+        }
+    }
+
+    private void capture(DataSource dataSource, Connection connection) {
+        synchronized (this) {
+            if (this.released || !this.seen.add(dataSource)) {
+                return;
+            }
+        }
+
+        int maxPoolSize = getMaxPoolSize(dataSource);
+        if (maxPoolSize <= 0 || !this.matchesJdbcUrl(connection)) {
+            return;
+        }
+
+        int connections = (int) Math.ceil(maxPoolSize * this.poolUtilization / 100.0);
+        log.debug("Borrowing " + connections + " of " + maxPoolSize + " connections from " + dataSource.getClass().getName());
+        Thread borrower = new Thread(() -> this.borrow(dataSource, connections), "steadybit-jdbc-pool-exhaustion");
+        borrower.setDaemon(true);
+        synchronized (this) {
+            if (this.released) {
+                return;
+            }
+            this.borrowers.add(borrower);
+        }
+        borrower.start();
+    }
+
+    private void borrow(DataSource dataSource, int connections) {
+        this.borrowing.set(Boolean.TRUE);
+        int held = 0;
+        while (held < connections) {
+            Connection connection;
+            try {
+                connection = dataSource.getConnection();
+            } catch (SQLException e) {
+                log.debug("Could not borrow connection from " + dataSource.getClass().getName() + ": " + e.getMessage());
+                if (this.released) {
+                    return;
+                }
+                try {
+                    //the pool is exhausted or the database is unavailable, retry to grab connections released by the application.
+                    Thread.sleep(100);
+                } catch (InterruptedException ex) {
+                    return;
+                }
+                continue;
+            }
+
+            synchronized (this) {
+                if (this.released) {
+                    closeQuietly(connection);
+                    return;
+                }
+                this.borrowed.add(connection);
+            }
+            held++;
+        }
+    }
+
+    private boolean matchesJdbcUrl(Connection connection) {
+        if ("*".equals(this.jdbcUrl)) {
+            return true;
+        }
+        try {
+            return JdbcUrls.matches(this.jdbcUrl, connection.getMetaData().getURL());
+        } catch (SQLException e) {
+            return false;
+        }
+    }
+
+    static int getMaxPoolSize(Object dataSource) {
+        for (String name : MAX_POOL_SIZE_METHODS) {
+            try {
+                Method method = dataSource.getClass().getMethod(name);
+                method.setAccessible(true);
+                Object result = method.invoke(dataSource);
+                if (result instanceof Number) {
+                    return ((Number) result).intValue();
+                }
+            } catch (Exception e) {
+                //try next method
+            }
+        }
+        return -1;
+    }
+
+    private static void closeQuietly(Connection connection) {
+        try {
+            connection.close();
+        } catch (SQLException e) {
+            //ignore
+        }
+    }
+}
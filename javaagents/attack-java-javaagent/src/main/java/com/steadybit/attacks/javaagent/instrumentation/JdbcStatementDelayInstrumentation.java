@@ -0,0 +1,32 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.instrumentation;
+
+import com.steadybit.attacks.javaagent.advice.JdbcStatementDelayAdvice;
+import org.json.JSONObject;
+
+import java.lang.instrument.Instrumentation;
+
+public class JdbcStatementDelayInstrumentation extends AbstractJdbcStatementInstrumentation {
+    private final DelayDistribution delay;
+
+    public JdbcStatementDelayInstrumentation(Instrumentation instrumentation, JSONObject config) {
+        super(instrumentation, config);
+        this.delay = DelayDistribution.fromConfig(config);
+    }
+
+    @Override
+    protected Class<?> getAdvice() {
+        return JdbcStatementDelayAdvice.class;
+    }
+
+    @Override
+    public Object exec(int code) {
+        if (code == 2) {
+            return this.delay.next();
+        }
+        return super.exec(code);
+    }
+}
@@ -0,0 +1,37 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.instrumentation;
+
+import com.steadybit.attacks.javaagent.advice.JdbcStatementExceptionAdvice;
+import org.json.JSONObject;
+
+import java.lang.instrument.Instrumentation;
+import java.sql.SQLException;
+import java.util.concurrent.ThreadLocalRandom;
+
+public class JdbcStatementExceptionInstrumentation extends AbstractJdbcStatementInstrumentation {
+    private final int errorRate;
+
+    public JdbcStatementExceptionInstrumentation(Instrumentation instrumentation, JSONObject config) {
+        super(instrumentation, config);
+        this.errorRate = config.optInt("erroneousCallRate", 100);
+    }
+
+    @Override
+    protected Class<?> getAdvice() {
+        return JdbcStatementExceptionAdvice.class;
+    }
+
+    @Override
+    public Object exec(int code) {
+        if (code == 5) {
+            if (this.errorRate >= 100 || ThreadLocalRandom.current().nextInt(100) < this.errorRate) {
+                return new SQLException("Exception injected by steadybit");
+            }
+            return null;
+        }
+        return super.exec(code);
+    }
+}
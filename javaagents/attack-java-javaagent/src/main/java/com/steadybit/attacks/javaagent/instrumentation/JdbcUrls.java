@@ -0,0 +1,30 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.instrumentation;
+
+import java.util.regex.Pattern;
+
+/**
+ * Matches JDBC urls against the urls reported by the discovery, which hides passwords.
+ */
+final class JdbcUrls {
+    private static final Pattern PASSWORD_PATTERN = Pattern.compile("(password)=[^;&]*", Pattern.CASE_INSENSITIVE);
+    private static final Pattern ORACLE_PASSWORD_PATTERN = Pattern.compile("/[^@]+@");
+
+    private JdbcUrls() {
+    }
+
+    static boolean matches(String expected, String url) {
+        if (expected == null || expected.isEmpty() || "*".equals(expected)) {
+            return true;
+        }
+        return url != null && (expected.equalsIgnoreCase(url) || expected.equalsIgnoreCase(hidePassword(url)));
+    }
+
+    private static String hidePassword(String url) {
+        String replacedOracle = ORACLE_PASSWORD_PATTERN.matcher(url).replaceAll("/***@");
+        return PASSWORD_PATTERN.matcher(replacedOracle).replaceAll("$1=***");
+    }
+}
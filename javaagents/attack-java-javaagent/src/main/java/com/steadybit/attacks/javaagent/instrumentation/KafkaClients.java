@@ -0,0 +1,81 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.instrumentation;
+
+import java.util.ArrayList;
+import java.util.Collection;
+import java.util.Collections;
+import java.util.List;
+
+/**
+ * Reflective access to the Kafka clients owned by the application.
+ */
+final class KafkaClients {
+    static final String PRODUCER = "org.apache.kafka.clients.producer.KafkaProducer";
+    static final String CONSUMER = "org.apache.kafka.clients.consumer.KafkaConsumer";
+
+    private KafkaClients() {
+        //util
+    }
+
+    static String parseTopic(String topic) {
+        return topic == null || topic.isEmpty() ? "*" : topic;
+    }
+
+    static boolean matchesTopic(String expected, String topic) {
+        return "*".equals(expected) || expected.equals(topic);
+    }
+
+    static String getTopic(Object recordOrPartition) throws ReflectiveOperationException {
+        return (String) recordOrPartition.getClass().getMethod("topic").invoke(recordOrPartition);
+    }
+
+    /**
+     * @return the partitions assigned to the consumer, which have a topic matching the expected one.
+     */
+    static List<Object> getAssignment(Object consumer, String expectedTopic) throws ReflectiveOperationException {
+        return filter((Collection<?>) consumer.getClass().getMethod("assignment").invoke(consumer), expectedTopic);
+    }
+
+    static List<Object> getPaused(Object consumer) throws ReflectiveOperationException {
+        return filter((Collection<?>) consumer.getClass().getMethod("paused").invoke(consumer), "*");
+    }
+
+    static void pause(Object consumer, Collection<?> partitions) throws ReflectiveOperationException {
+        consumer.getClass().getMethod("pause", Collection.class).invoke(consumer, partitions);
+    }
+
+    static void resume(Object consumer, Collection<?> partitions) throws ReflectiveOperationException {
+        consumer.getClass().getMethod("resume", Collection.class).invoke(consumer, partitions);
+    }
+
+    /**
+     * Creates an exception of the Kafka client, trying the given class names in order.
+     */
+    static Exception createException(Object client, String message, String... classNames) {
+        for (String className : classNames) {
+            try {
+                Class<?> exceptionClass = Class.forName(className, false, client.getClass().getClassLoader());
+                return (Exception) exceptionClass.getConstructor(String.class).newInstance(message);
+            } catch (ReflectiveOperationException | LinkageError e) {
+                //try next class
+            }
+        }
+        return new IllegalStateException(message);
+    }
+
+    private static List<Object> filter(Collection<?> partitions, String expectedTopic) throws ReflectiveOperationException {
+        if (partitions == null || partitions.isEmpty()) {
+            return Collections.emptyList();
+        }
+        List<Object> result = new ArrayList<>();
+        for (Object partition : partitions) {
+            if (matchesTopic(expectedTopic, getTopic(partition))) {
+                result.add(partition);
+            }
+        }
+        return result;
+    }
+}
@@ -0,0 +1,82 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.instrumentation;
+
+import com.steadybit.attacks.javaagent.advice.KafkaConsumerCommitDelayAdvice;
+import com.steadybit.javaagent.instrumentation.Registration;
+import com.steadybit.shaded.net.bytebuddy.agent.builder.AgentBuilder;
+import com.steadybit.shaded.net.bytebuddy.asm.Advice;
+import org.json.JSONObject;
+
+import java.lang.instrument.Instrumentation;
+
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.named;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.namedOneOf;
+
+/**
+ * Delays the acknowledgment of consumed records, i.e. the offset commits of {@code KafkaConsumer.commitSync} and
+ * {@code KafkaConsumer.commitAsync}. Spring Kafka listener containers acknowledge through these methods as well.
+ * Offsets committed automatically ({@code enable.auto.commit}) are not affected.
+ * <p>
+ * The commit methods delegate to each other, only the outermost call is delayed.
+ */
+public class KafkaConsumerCommitDelayInstrumentation extends ClassTransformationPlugin {
+    private final DelayDistribution delay;
+    private final String topic;
+    private final ThreadLocal<Boolean> committing = new ThreadLocal<>();
+
+    public KafkaConsumerCommitDelayInstrumentation(Instrumentation instrumentation, JSONObject config) {
+        super(instrumentation);
+        this.delay = DelayDistribution.fromConfig(config);
+        this.topic = KafkaClients.parseTopic(config.optString("topic", "*"));
+    }
+
+    @Override
+    protected AgentBuilder doInstall(AgentBuilder agentBuilder) {
+        return agentBuilder.type(named(KafkaClients.CONSUMER))
+                .transform(new AgentBuilder.Transformer.ForAdvice(Advice.withCustomMapping() //
+                        .bind(Registration.class, this.getRegistration())) //
+                        .include(KafkaConsumerCommitDelayAdvice.class.getClassLoader()) //
+                        .advice(namedOneOf("commitSync", "commitAsync"), KafkaConsumerCommitDelayAdvice.class.getName()));
+    }
+
+    @Override
+    public Object exec(int code) {
+        if (code == 9) {
+            this.committing.remove();
+        }
+        return null;
+    }
+
+    @Override
+    public Object exec(int code, Object arg1) {
+        if (code == 8) {
+            if (this.committing.get() != null) {
+                return false;
+            }
+            this.committing.set(Boolean.TRUE);
+            this.delay(arg1);
+            return true;
+        }
+        return null;
+    }
+
+    private void delay(Object consumer) {
+        try {
+            if (KafkaClients.getAssignment(consumer, this.topic).isEmpty()) {
+                return;
+            }
+        } catch (ReflectiveOperationException | RuntimeException e) {
+            return;
+        }
+
+        try {
+            Thread.sleep(this.delay.next());
+        } catch (InterruptedException e) {
+            //ignore the interruption and restore interruption flag.
+            Thread.currentThread().interrupt();
+        }
+    }
+}
@@ -0,0 +1,72 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.instrumentation;
+
+import com.steadybit.attacks.javaagent.advice.KafkaConsumerPauseAdvice;
+import com.steadybit.javaagent.instrumentation.Registration;
+import com.steadybit.javaagent.log.Logger;
+import com.steadybit.javaagent.log.RemoteAgentLogger;
+import com.steadybit.shaded.net.bytebuddy.agent.builder.AgentBuilder;
+import com.steadybit.shaded.net.bytebuddy.asm.Advice;
+import org.json.JSONObject;
+
+import java.lang.instrument.Instrumentation;
+import java.util.List;
+
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.named;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.takesArguments;
+
+/**
+ * Pauses the assigned partitions of {@code KafkaConsumer.poll} calls, so no records are returned and the consumer lag
+ * grows.
+ * <p>
+ * The consumer is not thread-safe, so the partitions are paused when entering and resumed by the advice when leaving
+ * every poll on the polling thread. The consumer keeps polling and stays in its group. Partitions paused by the application
+ * itself are left untouched.
+ */
+public class KafkaConsumerPauseInstrumentation extends ClassTransformationPlugin {
+    private static final Logger log = RemoteAgentLogger.getLogger(KafkaConsumerPauseInstrumentation.class);
+    private final String topic;
+
+    public KafkaConsumerPauseInstrumentation(Instrumentation instrumentation, JSONObject config) {
+        super(instrumentation);
+        this.topic = KafkaClients.parseTopic(config.optString("topic", "*"));
+    }
+
+    @Override
+    protected AgentBuilder doInstall(AgentBuilder agentBuilder) {
+        return agentBuilder.type(named(KafkaClients.CONSUMER))
+                .transform(new AgentBuilder.Transformer.ForAdvice(Advice.withCustomMapping() //
+                        .bind(Registration.class, this.getRegistration())) //
+                        .include(KafkaConsumerPauseAdvice.class.getClassLoader()) //
+                        .advice(named("poll").and(takesArguments(1)), KafkaConsumerPauseAdvice.class.getName()));
+    }
+
+    @Override
+    public Object exec(int code, Object arg1) {
+        if (code == 10) {
+            return this.pause(arg1);
+        }
+        return null;
+    }
+
+    private List<Object> pause(Object consumer) {
+        try {
+            List<Object> partitions = KafkaClients.getAssignment(consumer, this.topic);
+            if (partitions.isEmpty()) {
+                return null;
+            }
+            partitions.removeAll(KafkaClients.getPaused(consumer));
+            if (partitions.isEmpty()) {
+                return null;
+            }
+            KafkaClients.pause(consumer, partitions);
+            return partitions;
+        } catch (ReflectiveOperationException | RuntimeException e) {
+            log.debug("Could not pause Kafka consumer: " + e.getMessage());
+            return null;
+        }
+    }
+}
@@ -0,0 +1,58 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.instrumentation;
+
+import com.steadybit.attacks.javaagent.advice.KafkaProducerDelayAdvice;
+import com.steadybit.javaagent.instrumentation.Registration;
+import com.steadybit.shaded.net.bytebuddy.agent.builder.AgentBuilder;
+import com.steadybit.shaded.net.bytebuddy.asm.Advice;
+import org.json.JSONObject;
+
+import java.lang.instrument.Instrumentation;
+
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.named;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.takesArguments;
+
+/**
+ * Delays {@code KafkaProducer.send} before the record is handed to the producer.
+ */
+public class KafkaProducerDelayInstrumentation extends ClassTransformationPlugin {
+    private final DelayDistribution delay;
+    private final String topic;
+
+    public KafkaProducerDelayInstrumentation(Instrumentation instrumentation, JSONObject config) {
+        super(instrumentation);
+        this.delay = DelayDistribution.fromConfig(config);
+        this.topic = KafkaClients.parseTopic(config.optString("topic", "*"));
+    }
+
+    @Override
+    protected AgentBuilder doInstall(AgentBuilder agentBuilder) {
+        return agentBuilder.type(named(KafkaClients.PRODUCER))
+                .transform(new AgentBuilder.Transformer.ForAdvice(Advice.withCustomMapping() //
+                        .bind(Registration.class, this.getRegistration())) //
+                        .include(KafkaProducerDelayAdvice.class.getClassLoader()) //
+                        .advice(named("send").and(takesArguments(2)), KafkaProducerDelayAdvice.class.getName()));
+    }
+
+    @Override
+    public Object exec(int code, Object arg1, Object arg2) {
+        if (code == 2) {
+            return this.determineDelay(arg2);
+        }
+        return null;
+    }
+
+    private Long determineDelay(Object record) {
+        try {
+            if (!KafkaClients.matchesTopic(this.topic, KafkaClients.getTopic(record))) {
+                return null;
+            }
+        } catch (ReflectiveOperationException e) {
+            return null;
+        }
+        return this.delay.next();
+    }
+}
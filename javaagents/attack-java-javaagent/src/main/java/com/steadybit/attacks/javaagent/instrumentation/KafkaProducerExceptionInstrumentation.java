@@ -0,0 +1,97 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.instrumentation;
+
+import com.steadybit.attacks.javaagent.advice.KafkaProducerExceptionAdvice;
+import com.steadybit.javaagent.instrumentation.Registration;
+import com.steadybit.javaagent.log.Logger;
+import com.steadybit.javaagent.log.RemoteAgentLogger;
+import com.steadybit.shaded.net.bytebuddy.agent.builder.AgentBuilder;
+import com.steadybit.shaded.net.bytebuddy.asm.Advice;
+import org.json.JSONObject;
+
+import java.lang.instrument.Instrumentation;
+import java.util.concurrent.CompletableFuture;
+import java.util.concurrent.ThreadLocalRandom;
+
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.named;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.takesArguments;
+
+/**
+ * Fails {@code KafkaProducer.send} without sending the record. Like the producer does for errors, the exception is
+ * passed to the callback and the returned future, it is not thrown.
+ */
+public class KafkaProducerExceptionInstrumentation extends ClassTransformationPlugin {
+    static final String TIMEOUT = "TIMEOUT";
+    static final String NOT_LEADER = "NOT_LEADER";
+    private static final Logger log = RemoteAgentLogger.getLogger(KafkaProducerExceptionInstrumentation.class);
+    private final String topic;
+    private final String exceptionType;
+    private final int errorRate;
+
+    public KafkaProducerExceptionInstrumentation(Instrumentation instrumentation, JSONObject config) {
+        super(instrumentation);
+        this.topic = KafkaClients.parseTopic(config.optString("topic", "*"));
+        this.exceptionType = config.optString("exceptionType", TIMEOUT);
+        this.errorRate = config.optInt("erroneousCallRate", 100);
+    }
+
+    @Override
+    protected AgentBuilder doInstall(AgentBuilder agentBuilder) {
+        return agentBuilder.type(named(KafkaClients.PRODUCER))
+                .transform(new AgentBuilder.Transformer.ForAdvice(Advice.withCustomMapping() //
+                        .bind(Registration.class, this.getRegistration())) //
+                        .include(KafkaProducerExceptionAdvice.class.getClassLoader()) //
+                        .advice(named("send").and(takesArguments(2)), KafkaProducerExceptionAdvice.class.getName()));
+    }
+
+    @Override
+    public Object exec(int code, Object arg1, Object arg2, Object arg3) {
+        if (code == 5) {
+            return this.fail(arg1, arg2, arg3);
+        }
+        return null;
+    }
+
+    private CompletableFuture<Object> fail(Object producer, Object record, Object callback) {
+        String recordTopic;
+        try {
+            recordTopic = KafkaClients.getTopic(record);
+        } catch (ReflectiveOperationException e) {
+            return null;
+        }
+
+        if (!KafkaClients.matchesTopic(this.topic, recordTopic)) {
+            return null;
+        }
+        if (this.errorRate < 100 && ThreadLocalRandom.current().nextInt(100) >= this.errorRate) {
+            return null;
+        }
+
+        Exception exception = this.createException(producer, recordTopic);
+        if (callback != null) {
+            try {
+                Class<?> callbackClass = Class.forName("org.apache.kafka.clients.producer.Callback", false, producer.getClass().getClassLoader());
+                Class<?> metadataClass = Class.forName("org.apache.kafka.clients.producer.RecordMetadata", false, producer.getClass().getClassLoader());
+                callbackClass.getMethod("onCompletion", metadataClass, Exception.class).invoke(callback, null, exception);
+            } catch (Exception e) {
+                log.debug("Could not complete Kafka producer callback: " + e.getMessage());
+            }
+        }
+
+        CompletableFuture<Object> future = new CompletableFuture<>();
+        future.completeExceptionally(exception);
+        return future;
+    }
+
+    Exception createException(Object producer, String recordTopic) {
+        if (NOT_LEADER.equals(this.exceptionType)) {
+            return KafkaClients.createException(producer, "This server is not the leader for topic-partition " + recordTopic + ", simulated through a scheduled Steadybit experiment.",
+                    "org.apache.kafka.common.errors.NotLeaderOrFollowerException", "org.apache.kafka.common.errors.NotLeaderForPartitionException");
+        }
+        return KafkaClients.createException(producer, "Expiring record for " + recordTopic + ", simulated through a scheduled Steadybit experiment.",
+                "org.apache.kafka.common.errors.TimeoutException");
+    }
+}
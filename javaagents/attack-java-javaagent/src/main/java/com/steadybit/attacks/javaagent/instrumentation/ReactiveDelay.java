@@ -0,0 +1,191 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.instrumentation;
+
+import com.steadybit.javaagent.log.Logger;
+import com.steadybit.javaagent.log.RemoteAgentLogger;
+
+import java.lang.reflect.InvocationTargetException;
+import java.lang.reflect.Method;
+import java.lang.reflect.Proxy;
+import java.time.Duration;
+import java.util.Arrays;
+import java.util.HashSet;
+import java.util.Set;
+import java.util.concurrent.CompletableFuture;
+import java.util.concurrent.CompletionStage;
+import java.util.concurrent.Executors;
+import java.util.concurrent.ScheduledExecutorService;
+import java.util.concurrent.TimeUnit;
+import java.util.function.Function;
+
+/**
+ * Delays asynchronous results without blocking the calling thread, which usually is an event-loop thread:
+ * reactor publishers by prepending a {@code Mono.delay}, Mutiny {@code Uni}s and {@code Multi}s by delaying the
+ * item or subscription, {@code CompletionStage}s by delaying the completion and other reactive streams publishers by
+ * delaying the subscription. The libraries are looked up using the classloader of the result, as they are not
+ * visible to the agent.
+ */
+class ReactiveDelay {
+    private static final Logger log = RemoteAgentLogger.getLogger(ReactiveDelay.class);
+    private static final String MONO = "reactor.core.publisher.Mono";
+    private static final String FLUX = "reactor.core.publisher.Flux";
+    private static final String PUBLISHER = "org.reactivestreams.Publisher";
+    private static final String FLOW_PUBLISHER = "java.util.concurrent.Flow$Publisher";
+    private static final String UNI = "io.smallrye.mutiny.Uni";
+    private static final String MULTI = "io.smallrye.mutiny.Multi";
+    private static final Set<String> TYPES = new HashSet<>(Arrays.asList(MONO, FLUX, PUBLISHER, FLOW_PUBLISHER, UNI, MULTI,
+            CompletionStage.class.getName(), CompletableFuture.class.getName()));
+    private static final String NON_BLOCKING = "reactor.core.scheduler.NonBlocking";
+    private static final String VERTX_THREAD = "io.vertx.core.impl.VertxThread";
+    private static final String NETTY_THREAD = "io.netty.util.concurrent.FastThreadLocalThread";
+
+    private ReactiveDelay() {
+    }
+
+    /**
+     * @return whether values of the declared type can be delayed reactively.
+     */
+    static boolean isReactiveType(Class<?> type) {
+        return type != null && TYPES.contains(type.getName());
+    }
+
+    /**
+     * @return whether the thread is an event-loop thread of Netty, Vert.x or Reactor, which must not be blocked.
+     */
+    static boolean isEventLoopThread(Thread thread) {
+        for (Class<?> type = thread.getClass(); type != null; type = type.getSuperclass()) {
+            for (Class<?> implemented : type.getInterfaces()) {
+                if (NON_BLOCKING.equals(implemented.getName())) {
+                    return true;
+                }
+            }
+            if (VERTX_THREAD.equals(type.getName())) {
+                try {
+                    return !Boolean.TRUE.equals(type.getMethod("isWorker").invoke(thread));
+                } catch (ReflectiveOperationException e) {
+                    return true;
+                }
+            }
+            if (NETTY_THREAD.equals(type.getName())) {
+                return true;
+            }
+        }
+        return false;
+    }
+
+    /**
+     * @return the delayed value or the given value if it's not asynchronous.
+     */
+    static Object delay(Object value, long millis) {
+        try {
+            if (value instanceof CompletionStage) {
+                return delay((CompletionStage<?>) value, millis);
+            }
+
+            ClassLoader classLoader = value.getClass().getClassLoader();
+            Class<?> uni = loadClass(UNI, classLoader);
+            if (uni != null && uni.isInstance(value)) {
+                return delayItem(uni, value, millis);
+            }
+            if (uni != null && isInstance(MULTI, classLoader, value)) {
+                // Uni.createFrom().nullItem().onItem().delayIt().by(duration).onItem().transformToMulti(ignored -> multi)
+                Method createFrom = uni.getMethod("createFrom");
+                Object delay = delayItem(uni, createFrom.getReturnType().getMethod("nullItem").invoke(createFrom.invoke(null)), millis);
+                Method onItem = uni.getMethod("onItem");
+                Function<Object, Object> multi = ignored -> value;
+                return onItem.getReturnType().getMethod("transformToMulti", Function.class).invoke(onItem.invoke(delay), multi);
+            }
+
+            Class<?> mono = loadClass(MONO, classLoader);
+            if (mono != null) {
+                Class<?> publisherType = Class.forName(PUBLISHER, false, classLoader);
+                Object delay = mono.getMethod("delay", Duration.class).invoke(null, Duration.ofMillis(millis));
+                if (mono.isInstance(value)) {
+                    return mono.getMethod("then", mono).invoke(delay, value);
+                }
+                if (publisherType.isInstance(value)) {
+                    return mono.getMethod("thenMany", publisherType).invoke(delay, value);
+                }
+            }
+
+            for (String publisherName : Arrays.asList(PUBLISHER, FLOW_PUBLISHER)) {
+                Class<?> publisherType = loadClass(publisherName, classLoader);
+                if (publisherType != null && publisherType.isInstance(value)) {
+                    return delaySubscription(publisherType, value, millis);
+                }
+            }
+        } catch (Exception | LinkageError e) {
+            log.debug("Could not delay " + value.getClass().getName() + " reactively: " + e.getMessage());
+        }
+        return value;
+    }
+
+    private static CompletionStage<Object> delay(CompletionStage<?> stage, long millis) {
+        CompletableFuture<Object> delayed = new CompletableFuture<>();
+        stage.whenComplete((result, error) -> Scheduler.INSTANCE.schedule(() -> {
+            if (error != null) {
+                delayed.completeExceptionally(error);
+            } else {
+                delayed.complete(result);
+            }
+        }, millis, TimeUnit.MILLISECONDS));
+        return delayed;
+    }
+
+    /**
+     * Delays the item of a Mutiny {@code Uni} by {@code uni.onItem().delayIt().by(duration)}.
+     */
+    private static Object delayItem(Class<?> uni, Object value, long millis) throws ReflectiveOperationException {
+        Method onItem = uni.getMethod("onItem");
+        Method delayIt = onItem.getReturnType().getMethod("delayIt");
+        Method by = delayIt.getReturnType().getMethod("by", Duration.class);
+        return by.invoke(delayIt.invoke(onItem.invoke(value)), Duration.ofMillis(millis));
+    }
+
+    /**
+     * Wraps a plain publisher, so each subscriber is subscribed delayed on a separate thread.
+     */
+    private static Object delaySubscription(Class<?> publisherType, Object publisher, long millis) {
+        return Proxy.newProxyInstance(publisherType.getClassLoader(), new Class<?>[]{publisherType}, (proxy, method, args) -> {
+            if ("subscribe".equals(method.getName()) && args != null && args.length == 1) {
+                Scheduler.INSTANCE.schedule(() -> {
+                    try {
+                        method.invoke(publisher, args);
+                    } catch (IllegalAccessException | InvocationTargetException e) {
+                        log.debug("Could not subscribe delayed to " + publisher.getClass().getName() + ": " + e.getMessage());
+                    }
+                }, millis, TimeUnit.MILLISECONDS);
+                return null;
+            }
+            try {
+                return method.invoke(publisher, args);
+            } catch (InvocationTargetException e) {
+                throw e.getCause();
+            }
+        });
+    }
+
+    private static boolean isInstance(String className, ClassLoader classLoader, Object value) {
+        Class<?> type = loadClass(className, classLoader);
+        return type != null && type.isInstance(value);
+    }
+
+    private static Class<?> loadClass(String className, ClassLoader classLoader) {
+        try {
+            return Class.forName(className, false, classLoader);
+        } catch (ClassNotFoundException | LinkageError e) {
+            return null;
+        }
+    }
+
+    private static final class Scheduler {
+        private static final ScheduledExecutorService INSTANCE = Executors.newSingleThreadScheduledExecutor(runnable -> {
+            Thread thread = new Thread(runnable, "steadybit-reactive-delay");
+            thread.setDaemon(true);
+            return thread;
+        });
+    }
+}
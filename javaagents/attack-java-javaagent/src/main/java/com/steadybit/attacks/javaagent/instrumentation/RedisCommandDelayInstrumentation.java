@@ -0,0 +1,34 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.instrumentation;
+
+import com.steadybit.attacks.javaagent.advice.RedisCommandDelayAdvice;
+import org.json.JSONObject;
+
+import java.lang.instrument.Instrumentation;
+
+public class RedisCommandDelayInstrumentation extends AbstractRedisCommandInstrumentation {
+    private final DelayDistribution delay;
+
+    public RedisCommandDelayInstrumentation(Instrumentation instrumentation, JSONObject config) {
+        super(instrumentation, config);
+        this.delay = DelayDistribution.fromConfig(config);
+    }
+
+    @Override
+    protected Class<?> getAdvice() {
+        return RedisCommandDelayAdvice.class;
+    }
+
+    @Override
+    protected int getCode() {
+        return 2;
+    }
+
+    @Override
+    protected Object attack(Object owner, String command) {
+        return this.delay.next();
+    }
+}
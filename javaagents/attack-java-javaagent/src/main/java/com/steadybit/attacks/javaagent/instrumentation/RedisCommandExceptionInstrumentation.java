@@ -0,0 +1,38 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.instrumentation;
+
+import com.steadybit.attacks.javaagent.advice.RedisCommandExceptionAdvice;
+import org.json.JSONObject;
+
+import java.lang.instrument.Instrumentation;
+import java.util.concurrent.ThreadLocalRandom;
+
+public class RedisCommandExceptionInstrumentation extends AbstractRedisCommandInstrumentation {
+    private final int errorRate;
+
+    public RedisCommandExceptionInstrumentation(Instrumentation instrumentation, JSONObject config) {
+        super(instrumentation, config);
+        this.errorRate = config.optInt("erroneousCallRate", 100);
+    }
+
+    @Override
+    protected Class<?> getAdvice() {
+        return RedisCommandExceptionAdvice.class;
+    }
+
+    @Override
+    protected int getCode() {
+        return 5;
+    }
+
+    @Override
+    protected Object attack(Object owner, String command) {
+        if (this.errorRate >= 100 || ThreadLocalRandom.current().nextInt(100) < this.errorRate) {
+            return RedisCommands.createException(owner, "Exception injected by steadybit on " + command);
+        }
+        return null;
+    }
+}
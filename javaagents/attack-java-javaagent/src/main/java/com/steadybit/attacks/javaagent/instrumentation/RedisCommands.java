@@ -0,0 +1,100 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.instrumentation;
+
+import java.nio.charset.StandardCharsets;
+import java.util.Arrays;
+import java.util.HashSet;
+import java.util.Locale;
+import java.util.Set;
+
+/**
+ * Reflective access to the commands of the Redis clients (Lettuce and Jedis) owned by the application.
+ */
+final class RedisCommands {
+    static final String LETTUCE_CHANNEL_HANDLER = "io.lettuce.core.RedisChannelHandler";
+    static final String LETTUCE_COMMAND = "io.lettuce.core.protocol.RedisCommand";
+    static final String JEDIS_CONNECTION = "redis.clients.jedis.Connection";
+    static final String JEDIS_COMMAND_ARGUMENTS = "redis.clients.jedis.CommandArguments";
+    private static final String LETTUCE_KEYWORD = "io.lettuce.core.protocol.ProtocolKeyword";
+    private static final String JEDIS_COMMAND = "redis.clients.jedis.commands.ProtocolCommand";
+    private static final Set<String> READ_COMMANDS = new HashSet<>(Arrays.asList("GET", "MGET", "GETRANGE", "STRLEN", "EXISTS", "TYPE",
+            "TTL", "PTTL", "KEYS", "SCAN", "HGET", "HMGET", "HGETALL", "HKEYS", "HVALS", "HLEN", "HEXISTS", "HSTRLEN", "HSCAN", "LRANGE",
+            "LINDEX", "LLEN", "LPOS", "SMEMBERS", "SISMEMBER", "SMISMEMBER", "SCARD", "SRANDMEMBER", "SSCAN", "SINTER", "SUNION", "SDIFF",
+            "ZRANGE", "ZRANGEBYSCORE", "ZRANGEBYLEX", "ZREVRANGE", "ZREVRANGEBYSCORE", "ZSCORE", "ZMSCORE", "ZCARD", "ZCOUNT", "ZRANK",
+            "ZREVRANK", "ZSCAN", "GETBIT", "BITCOUNT", "BITPOS", "PFCOUNT", "XRANGE", "XREVRANGE", "XREAD", "XLEN", "GEOPOS", "GEODIST",
+            "GEOSEARCH", "DUMP", "OBJECT", "RANDOMKEY", "DBSIZE"));
+    private static final Set<String> WRITE_COMMANDS = new HashSet<>(Arrays.asList("SET", "SETEX", "PSETEX", "SETNX", "MSET", "MSETNX",
+            "GETSET", "GETDEL", "GETEX", "SETRANGE", "APPEND", "INCR", "INCRBY", "INCRBYFLOAT", "DECR", "DECRBY", "DEL", "UNLINK", "EXPIRE",
+            "PEXPIRE", "EXPIREAT", "PEXPIREAT", "PERSIST", "RENAME", "RENAMENX", "COPY", "RESTORE", "HSET", "HSETNX", "HMSET", "HDEL",
+            "HINCRBY", "HINCRBYFLOAT", "LPUSH", "RPUSH", "LPUSHX", "RPUSHX", "LPOP", "RPOP", "BLPOP", "BRPOP", "LSET", "LREM", "LTRIM",
+            "LINSERT", "LMOVE", "RPOPLPUSH", "SADD", "SREM", "SPOP", "SMOVE", "SINTERSTORE", "SUNIONSTORE", "SDIFFSTORE", "ZADD", "ZREM",
+            "ZINCRBY", "ZPOPMIN", "ZPOPMAX", "ZREMRANGEBYSCORE", "ZREMRANGEBYRANK", "ZREMRANGEBYLEX", "SETBIT", "BITOP", "PFADD",
+            "PFMERGE", "XADD", "XDEL", "XTRIM", "XACK", "GEOADD", "PUBLISH", "FLUSHDB", "FLUSHALL"));
+
+    private RedisCommands() {
+        //util
+    }
+
+    /**
+     * @param command a Lettuce {@code RedisCommand}, a Jedis {@code CommandArguments} or {@code ProtocolCommand}
+     * @return the upper case name of the command, e.g. {@code GET}
+     */
+    static String getName(Object command) throws ReflectiveOperationException {
+        byte[] name;
+        if (isInstance(command, LETTUCE_COMMAND)) {
+            Object keyword = invoke(command, LETTUCE_COMMAND, "getType");
+            name = (byte[]) invoke(keyword, LETTUCE_KEYWORD, "getBytes");
+        } else {
+            Object protocolCommand = isInstance(command, JEDIS_COMMAND_ARGUMENTS) ? invoke(command, JEDIS_COMMAND_ARGUMENTS, "getCommand") : command;
+            name = (byte[]) invoke(protocolCommand, JEDIS_COMMAND, "getRaw");
+        }
+        return new String(name, StandardCharsets.US_ASCII).toUpperCase(Locale.ROOT);
+    }
+
+    /**
+     * @param operations {@code *} for all commands, {@code r} for reading and {@code w} for writing commands
+     */
+    static boolean matches(String operations, String command) {
+        if ("*".equals(operations) || operations.isEmpty()) {
+            return true;
+        } else if ("r".equalsIgnoreCase(operations)) {
+            return READ_COMMANDS.contains(command);
+        } else if ("w".equalsIgnoreCase(operations)) {
+            return WRITE_COMMANDS.contains(command);
+        }
+        return false;
+    }
+
+    /**
+     * Creates the connection exception of the client owning the command, as if the Redis server was unavailable.
+     */
+    static RuntimeException createException(Object owner, String message) {
+        String className = isInstance(owner, JEDIS_CONNECTION) ? "redis.clients.jedis.exceptions.JedisConnectionException" : "io.lettuce.core.RedisConnectionException";
+        try {
+            return (RuntimeException) loadClass(owner, className).getConstructor(String.class).newInstance(message);
+        } catch (ReflectiveOperationException | RuntimeException e) {
+            return new IllegalStateException(message);
+        }
+    }
+
+    private static boolean isInstance(Object target, String type) {
+        try {
+            return loadClass(target, type).isInstance(target);
+        } catch (ClassNotFoundException e) {
+            return false;
+        }
+    }
+
+    private static Object invoke(Object target, String type, String method) throws ReflectiveOperationException {
+        // Resolve the method on the public API type, the implementations might not be accessible
+        return loadClass(target, type).getMethod(method).invoke(target);
+    }
+
+    private static Class<?> loadClass(Object target, String type) throws ClassNotFoundException {
+        ClassLoader classLoader = target.getClass().getClassLoader();
+        return Class.forName(type, false, classLoader != null ? classLoader : ClassLoader.getSystemClassLoader());
+    }
+}
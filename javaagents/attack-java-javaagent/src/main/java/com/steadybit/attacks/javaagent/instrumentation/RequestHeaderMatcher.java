@@ -0,0 +1,189 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.instrumentation;
+
+import org.json.JSONObject;
+
+import java.lang.reflect.Method;
+import java.util.ArrayList;
+import java.util.List;
+import java.util.Map;
+import java.util.Optional;
+import java.util.concurrent.ConcurrentHashMap;
+import java.util.regex.Pattern;
+
+/**
+ * Matches the request currently bound to the thread against a configured header condition.
+ * <p>
+ * The request is looked up via Spring's {@code RequestContextHolder}, Micronaut's {@code ServerRequestContext}, the
+ * {@code CurrentRequestManager} of RESTEasy Reactive (Quarkus) or the {@code ResteasyContext} of RESTEasy using the
+ * context classloader, as the framework classes are not visible to the agent. Jersey has no such holder, so its
+ * {@code ContainerRequest} is bound to the thread by {@link #bind(Object)} while it is processed. The reflective
+ * lookups are resolved once per context classloader. Calls outside a request never match.
+ */
+public class RequestHeaderMatcher {
+    private final String name;
+    private final Pattern value;
+    //the matcher lives only as long as the attack, so holding on to the classloaders is fine
+    private final Map<ClassLoader, List<HeaderLookup>> lookups = new ConcurrentHashMap<>();
+    private final ThreadLocal<Object> jerseyRequest = new ThreadLocal<>();
+
+    public RequestHeaderMatcher(String name, String value) {
+        this.name = name;
+        this.value = value == null || value.isEmpty() ? null : Pattern.compile(value);
+    }
+
+    /**
+     * @return the matcher for the attack config or {@code null} if no header condition is configured.
+     */
+    public static RequestHeaderMatcher fromConfig(JSONObject config) {
+        JSONObject header = config.optJSONObject("requestHeader");
+        if (header == null || header.optString("name", "").isEmpty()) {
+            return null;
+        }
+        return new RequestHeaderMatcher(header.getString("name"), header.optString("value", ""));
+    }
+
+    public String getName() {
+        return this.name;
+    }
+
+    /**
+     * Binds the Jersey {@code ContainerRequest} processed by the current thread, {@code null} unbinds it.
+     */
+    public void bind(Object request) {
+        if (request == null) {
+            this.jerseyRequest.remove();
+        } else {
+            this.jerseyRequest.set(request);
+        }
+    }
+
+    public boolean test() {
+        return this.test(this.currentRequestHeader());
+    }
+
+    /**
+     * Tests the given header value, for requests not bound to the thread, e.g. in WebFlux.
+     */
+    public boolean test(String header) {
+        if (header == null) {
+            return false;
+        }
+        return this.value == null || this.value.matcher(header).matches();
+    }
+
+    private String currentRequestHeader() {
+        ClassLoader classLoader = Thread.currentThread().getContextClassLoader();
+        if (classLoader == null) {
+            return null;
+        }
+        for (HeaderLookup lookup : this.lookups.computeIfAbsent(classLoader, this::resolveLookups)) {
+            try {
+                String header = lookup.getHeader(this.name);
+                if (header != null) {
+                    return header;
+                }
+            } catch (Exception e) {
+                //not within a request of this framework
+            }
+        }
+        return null;
+    }
+
+    private List<HeaderLookup> resolveLookups(ClassLoader classLoader) {
+        List<HeaderLookup> lookups = new ArrayList<>(5);
+        addIfPresent(lookups, () -> servletLookup(classLoader));
+        addIfPresent(lookups, () -> micronautLookup(classLoader));
+        addIfPresent(lookups, () -> resteasyReactiveLookup(classLoader));
+        addIfPresent(lookups, () -> resteasyLookup(classLoader));
+        addIfPresent(lookups, () -> this.jerseyLookup(classLoader));
+        return lookups;
+    }
+
+    private static void addIfPresent(List<HeaderLookup> lookups, LookupResolver resolver) {
+        try {
+            lookups.add(resolver.resolve());
+        } catch (ReflectiveOperationException | LinkageError e) {
+            //framework not present in the classloader
+        }
+    }
+
+    private static HeaderLookup servletLookup(ClassLoader classLoader) throws ReflectiveOperationException {
+        Method getRequestAttributes = classLoader.loadClass("org.springframework.web.context.request.RequestContextHolder").getMethod("getRequestAttributes");
+        Class<?> attributesClass = classLoader.loadClass("org.springframework.web.context.request.ServletRequestAttributes");
+        Method getRequest = attributesClass.getMethod("getRequest");
+        //jakarta or javax servlet api, depending on the spring version
+        Method getHeader = getRequest.getReturnType().getMethod("getHeader", String.class);
+        return name -> {
+            Object attributes = getRequestAttributes.invoke(null);
+            if (!attributesClass.isInstance(attributes)) {
+                return null;
+            }
+            Object request = getRequest.invoke(attributes);
+            return request != null ? (String) getHeader.invoke(request, name) : null;
+        };
+    }
+
+    private static HeaderLookup micronautLookup(ClassLoader classLoader) throws ReflectiveOperationException {
+        Method currentRequest = classLoader.loadClass("io.micronaut.http.context.ServerRequestContext").getMethod("currentRequest");
+        Method getHeaders = classLoader.loadClass("io.micronaut.http.HttpMessage").getMethod("getHeaders");
+        Method get = classLoader.loadClass("io.micronaut.http.HttpHeaders").getMethod("get", CharSequence.class);
+        return name -> {
+            Optional<?> request = (Optional<?>) currentRequest.invoke(null);
+            return request.isPresent() ? (String) get.invoke(getHeaders.invoke(request.get()), name) : null;
+        };
+    }
+
+    private static HeaderLookup resteasyReactiveLookup(ClassLoader classLoader) throws ReflectiveOperationException {
+        Method get = classLoader.loadClass("org.jboss.resteasy.reactive.server.core.CurrentRequestManager").getMethod("get");
+        Method serverRequest = classLoader.loadClass("org.jboss.resteasy.reactive.server.core.ResteasyReactiveRequestContext").getMethod("serverRequest");
+        Method getRequestHeader = classLoader.loadClass("org.jboss.resteasy.reactive.server.spi.ServerHttpRequest").getMethod("getRequestHeader", CharSequence.class);
+        return name -> {
+            Object context = get.invoke(null);
+            return context != null ? (String) getRequestHeader.invoke(serverRequest.invoke(context), name) : null;
+        };
+    }
+
+    private static HeaderLookup resteasyLookup(ClassLoader classLoader) throws ReflectiveOperationException {
+        Class<?> headersClass = loadFirst(classLoader, "jakarta.ws.rs.core.HttpHeaders", "javax.ws.rs.core.HttpHeaders");
+        Method getHeaderString = headersClass.getMethod("getHeaderString", String.class);
+        //ResteasyContext since RESTEasy 4, before the context data was held by the ResteasyProviderFactory
+        Method getContextData = loadFirst(classLoader, "org.jboss.resteasy.core.ResteasyContext", "org.jboss.resteasy.spi.ResteasyProviderFactory")
+                .getMethod("getContextData", Class.class);
+        return name -> {
+            Object headers = getContextData.invoke(null, headersClass);
+            return headers != null ? (String) getHeaderString.invoke(headers, name) : null;
+        };
+    }
+
+    private HeaderLookup jerseyLookup(ClassLoader classLoader) throws ReflectiveOperationException {
+        Class<?> requestClass = classLoader.loadClass("org.glassfish.jersey.server.ContainerRequest");
+        Method getHeaderString = requestClass.getMethod("getHeaderString", String.class);
+        return name -> {
+            Object request = this.jerseyRequest.get();
+            return requestClass.isInstance(request) ? (String) getHeaderString.invoke(request, name) : null;
+        };
+    }
+
+    private static Class<?> loadFirst(ClassLoader classLoader, String... classNames) throws ClassNotFoundException {
+        for (String className : classNames) {
+            try {
+                return classLoader.loadClass(className);
+            } catch (ClassNotFoundException e) {
+                //try the next one
+            }
+        }
+        throw new ClassNotFoundException(String.join(", ", classNames));
+    }
+
+    private interface HeaderLookup {
+        String getHeader(String name) throws Exception;
+    }
+
+    private interface LookupResolver {
+        HeaderLookup resolve() throws ReflectiveOperationException;
+    }
+}
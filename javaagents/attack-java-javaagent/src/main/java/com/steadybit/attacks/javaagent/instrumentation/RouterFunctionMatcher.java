@@ -0,0 +1,102 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.instrumentation;
+
+import org.json.JSONArray;
+import org.json.JSONObject;
+
+import java.lang.reflect.Method;
+import java.util.List;
+import java.util.stream.Collectors;
+
+/**
+ * Matches the {@code ServerWebExchange} handled by a WebFlux router function against a configured pattern and http
+ * methods.
+ * <p>
+ * Router functions are usually lambdas, which can't be instrumented by their handler method. Instead, the
+ * {@code HandlerFunctionAdapter} invoking them is instrumented and the request is matched using the best matching
+ * pattern stored in the exchange by the {@code RouterFunctionMapping}. The WebFlux classes are looked up on the exchange
+ * itself, as they are not visible to the agent.
+ */
+public class RouterFunctionMatcher {
+    static final String HANDLER_FUNCTION_ADAPTER = "org.springframework.web.reactive.function.server.support.HandlerFunctionAdapter";
+    private static final String BEST_MATCHING_PATTERN_ATTRIBUTE = "org.springframework.web.reactive.HandlerMapping.bestMatchingPattern";
+    private static final JSONArray ANY_METHOD = new JSONArray().put("*");
+    private final String pattern;
+    private final List<String> methods;
+
+    public RouterFunctionMatcher(String pattern, List<String> methods) {
+        this.pattern = pattern;
+        this.methods = methods;
+    }
+
+    /**
+     * @return the matcher for the attack config or {@code null} if no router function is configured.
+     */
+    public static RouterFunctionMatcher fromConfig(JSONObject config) {
+        JSONObject routerFunction = config.optJSONObject("routerFunction");
+        if (routerFunction == null || routerFunction.optString("pattern", "").isEmpty()) {
+            return null;
+        }
+        List<String> methods = routerFunction.optJSONArray("methods", ANY_METHOD).toList().stream().map(Object::toString).collect(Collectors.toList());
+        return new RouterFunctionMatcher(routerFunction.getString("pattern"), methods);
+    }
+
+    public boolean test(Object exchange, RequestHeaderMatcher requestHeaderMatcher) {
+        try {
+            Object pattern = invoke(exchange, "org.springframework.web.server.ServerWebExchange", "getAttribute", BEST_MATCHING_PATTERN_ATTRIBUTE);
+            if (pattern == null || !this.pattern.equals(pattern.toString())) {
+                return false;
+            }
+
+            Object request = invoke(exchange, "org.springframework.web.server.ServerWebExchange", "getRequest");
+            if (!this.methods.contains("*")) {
+                Object method = invoke(request, "org.springframework.http.HttpRequest", "getMethod");
+                if (method == null || !this.methods.contains(method.toString())) {
+                    return false;
+                }
+            }
+
+            if (requestHeaderMatcher != null) {
+                Object headers = invoke(request, "org.springframework.http.HttpMessage", "getHeaders");
+                return requestHeaderMatcher.test((String) invoke(headers, "org.springframework.http.HttpHeaders", "getFirst", requestHeaderMatcher.getName()));
+            }
+            return true;
+        } catch (Exception e) {
+            //not a webflux exchange
+            return false;
+        }
+    }
+
+    private static Object invoke(Object target, String typeName, String methodName, Object... args) throws ReflectiveOperationException {
+        // the implementations are often not public, so the method is invoked via the public type declaring it
+        Class<?> type = findType(target.getClass(), typeName);
+        if (type == null) {
+            throw new NoSuchMethodException(typeName + "." + methodName);
+        }
+        for (Method method : type.getMethods()) {
+            if (method.getName().equals(methodName) && method.getParameterCount() == args.length) {
+                return method.invoke(target, args);
+            }
+        }
+        throw new NoSuchMethodException(typeName + "." + methodName);
+    }
+
+    private static Class<?> findType(Class<?> type, String typeName) {
+        if (type == null) {
+            return null;
+        }
+        if (type.getName().equals(typeName)) {
+            return type;
+        }
+        for (Class<?> candidate : type.getInterfaces()) {
+            Class<?> found = findType(candidate, typeName);
+            if (found != null) {
+                return found;
+            }
+        }
+        return findType(type.getSuperclass(), typeName);
+    }
+}
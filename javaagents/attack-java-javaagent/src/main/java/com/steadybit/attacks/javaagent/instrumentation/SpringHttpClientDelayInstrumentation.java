@@ -17,7 +17,6 @@ import org.json.JSONObject;
 import java.lang.instrument.Instrumentation;
 import java.net.URI;
 import java.util.List;
-import java.util.concurrent.ThreadLocalRandom;
 import java.util.stream.Collectors;
 
 import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.declaresMethod;
@@ -30,8 +29,7 @@ import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.takesNo
 
 public class SpringHttpClientDelayInstrumentation extends ClassTransformationPlugin {
     private static final JSONArray EMPTY_ARRAY = new JSONArray();
-    private final long delay;
-    private final boolean delayJitter;
+    private final DelayDistribution delay;
     private final ElementMatcher<MethodDescription> executeMethod = named("execute").and(takesNoArguments()).and(not(isAbstract()));
     private final ElementMatcher<MethodDescription> connectMethod = named("connect").and(takesArguments(3)).and(not(isAbstract()));
     private final List<String> httpMethods;
@@ -40,8 +38,7 @@ public class SpringHttpClientDelayInstrumentation extends ClassTransformationPlu
 
     public SpringHttpClientDelayInstrumentation(Instrumentation instrumentation, JSONObject config) {
         super(instrumentation);
-        this.delay = config.optLong("delay", 500L);
-        this.delayJitter = config.optBoolean("delayJitter", false);
+        this.delay = DelayDistribution.fromConfig(config);
         this.httpMethods = config.optJSONArray("httpMethods", EMPTY_ARRAY).toList().stream().map(Object::toString).collect(Collectors.toList());
         this.hostAdress = config.optString("hostAddress", "*");
         this.urlPath = config.optString("urlPath", "/**");
@@ -76,11 +73,6 @@ public class SpringHttpClientDelayInstrumentation extends ClassTransformationPlu
             return null;
         }
 
-        if (this.delayJitter) {
-            double jitterValue = 1.3d - ThreadLocalRandom.current().nextDouble(0.6d);
-            return Math.round(jitterValue * this.delay);
-        } else {
-            return this.delay;
-        }
+        return this.delay.next();
     }
 }
@@ -28,8 +28,6 @@ import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.takesAr
 import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.takesNoArguments;
 
 public class SpringHttpClientStatusInstrumentation extends ClassTransformationPlugin {
-    private static final int[] HTTP_4XX_CODES = {400, 401, 402, 403, 404, 405, 406, 407, 408, 409, 410, 411, 412, 413, 414, 415, 416, 417, 421, 422, 423, 424, 425, 426, 428, 429, 431, 451};
-    private static final int[] HTTP_5XX_CODES = {500, 501, 502, 503, 504, 505, 506, 507, 508, 509, 510, 511};
     private static final JSONArray EMPTY_ARRAY = new JSONArray();
     private static final String ADVICE_PKG = "com.steadybit.attacks.javaagent.advice.";
     // Spring 6+ (incl. 7) is on the target when HttpStatusCode is present; it changed the client-response
@@ -103,39 +101,6 @@ public class SpringHttpClientStatusInstrumentation extends ClassTransformationPl
     }
 
     private Integer determineFailureStatusCode() {
-        if (this.failureCauses.isEmpty()) {
-            return 500;
-        }
-
-        ThreadLocalRandom threadLocalRandom = ThreadLocalRandom.current();
-        String failureCause = this.failureCauses.get(threadLocalRandom.nextInt(this.failureCauses.size()));
-
-        if (HttpClientFailureCause.ERROR.equals(failureCause)) {
-            return -2;
-        } else if (HttpClientFailureCause.TIMEOUT.equals(failureCause)) {
-            return -1;
-        } else if (HttpClientFailureCause.HTTP_4XX.equals(failureCause)) {
-            return HTTP_4XX_CODES[threadLocalRandom.nextInt(0, HTTP_4XX_CODES.length)];
-        } else if (HttpClientFailureCause.HTTP_5XX.equals(failureCause)) {
-            return HTTP_5XX_CODES[threadLocalRandom.nextInt(0, HTTP_5XX_CODES.length)];
-        } else if (HttpClientFailureCause.HTTP_400.equals(failureCause)) {
-            return 400;
-        } else if (HttpClientFailureCause.HTTP_403.equals(failureCause)) {
-            return 403;
-        } else if (HttpClientFailureCause.HTTP_404.equals(failureCause)) {
-            return 404;
-        } else if (HttpClientFailureCause.HTTP_429.equals(failureCause)) {
-            return 429;
-        } else if (HttpClientFailureCause.HTTP_500.equals(failureCause)) {
-            return 500;
-        } else if (HttpClientFailureCause.HTTP_502.equals(failureCause)) {
-            return 502;
-        } else if (HttpClientFailureCause.HTTP_503.equals(failureCause)) {
-            return 503;
-        } else if (HttpClientFailureCause.HTTP_504.equals(failureCause)) {
-            return 504;
-        }
-
-        return null;
+        return HttpClientFailureCause.determineStatusCode(this.failureCauses);
     }
 }
@@ -4,10 +4,9 @@
 
 package com.steadybit.attacks.javaagent.instrumentation;
 
-import com.steadybit.attacks.javaagent.advice.Delay;
 import com.steadybit.attacks.javaagent.advice.JdbcTemplateDelayAdvice;
 import com.steadybit.attacks.javaagent.advice.JdbcUrl;
-import com.steadybit.attacks.javaagent.advice.Jitter;
+import com.steadybit.javaagent.instrumentation.Registration;
 import com.steadybit.shaded.net.bytebuddy.agent.builder.AgentBuilder;
 import com.steadybit.shaded.net.bytebuddy.asm.Advice;
 import com.steadybit.shaded.net.bytebuddy.description.method.MethodDescription;
@@ -22,10 +21,9 @@ import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.none;
 import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.takesArgument;
 import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.takesArguments;
 
-public class SpringJdbcTemplateDelayInstrumentation extends ClassTransformation {
+public class SpringJdbcTemplateDelayInstrumentation extends ClassTransformationPlugin {
     private static final String CLASSNAME_JDBC_TEMPLATE = "org.springframework.jdbc.core.JdbcTemplate";
-    private final long delay;
-    private final boolean delayJitter;
+    private final DelayDistribution delay;
     private final String jdbcUrl;
     private ElementMatcher.Junction<MethodDescription> readMethodMatcher;
     private ElementMatcher.Junction<MethodDescription> writeMethodMatcher;
@@ -34,8 +32,7 @@ public class SpringJdbcTemplateDelayInstrumentation extends ClassTransformation
         super(instrumentation);
         this.writeMethodMatcher = none();
         this.readMethodMatcher = none();
-        this.delay = config.optLong("delay", 500L);
-        this.delayJitter = config.optBoolean("delayJitter", false);
+        this.delay = DelayDistribution.fromConfig(config);
         this.jdbcUrl = config.optString("jdbc-url", "*");
         this.initializeMatchers(config.optString("operations", "*"));
     }
@@ -44,14 +41,21 @@ public class SpringJdbcTemplateDelayInstrumentation extends ClassTransformation
     protected AgentBuilder doInstall(AgentBuilder agentBuilder) {
         return agentBuilder.type(named(CLASSNAME_JDBC_TEMPLATE)) //
                 .transform(new AgentBuilder.Transformer.ForAdvice(Advice.withCustomMapping() //
-                        .bind(Delay.class, this.delay)//
-                        .bind(Jitter.class, this.delayJitter)//
+                        .bind(Registration.class, this.getRegistration())//
                         .bind(JdbcUrl.class, this.jdbcUrl))//
                         .include(JdbcTemplateDelayAdvice.class.getClassLoader()) //
                         .advice(this.readMethodMatcher, JdbcTemplateDelayAdvice.class.getName()) //
                         .advice(this.writeMethodMatcher, JdbcTemplateDelayAdvice.class.getName()));
     }
 
+    @Override
+    public Object exec(int code) {
+        if (code == 2) {
+            return this.delay.next();
+        }
+        return null;
+    }
+
     private void initializeMatchers(String operations) {
         // Any & Reads
         if (operations.equalsIgnoreCase("*") || operations.equalsIgnoreCase("r")) {
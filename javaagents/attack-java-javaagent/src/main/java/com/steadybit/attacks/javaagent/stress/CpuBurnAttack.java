@@ -0,0 +1,93 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.stress;
+
+import com.steadybit.attacks.javaagent.Installable;
+import com.steadybit.javaagent.log.Logger;
+import com.steadybit.javaagent.log.RemoteAgentLogger;
+import org.json.JSONObject;
+
+import java.lang.instrument.Instrumentation;
+import java.util.ArrayList;
+import java.util.List;
+import java.util.concurrent.TimeUnit;
+
+/**
+ * Consumes CPU within the JVM using a number of threads.
+ * <p>
+ * Each thread is busy for the configured utilization of a 100ms period and sleeps for the rest of it. The threads
+ * are named using the configured prefix so they can be identified in thread dumps and profilers.
+ */
+public class CpuBurnAttack implements Installable {
+    private static final Logger log = RemoteAgentLogger.getLogger(CpuBurnAttack.class);
+    private static final long PERIOD_NANOS = TimeUnit.MILLISECONDS.toNanos(100);
+    private final int threads;
+    private final int utilization;
+    private final String threadNamePrefix;
+    private final List<Thread> workers = new ArrayList<>();
+    private volatile boolean running;
+    private volatile long sink;
+
+    public CpuBurnAttack(Instrumentation instrumentation, JSONObject config) {
+        int threads = config.optInt("threads", 1);
+        this.threads = threads > 0 ? threads : Runtime.getRuntime().availableProcessors();
+        this.utilization = Math.max(1, Math.min(100, config.optInt("utilization", 100)));
+        this.threadNamePrefix = config.optString("threadNamePrefix", "steadybit-cpu-burn");
+    }
+
+    @Override
+    public AdviceApplied install() {
+        log.debug("Burning CPU on " + this.threads + " threads with " + this.utilization + "% utilization");
+        this.running = true;
+        for (int i = 0; i < this.threads; i++) {
+            Thread worker = new Thread(this::burn, this.threadNamePrefix + "-" + i);
+            worker.setDaemon(true);
+            worker.start();
+            this.workers.add(worker);
+        }
+        return AdviceApplied.APPLIED;
+    }
+
+    @Override
+    public void reset() {
+        this.running = false;
+        for (Thread worker : this.workers) {
+            worker.interrupt();
+        }
+        for (Thread worker : this.workers) {
+            try {
+                worker.join(TimeUnit.SECONDS.toMillis(1));
+            } catch (InterruptedException e) {
+                Thread.currentThread().interrupt();
+            }
+        }
+        this.workers.clear();
+    }
+
+    List<Thread> getWorkers() {
+        return this.workers;
+    }
+
+    private void burn() {
+        long busyNanos = PERIOD_NANOS * this.utilization / 100;
+        long value = 0;
+        while (this.running) {
+            long start = System.nanoTime();
+            while (System.nanoTime() - start < busyNanos) {
+                value += Long.rotateLeft(value ^ start, 7);
+            }
+            this.sink = value;
+
+            long idleNanos = PERIOD_NANOS - (System.nanoTime() - start);
+            if (idleNanos > 0) {
+                try {
+                    TimeUnit.NANOSECONDS.sleep(idleNanos);
+                } catch (InterruptedException e) {
+                    return;
+                }
+            }
+        }
+    }
+}
@@ -0,0 +1,122 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.stress;
+
+import com.steadybit.attacks.javaagent.Installable;
+import com.steadybit.javaagent.log.Logger;
+import com.steadybit.javaagent.log.RemoteAgentLogger;
+import org.json.JSONObject;
+
+import java.lang.instrument.Instrumentation;
+import java.util.ArrayList;
+import java.util.List;
+import java.util.concurrent.TimeUnit;
+
+/**
+ * Puts pressure on the heap of the JVM.
+ * <p>
+ * In the {@code FILL} mode the configured amount of memory is allocated and retained until the attack is reset. A heap
+ * percentage fills the heap up to that usage, taking the memory already in use into account. To avoid crashing the
+ * application, the filling stops at the first {@link OutOfMemoryError} and some memory is
+ * released again. In the {@code ALLOCATION_RATE} mode memory is allocated at the configured rate and released right
+ * away, which stresses the garbage collector without retaining memory.
+ */
+public class HeapFillAttack implements Installable {
+    private static final Logger log = RemoteAgentLogger.getLogger(HeapFillAttack.class);
+    static final int CHUNK_SIZE = 1024 * 1024;
+    private static final long TICK_MILLIS = 10L;
+    private final String mode;
+    private final long bytes;
+    private final int heapPercentage;
+    private final long allocationRate;
+    private final List<byte[]> retained = new ArrayList<>();
+    private volatile boolean running;
+    private volatile Object sink;
+    private Thread thread;
+
+    public HeapFillAttack(Instrumentation instrumentation, JSONObject config) {
+        this.mode = config.optString("mode", "FILL");
+        this.bytes = config.optLong("bytes", -1L);
+        this.heapPercentage = config.optInt("heapPercentage", 80);
+        this.allocationRate = config.optLong("allocationRate", 100L * CHUNK_SIZE);
+    }
+
+    @Override
+    public AdviceApplied install() {
+        this.running = true;
+        this.thread = new Thread("ALLOCATION_RATE".equals(this.mode) ? this::allocate : this::fill, "steadybit-heap-fill");
+        this.thread.setDaemon(true);
+        this.thread.start();
+        return AdviceApplied.APPLIED;
+    }
+
+    @Override
+    public void reset() {
+        this.running = false;
+        if (this.thread != null) {
+            this.thread.interrupt();
+            try {
+                this.thread.join(TimeUnit.SECONDS.toMillis(5));
+            } catch (InterruptedException e) {
+                Thread.currentThread().interrupt();
+            }
+            this.thread = null;
+        }
+        synchronized (this.retained) {
+            this.retained.clear();
+        }
+        this.sink = null;
+    }
+
+    long getRetainedBytes() {
+        synchronized (this.retained) {
+            return (long) this.retained.size() * CHUNK_SIZE;
+        }
+    }
+
+    static long bytesToFill(long maxMemory, long usedMemory, int heapPercentage) {
+        return Math.max(0L, maxMemory / 100L * heapPercentage - usedMemory);
+    }
+
+    private void fill() {
+        Runtime runtime = Runtime.getRuntime();
+        long bytes = this.bytes >= 0 ? this.bytes : bytesToFill(runtime.maxMemory(), runtime.totalMemory() - runtime.freeMemory(), this.heapPercentage);
+        long chunks = bytes / CHUNK_SIZE;
+        log.debug("Filling heap with " + chunks + " MB");
+        try {
+            for (long i = 0; i < chunks && this.running; i++) {
+                byte[] chunk = new byte[CHUNK_SIZE];
+                synchronized (this.retained) {
+                    this.retained.add(chunk);
+                }
+            }
+        } catch (OutOfMemoryError e) {
+            synchronized (this.retained) {
+                int release = Math.max(1, this.retained.size() / 10);
+                this.retained.subList(this.retained.size() - Math.min(release, this.retained.size()), this.retained.size()).clear();
+            }
+            log.warn("Heap exhausted while filling, retaining " + this.getRetainedBytes() / CHUNK_SIZE + " MB");
+        }
+    }
+
+    private void allocate() {
+        long bytesPerTick = Math.max(1L, this.allocationRate * TICK_MILLIS / 1000L);
+        while (this.running) {
+            long start = System.nanoTime();
+            for (long allocated = 0; allocated < bytesPerTick; allocated += CHUNK_SIZE) {
+                // the sink keeps the allocation from being optimized away, the previous array becomes garbage right away
+                this.sink = new byte[(int) Math.min(CHUNK_SIZE, bytesPerTick - allocated)];
+            }
+            long remaining = TICK_MILLIS - TimeUnit.NANOSECONDS.toMillis(System.nanoTime() - start);
+            if (remaining > 0) {
+                try {
+                    Thread.sleep(remaining);
+                } catch (InterruptedException e) {
+                    return;
+                }
+            }
+        }
+    }
+}
@@ -0,0 +1,223 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.stress;
+
+import com.steadybit.attacks.javaagent.Installable;
+import com.steadybit.javaagent.log.Logger;
+import com.steadybit.javaagent.log.RemoteAgentLogger;
+import org.json.JSONObject;
+
+import java.lang.instrument.Instrumentation;
+import java.lang.reflect.Field;
+import java.lang.reflect.Method;
+import java.util.ArrayList;
+import java.util.Collection;
+import java.util.Collections;
+import java.util.concurrent.CountDownLatch;
+import java.util.concurrent.Executor;
+import java.util.concurrent.ForkJoinPool;
+import java.util.concurrent.RejectedExecutionException;
+import java.util.concurrent.ThreadPoolExecutor;
+import java.util.concurrent.atomic.AtomicInteger;
+
+/**
+ * Occupies the worker threads of a thread pool with tasks blocking until the attack is reset.
+ * <p>
+ * The pool is either the request thread pool of the embedded web server (Tomcat, Jetty, Undertow), the
+ * {@link ForkJoinPool#commonPool()} or a Spring bean implementing {@link Executor}, e.g. a
+ * <code>ThreadPoolTaskExecutor</code>. Spring objects are looked up reflectively through the application contexts
+ * registered with Spring Boot's shutdown hook. The attack fails to install if the pool can't be found or its size is
+ * unknown.
+ */
+public class ThreadPoolExhaustionAttack implements Installable {
+    static final String WEB_SERVER = "web-server";
+    static final String COMMON_POOL = "common-pool";
+    private static final Logger log = RemoteAgentLogger.getLogger(ThreadPoolExhaustionAttack.class);
+    private static final int MAX_TASKS = 10_000;
+    private static final String[] MAX_POOL_SIZE_METHODS = { "getMaximumPoolSize", "getMaxThreads", "getMaxPoolSize", "getMaxWorkerPoolSize" };
+    private final Instrumentation instrumentation;
+    private final String pool;
+    private final int poolUtilization;
+    private final CountDownLatch release = new CountDownLatch(1);
+    private final AtomicInteger occupied = new AtomicInteger();
+
+    public ThreadPoolExhaustionAttack(Instrumentation instrumentation, JSONObject config) {
+        this.instrumentation = instrumentation;
+        this.pool = config.optString("pool", WEB_SERVER);
+        this.poolUtilization = Math.max(1, Math.min(100, config.optInt("poolUtilization", 100)));
+    }
+
+    @Override
+    public AdviceApplied install() {
+        Executor executor = this.findExecutor();
+        if (executor == null) {
+            throw new IllegalStateException("Could not find thread pool " + this.pool);
+        }
+
+        int maxPoolSize = getMaxPoolSize(executor);
+        if (maxPoolSize <= 0) {
+            throw new IllegalStateException("Could not determine the size of thread pool " + this.pool + " (" + executor.getClass().getName() + ")");
+        }
+
+        int tasks = Math.min(MAX_TASKS, (int) Math.ceil(maxPoolSize * this.poolUtilization / 100.0));
+        log.debug("Occupying " + tasks + " of " + maxPoolSize + " threads of thread pool " + this.pool);
+        for (int i = 0; i < tasks; i++) {
+            try {
+                executor.execute(this::block);
+            } catch (RejectedExecutionException e) {
+                log.debug("Thread pool " + this.pool + " rejected task after " + i + " submissions");
+                break;
+            }
+        }
+        return AdviceApplied.APPLIED;
+    }
+
+    @Override
+    public void reset() {
+        this.release.countDown();
+    }
+
+    int getOccupied() {
+        return this.occupied.get();
+    }
+
+    private void block() {
+        this.occupied.incrementAndGet();
+        try {
+            this.release.await();
+        } catch (InterruptedException e) {
+            Thread.currentThread().interrupt();
+        } finally {
+            this.occupied.decrementAndGet();
+        }
+    }
+
+    private Executor findExecutor() {
+        if (COMMON_POOL.equals(this.pool)) {
+            return ForkJoinPool.commonPool();
+        }
+
+        for (Object context : this.findApplicationContexts()) {
+            try {
+                Object executor = WEB_SERVER.equals(this.pool) ? findWebServerExecutor(context) : findBeanExecutor(context, this.pool);
+                if (executor instanceof Executor) {
+                    return (Executor) executor;
+                }
+            } catch (Exception e) {
+                log.debug("Could not get thread pool " + this.pool + " from " + context + ": " + e.getMessage());
+            }
+        }
+        return null;
+    }
+
+    private Collection<?> findApplicationContexts() {
+        for (Class<?> clazz : this.instrumentation.getAllLoadedClasses()) {
+            if (!"org.springframework.boot.SpringApplication".equals(clazz.getName())) {
+                continue;
+            }
+            try {
+                Object shutdownHook = readField(clazz, null, "shutdownHook");
+                Object contexts = readField(shutdownHook.getClass(), shutdownHook, "contexts");
+                synchronized (shutdownHook.getClass()) {
+                    return new ArrayList<>((Collection<?>) contexts);
+                }
+            } catch (Exception e) {
+                log.debug("Could not read Spring application contexts: " + e.getMessage());
+            }
+        }
+        return Collections.emptyList();
+    }
+
+    private static Object findWebServerExecutor(Object context) throws Exception {
+        Object webServer = invoke(context, "getWebServer");
+        if (webServer == null) {
+            return null;
+        }
+
+        String type = webServer.getClass().getName();
+        if (type.endsWith("TomcatWebServer")) {
+            Object connector = invoke(invoke(webServer, "getTomcat"), "getConnector");
+            return invoke(invoke(connector, "getProtocolHandler"), "getExecutor");
+        } else if (type.endsWith("JettyWebServer")) {
+            return invoke(invoke(webServer, "getServer"), "getThreadPool");
+        } else if (type.endsWith("UndertowWebServer")) {
+            Object undertow = readField(webServer.getClass(), webServer, "undertow");
+            return undertow != null ? invoke(undertow, "getWorker") : null;
+        }
+        log.debug("Unsupported web server " + type);
+        return null;
+    }
+
+    private static Object findBeanExecutor(Object context, String beanName) throws Exception {
+        Method containsBean = context.getClass().getMethod("containsBean", String.class);
+        if (!((Boolean) containsBean.invoke(context, beanName))) {
+            return null;
+        }
+
+        Object bean = context.getClass().getMethod("getBean", String.class).invoke(context, beanName);
+        Method getThreadPoolExecutor = findMethod(bean.getClass(), "getThreadPoolExecutor");
+        if (getThreadPoolExecutor != null) {
+            return getThreadPoolExecutor.invoke(bean);
+        }
+        return bean;
+    }
+
+    static int getMaxPoolSize(Executor executor) {
+        if (executor instanceof ForkJoinPool) {
+            return ((ForkJoinPool) executor).getParallelism();
+        }
+        if (executor instanceof ThreadPoolExecutor) {
+            ThreadPoolExecutor threadPoolExecutor = (ThreadPoolExecutor) executor;
+            // with an unbounded queue the pool never grows beyond its core size, except for Tomcat's TaskQueue
+            if (threadPoolExecutor.getQueue().remainingCapacity() == Integer.MAX_VALUE
+                    && !threadPoolExecutor.getQueue().getClass().getName().equals("org.apache.tomcat.util.threads.TaskQueue")) {
+                return threadPoolExecutor.getCorePoolSize();
+            }
+            return threadPoolExecutor.getMaximumPoolSize();
+        }
+        for (String name : MAX_POOL_SIZE_METHODS) {
+            Method method = findMethod(executor.getClass(), name);
+            if (method != null) {
+                try {
+                    return ((Number) method.invoke(executor)).intValue();
+                } catch (Exception e) {
+                    log.debug("Could not invoke " + name + " on " + executor.getClass().getName() + ": " + e.getMessage());
+                }
+            }
+        }
+        return -1;
+    }
+
+    private static Object invoke(Object target, String name) throws Exception {
+        Method method = findMethod(target.getClass(), name);
+        if (method == null) {
+            throw new NoSuchMethodException(target.getClass().getName() + "." + name);
+        }
+        return method.invoke(target);
+    }
+
+    private static Method findMethod(Class<?> clazz, String name) {
+        try {
+            Method method = clazz.getMethod(name);
+            method.setAccessible(true);
+            return method;
+        } catch (NoSuchMethodException e) {
+            return null;
+        }
+    }
+
+    private static Object readField(Class<?> clazz, Object target, String name) throws Exception {
+        for (Class<?> c = clazz; c != null; c = c.getSuperclass()) {
+            try {
+                Field field = c.getDeclaredField(name);
+                field.setAccessible(true);
+                return field.get(target);
+            } catch (NoSuchFieldException e) {
+                //try superclass
+            }
+        }
+        throw new NoSuchFieldException(clazz.getName() + "." + name);
+    }
+}
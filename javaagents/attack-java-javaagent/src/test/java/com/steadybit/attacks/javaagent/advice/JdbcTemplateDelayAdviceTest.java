@@ -4,6 +4,10 @@
 
 package com.steadybit.attacks.javaagent.advice;
 
+import com.steadybit.attacks.javaagent.instrumentation.SpringJdbcTemplateDelayInstrumentation;
+import com.steadybit.javaagent.instrumentation.InstrumentationPluginDispatcher;
+import org.json.JSONObject;
+import org.junit.jupiter.api.AfterEach;
 import org.junit.jupiter.api.Test;
 import org.junit.jupiter.api.extension.ExtendWith;
 import org.mockito.InjectMocks;
@@ -33,14 +37,30 @@ class JdbcTemplateDelayAdviceTest {
     @InjectMocks
     private JdbcTemplate jdbcTemplate;
 
+    private SpringJdbcTemplateDelayInstrumentation plugin;
+
+    @AfterEach
+    void tearDown() {
+        if (this.plugin != null) {
+            InstrumentationPluginDispatcher.deregister(this.plugin);
+        }
+    }
+
+    private int register(long delay, boolean delayJitter) {
+        this.plugin = new SpringJdbcTemplateDelayInstrumentation(null, new JSONObject().put("delay", delay).put("delayJitter", delayJitter));
+        InstrumentationPluginDispatcher.register(this.plugin);
+        return this.plugin.getRegistration();
+    }
+
     @Test
     void should_delay_200ms() {
         long delay = 200;
         boolean delayJitter = false;
         String jdbcUrl = "*";
 
+        int registration = this.register(delay, delayJitter);
         long startTime = System.currentTimeMillis();
-        JdbcTemplateDelayAdvice.enter(delay, delayJitter, jdbcUrl, this.jdbcTemplate);
+        JdbcTemplateDelayAdvice.enter(registration, jdbcUrl, this.jdbcTemplate);
 
         long totalTime = System.currentTimeMillis() - startTime;
         assertThat(totalTime).isGreaterThanOrEqualTo(200);
@@ -52,8 +72,9 @@ class JdbcTemplateDelayAdviceTest {
         boolean delayJitter = true;
         String jdbcUrl = "*";
 
+        int registration = this.register(delay, delayJitter);
         long startTime = System.currentTimeMillis();
-        JdbcTemplateDelayAdvice.enter(delay, delayJitter, jdbcUrl, this.jdbcTemplate);
+        JdbcTemplateDelayAdvice.enter(registration, jdbcUrl, this.jdbcTemplate);
 
         long totalTime = System.currentTimeMillis() - startTime;
         assertThat(totalTime).isBetween(140L, 260L);
@@ -69,8 +90,9 @@ class JdbcTemplateDelayAdviceTest {
         when(this.connection.getMetaData()).thenReturn(this.databaseMetaData);
         when(this.dataSource.getConnection()).thenReturn(this.connection);
 
+        int registration = this.register(delay, delayJitter);
         long startTime = System.currentTimeMillis();
-        JdbcTemplateDelayAdvice.enter(delay, delayJitter, jdbcUrl, this.jdbcTemplate);
+        JdbcTemplateDelayAdvice.enter(registration, jdbcUrl, this.jdbcTemplate);
 
         long totalTime = System.currentTimeMillis() - startTime;
         assertThat(totalTime).isGreaterThanOrEqualTo(200);
@@ -86,8 +108,9 @@ class JdbcTemplateDelayAdviceTest {
         when(this.connection.getMetaData()).thenReturn(this.databaseMetaData);
         when(this.dataSource.getConnection()).thenReturn(this.connection);
 
+        int registration = this.register(delay, delayJitter);
         long startTime = System.currentTimeMillis();
-        JdbcTemplateDelayAdvice.enter(delay, delayJitter, jdbcUrl, this.jdbcTemplate);
+        JdbcTemplateDelayAdvice.enter(registration, jdbcUrl, this.jdbcTemplate);
 
         long totalTime = System.currentTimeMillis() - startTime;
         assertThat(totalTime).isLessThanOrEqualTo(30);
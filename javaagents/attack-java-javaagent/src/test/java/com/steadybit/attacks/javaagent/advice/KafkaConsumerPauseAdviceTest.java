@@ -0,0 +1,51 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.advice;
+
+import com.steadybit.attacks.javaagent.instrumentation.KafkaConsumerPauseInstrumentation;
+import com.steadybit.attacks.javaagent.instrumentation.KafkaConsumerPauseInstrumentationTest.TestConsumer;
+import com.steadybit.attacks.javaagent.instrumentation.KafkaConsumerPauseInstrumentationTest.TestPartition;
+import com.steadybit.javaagent.instrumentation.InstrumentationPluginDispatcher;
+import org.json.JSONObject;
+import org.junit.jupiter.api.AfterEach;
+import org.junit.jupiter.api.Test;
+
+import java.lang.instrument.Instrumentation;
+
+import static org.assertj.core.api.Assertions.assertThat;
+import static org.mockito.Mockito.mock;
+
+class KafkaConsumerPauseAdviceTest {
+    private KafkaConsumerPauseInstrumentation plugin;
+
+    @AfterEach
+    void tearDown() {
+        if (this.plugin != null) {
+            InstrumentationPluginDispatcher.deregister(this.plugin);
+        }
+    }
+
+    private int register() {
+        this.plugin = new KafkaConsumerPauseInstrumentation(mock(Instrumentation.class), new JSONObject());
+        InstrumentationPluginDispatcher.register(this.plugin);
+        return this.plugin.getRegistration();
+    }
+
+    @Test
+    void should_resume_partitions_when_reset_while_polling() {
+        TestConsumer consumer = new TestConsumer(new TestPartition("orders"));
+        int registration = register();
+
+        Object paused = KafkaConsumerPauseAdvice.enter(registration, consumer);
+        assertThat(consumer.paused()).hasSize(1);
+
+        this.plugin.reset();
+        KafkaConsumerPauseAdvice.exit(consumer, paused);
+
+        assertThat(consumer.paused()).isEmpty();
+        assertThat(KafkaConsumerPauseAdvice.enter(registration, consumer)).isNull();
+        assertThat(consumer.paused()).isEmpty();
+    }
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.instrumentation;
+
+import org.json.JSONObject;
+import org.junit.jupiter.api.Test;
+
+import java.util.Arrays;
+import java.util.stream.LongStream;
+
+import static org.assertj.core.api.Assertions.assertThat;
+import static org.assertj.core.data.Offset.offset;
+
+class DelayDistributionTest {
+
+    @Test
+    void should_use_fixed_delay() {
+        DelayDistribution distribution = DelayDistribution.fromConfig(new JSONObject().put("delay", 200));
+
+        assertThat(samples(distribution)).containsOnly(200L);
+    }
+
+    @Test
+    void should_apply_jitter_to_fixed_delay() {
+        DelayDistribution distribution = DelayDistribution.fromConfig(new JSONObject().put("delay", 200).put("delayJitter", true));
+
+        long[] samples = samples(distribution);
+        assertThat(LongStream.of(samples).min().getAsLong()).isGreaterThanOrEqualTo(140L);
+        assertThat(LongStream.of(samples).max().getAsLong()).isLessThanOrEqualTo(260L);
+    }
+
+    @Test
+    void should_sample_uniform_delay() {
+        DelayDistribution distribution = DelayDistribution.fromConfig(config("UNIFORM", "min", 100, "max", 300));
+
+        long[] samples = samples(distribution);
+        assertThat(LongStream.of(samples).min().getAsLong()).isGreaterThanOrEqualTo(100L);
+        assertThat(LongStream.of(samples).max().getAsLong()).isLessThanOrEqualTo(300L);
+        assertThat(LongStream.of(samples).average().orElse(0)).isCloseTo(200d, offset(10d));
+    }
+
+    @Test
+    void should_sample_normal_delay() {
+        DelayDistribution distribution = DelayDistribution.fromConfig(config("NORMAL", "mean", 500, "stddev", 50));
+
+        long[] samples = samples(distribution);
+        assertThat(LongStream.of(samples).min().getAsLong()).isGreaterThanOrEqualTo(0L);
+        assertThat(LongStream.of(samples).average().orElse(0)).isCloseTo(500d, offset(10d));
+    }
+
+    @Test
+    void should_sample_long_tail_delay() {
+        DelayDistribution distribution = DelayDistribution.fromConfig(config("LONG_TAIL", "p50", 100, "p99", 2000));
+
+        long[] samples = samples(distribution);
+        Arrays.sort(samples);
+        assertThat(samples[samples.length / 2]).isCloseTo(100L, offset(15L));
+        assertThat(samples[samples.length * 99 / 100]).isCloseTo(2000L, offset(600L));
+    }
+
+    @Test
+    void should_start_ramp_at_initial_delay() {
+        DelayDistribution distribution = DelayDistribution.fromConfig(config("RAMP", "from", 100, "to", 5000).put("duration", 60_000));
+
+        assertThat(distribution.next()).isCloseTo(100L, offset(10L));
+    }
+
+    @Test
+    void should_end_ramp_at_target_delay() {
+        DelayDistribution distribution = DelayDistribution.fromConfig(config("RAMP", "from", 100, "to", 5000).put("duration", 0));
+
+        assertThat(distribution.next()).isEqualTo(5000L);
+    }
+
+    private static JSONObject config(String type, String k1, long v1, String k2, long v2) {
+        return new JSONObject().put("delay", v1).put("delayDistribution", new JSONObject().put("type", type).put(k1, v1).put(k2, v2));
+    }
+
+    private static long[] samples(DelayDistribution distribution) {
+        long[] samples = new long[10_000];
+        for (int i = 0; i < samples.length; i++) {
+            samples[i] = distribution.next();
+        }
+        return samples;
+    }
+}
@@ -0,0 +1,34 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.instrumentation;
+
+import org.junit.jupiter.api.Test;
+
+import static org.assertj.core.api.Assertions.assertThat;
+
+class GrpcCallsTest {
+    private static final String PLACE_ORDER = "com.example.OrderService/PlaceOrder";
+
+    @Test
+    void should_match_any_call() {
+        assertThat(GrpcCalls.matches("*", "*", PLACE_ORDER)).isTrue();
+        assertThat(GrpcCalls.matches("", "", PLACE_ORDER)).isTrue();
+    }
+
+    @Test
+    void should_match_service_and_method() {
+        assertThat(GrpcCalls.matches("com.example.OrderService", "*", PLACE_ORDER)).isTrue();
+        assertThat(GrpcCalls.matches("com.example.OrderService", "PlaceOrder", PLACE_ORDER)).isTrue();
+        assertThat(GrpcCalls.matches("*", "PlaceOrder", PLACE_ORDER)).isTrue();
+        assertThat(GrpcCalls.matches("com.example.OrderService", "CancelOrder", PLACE_ORDER)).isFalse();
+        assertThat(GrpcCalls.matches("com.example.PaymentService", "*", PLACE_ORDER)).isFalse();
+    }
+
+    @Test
+    void should_fall_back_to_generic_exception() {
+        assertThat(GrpcCalls.createException(new Object(), "UNAVAILABLE", "injected")).isInstanceOf(IllegalStateException.class)
+                .hasMessage("UNAVAILABLE: injected");
+    }
+}
@@ -0,0 +1,108 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.instrumentation;
+
+import net.bytebuddy.agent.ByteBuddyAgent;
+import okhttp3.OkHttpClient;
+import okhttp3.Request;
+import okhttp3.Response;
+import org.json.JSONArray;
+import org.json.JSONObject;
+import org.junit.jupiter.api.Test;
+
+import java.io.IOException;
+import java.lang.instrument.Instrumentation;
+import java.net.ConnectException;
+import java.net.SocketTimeoutException;
+import java.util.Collections;
+import java.util.concurrent.TimeUnit;
+
+import static org.assertj.core.api.Assertions.assertThat;
+import static org.assertj.core.api.Assertions.assertThatThrownBy;
+
+class JavaHttpClientStatusInstrumentationTest {
+    private static final Instrumentation INSTRUMENTATION = ByteBuddyAgent.install();
+    // nothing is listening on port 1, calls which are not attacked fail to connect
+    private static final String URL = "http://localhost:1/api/customers";
+    private final OkHttpClient client = new OkHttpClient.Builder().readTimeout(100, TimeUnit.MILLISECONDS).build();
+
+    @Test
+    void should_return_status_for_okhttp_call() throws IOException {
+        JavaHttpClientStatusInstrumentation attack = new JavaHttpClientStatusInstrumentation(INSTRUMENTATION,
+                new JSONObject().put("failureCauses", new JSONArray(Collections.singletonList("HTTP_503"))).put("urlPath", "/api/**"));
+
+        attack.install();
+        try (Response response = this.client.newCall(new Request.Builder().url(URL).build()).execute()) {
+            assertThat(response.code()).isEqualTo(503);
+        } finally {
+            attack.reset();
+        }
+
+        assertThatThrownBy(() -> this.client.newCall(new Request.Builder().url(URL).build()).execute()).isInstanceOf(ConnectException.class);
+    }
+
+    @Test
+    void should_throw_timeout_for_okhttp_call() {
+        JavaHttpClientStatusInstrumentation attack = new JavaHttpClientStatusInstrumentation(INSTRUMENTATION,
+                new JSONObject().put("failureCauses", new JSONArray(Collections.singletonList("TIMEOUT"))));
+
+        attack.install();
+        try {
+            assertThatThrownBy(() -> this.client.newCall(new Request.Builder().url(URL).build()).execute()).isInstanceOf(SocketTimeoutException.class);
+        } finally {
+            attack.reset();
+        }
+    }
+
+    @Test
+    void should_not_fail_calls_for_zero_error_rate() {
+        JavaHttpClientStatusInstrumentation attack = new JavaHttpClientStatusInstrumentation(INSTRUMENTATION,
+                new JSONObject().put("failureCauses", new JSONArray(Collections.singletonList("HTTP_503"))).put("erroneousCallRate", 0));
+
+        attack.install();
+        try {
+            for (int i = 0; i < 200; i++) {
+                assertThatThrownBy(() -> this.client.newCall(new Request.Builder().url(URL).build()).execute()).isInstanceOf(ConnectException.class);
+            }
+        } finally {
+            attack.reset();
+        }
+    }
+
+    @Test
+    void should_ignore_other_hosts() {
+        JavaHttpClientStatusInstrumentation attack = new JavaHttpClientStatusInstrumentation(INSTRUMENTATION, new JSONObject().put("hostAddress", "example.com:80"));
+
+        attack.install();
+        try {
+            assertThatThrownBy(() -> this.client.newCall(new Request.Builder().url(URL).build()).execute()).isInstanceOf(ConnectException.class);
+        } finally {
+            attack.reset();
+        }
+    }
+
+    @Test
+    void should_fail_delayed_okhttp_call_exceeding_read_timeout() {
+        JavaHttpClientDelayInstrumentation attack = new JavaHttpClientDelayInstrumentation(INSTRUMENTATION, new JSONObject().put("delay", 500));
+
+        attack.install();
+        long start = System.currentTimeMillis();
+        try {
+            assertThatThrownBy(() -> this.client.newCall(new Request.Builder().url(URL).build()).execute()).isInstanceOf(SocketTimeoutException.class);
+        } finally {
+            attack.reset();
+        }
+        assertThat(System.currentTimeMillis() - start).isBetween(100L, 500L);
+    }
+
+    @Test
+    void should_match_ant_style_paths_without_spring() {
+        assertThat(HttpMatcher.parseAntPattern("/api/**").test("/api")).isTrue();
+        assertThat(HttpMatcher.parseAntPattern("/api/**").test("/API/customers/1")).isTrue();
+        assertThat(HttpMatcher.parseAntPattern("/api/*").test("/api/customers/1")).isFalse();
+        assertThat(HttpMatcher.parseAntPattern("/api/*/orders").test("/api/customers/orders")).isTrue();
+        assertThat(HttpMatcher.parseAntPattern("/api").test("/apis")).isFalse();
+    }
+}
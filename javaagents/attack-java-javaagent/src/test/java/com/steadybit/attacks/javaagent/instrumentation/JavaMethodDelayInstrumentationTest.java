@@ -11,9 +11,15 @@ import org.json.JSONObject;
 import org.junit.jupiter.api.Test;
 import org.slf4j.Logger;
 import org.slf4j.LoggerFactory;
+import reactor.core.publisher.Flux;
+import reactor.core.publisher.Mono;
+import reactor.core.scheduler.NonBlocking;
 
 import java.lang.instrument.Instrumentation;
 import java.util.Collections;
+import java.util.concurrent.CompletableFuture;
+import java.util.concurrent.CompletionStage;
+import java.util.concurrent.atomic.AtomicLong;
 
 import static org.assertj.core.api.Assertions.assertThat;
 import static org.assertj.core.data.Offset.offset;
@@ -100,6 +106,86 @@ class JavaMethodDelayInstrumentationTest {
         assertThat(this.measureTime(TEST_CLASS::run)).isCloseTo(normalTime, offset(10L));
     }
 
+    @Test
+    void should_delay_reactive_method_call_without_blocking() {
+        JSONObject config = new JSONObject().put("methods", new JSONArray(Collections.singletonList(TestClass.class.getName() + "#mono")))
+                .put("delay", "100");
+        JavaMethodDelayInstrumentation attack = new JavaMethodDelayInstrumentation(INSTRUMENTATION, config);
+
+        long normalTime = this.measureTime(() -> TEST_CLASS.mono().block());
+
+        attack.install();
+        assertThat(this.measureTime(TEST_CLASS::mono)).isCloseTo(normalTime, offset(10L));
+        assertThat(this.measureTime(() -> TEST_CLASS.mono().block())).isCloseTo(normalTime + 100L, offset(10L));
+        attack.reset();
+
+        assertThat(this.measureTime(() -> TEST_CLASS.mono().block())).isCloseTo(normalTime, offset(10L));
+    }
+
+    @Test
+    void should_delay_flux_method_call_without_blocking() {
+        JSONObject config = new JSONObject().put("methods", new JSONArray(Collections.singletonList(TestClass.class.getName() + "#flux")))
+                .put("delay", "100");
+        JavaMethodDelayInstrumentation attack = new JavaMethodDelayInstrumentation(INSTRUMENTATION, config);
+
+        long normalTime = this.measureTime(() -> TEST_CLASS.flux().blockLast());
+
+        attack.install();
+        assertThat(this.measureTime(TEST_CLASS::flux)).isCloseTo(normalTime, offset(10L));
+        assertThat(this.measureTime(() -> TEST_CLASS.flux().blockLast())).isCloseTo(normalTime + 100L, offset(10L));
+        attack.reset();
+    }
+
+    @Test
+    void should_delay_completion_stage_method_call_without_blocking() {
+        JSONObject config = new JSONObject().put("methods", new JSONArray(Collections.singletonList(TestClass.class.getName() + "#completionStage")))
+                .put("delay", "100");
+        JavaMethodDelayInstrumentation attack = new JavaMethodDelayInstrumentation(INSTRUMENTATION, config);
+
+        long normalTime = this.measureTime(() -> TEST_CLASS.completionStage().toCompletableFuture().join());
+
+        attack.install();
+        assertThat(this.measureTime(TEST_CLASS::completionStage)).isCloseTo(normalTime, offset(10L));
+        assertThat(this.measureTime(() -> TEST_CLASS.completionStage().toCompletableFuture().join())).isCloseTo(normalTime + 100L, offset(10L));
+        attack.reset();
+
+        assertThat(this.measureTime(() -> TEST_CLASS.completionStage().toCompletableFuture().join())).isCloseTo(normalTime, offset(10L));
+    }
+
+    @Test
+    void should_not_block_event_loop_threads_if_configured() throws InterruptedException {
+        JSONObject config = new JSONObject().put("methods", new JSONArray(Collections.singletonList(TestClass.class.getName() + "#run"))).put("delay", "100")
+                .put("skipOnEventLoop", true);
+        JavaMethodDelayInstrumentation attack = new JavaMethodDelayInstrumentation(INSTRUMENTATION, config);
+
+        long normalTime = this.measureTime(TEST_CLASS::run);
+
+        attack.install();
+        assertThat(this.measureTimeOnEventLoop(TEST_CLASS::run)).isCloseTo(normalTime, offset(10L));
+        assertThat(this.measureTime(TEST_CLASS::run)).isCloseTo(normalTime + 100L, offset(10L));
+        attack.reset();
+    }
+
+    @Test
+    void should_delay_event_loop_threads_by_default() throws InterruptedException {
+        JSONObject config = new JSONObject().put("methods", new JSONArray(Collections.singletonList(TestClass.class.getName() + "#run"))).put("delay", "100");
+        JavaMethodDelayInstrumentation attack = new JavaMethodDelayInstrumentation(INSTRUMENTATION, config);
+
+        long normalTime = this.measureTime(TEST_CLASS::run);
+
+        attack.install();
+        assertThat(this.measureTimeOnEventLoop(TEST_CLASS::run)).isCloseTo(normalTime + 100L, offset(10L));
+        attack.reset();
+    }
+
+    private long measureTimeOnEventLoop(Runnable r) throws InterruptedException {
+        AtomicLong time = new AtomicLong();
+        Thread eventLoop = new EventLoopThread(() -> time.set(this.measureTime(r)));
+        eventLoop.start();
+        eventLoop.join();
+        return time.get();
+    }
+
     private long measureTime(Runnable r) {
         int invocations = 5;
         long start = System.currentTimeMillis();
@@ -118,9 +204,31 @@ class JavaMethodDelayInstrumentationTest {
             log.info("overloaded()");
         }
 
+        private Mono<String> mono() {
+            return Mono.fromCallable(() -> {
+                log.info("mono()");
+                return "mono";
+            });
+        }
+
+        private Flux<String> flux() {
+            return Flux.just("a", "b").doOnNext(s -> log.info("flux()"));
+        }
+
+        private CompletionStage<String> completionStage() {
+            log.info("completionStage()");
+            return CompletableFuture.completedFuture("stage");
+        }
+
         @SuppressWarnings({"unused", "SameParameterValue"})
         private void overloaded(Object o) {
             log.info("overloaded(Object)");
         }
     }
-}
\ No newline at end of file
+
+    private static class EventLoopThread extends Thread implements NonBlocking {
+        EventLoopThread(Runnable runnable) {
+            super(runnable, "event-loop");
+        }
+    }
+}
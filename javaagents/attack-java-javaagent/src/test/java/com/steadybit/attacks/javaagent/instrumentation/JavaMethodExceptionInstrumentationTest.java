@@ -11,6 +11,7 @@ import org.json.JSONObject;
 import org.junit.jupiter.api.Test;
 
 import java.lang.instrument.Instrumentation;
+import java.net.SocketTimeoutException;
 import java.util.Collections;
 
 import static org.assertj.core.api.Assertions.assertThat;
@@ -48,9 +49,86 @@ class JavaMethodExceptionInstrumentationTest {
         assertThatCode(TEST::run).doesNotThrowAnyException();
     }
 
+    @Test
+    void should_throw_exception_only_for_matching_argument() {
+        JSONObject config = new JSONObject().put("methods", new JSONArray(Collections.singletonList(TestClass.class.getName() + "#greet")))
+                .put("argumentMatcher", new JSONObject().put("index", 0).put("condition", "EQUALS").put("value", "tenant-a"));
+        JavaMethodExceptionInstrumentation attack = new JavaMethodExceptionInstrumentation(INSTRUMENTATION, config);
+
+        Installable.AdviceApplied applied = attack.install();
+        assertThat(applied).isEqualTo(Installable.AdviceApplied.APPLIED);
+        assertThatThrownBy(() -> TEST.greet("tenant-a")).isInstanceOf(RuntimeException.class);
+        assertThatCode(() -> TEST.greet("tenant-b")).doesNotThrowAnyException();
+        attack.reset();
+        assertThatCode(() -> TEST.greet("tenant-a")).doesNotThrowAnyException();
+    }
+
+    @Test
+    void should_throw_exception_for_argument_matching_property_path() {
+        JSONObject config = new JSONObject().put("methods", new JSONArray(Collections.singletonList(TestClass.class.getName() + "#order")))
+                .put("argumentMatcher", new JSONObject().put("index", 0).put("propertyPath", "tenant.id").put("condition", "REGEX").put("value", "tenant-[ab]"));
+        JavaMethodExceptionInstrumentation attack = new JavaMethodExceptionInstrumentation(INSTRUMENTATION, config);
+
+        attack.install();
+        assertThatThrownBy(() -> TEST.order(new Order(new Tenant("tenant-b")))).isInstanceOf(RuntimeException.class);
+        assertThatCode(() -> TEST.order(new Order(new Tenant("tenant-c")))).doesNotThrowAnyException();
+        assertThatCode(() -> TEST.order(new Order(null))).doesNotThrowAnyException();
+        attack.reset();
+    }
+
+    @Test
+    void should_throw_configured_exception() {
+        JSONObject config = new JSONObject().put("methods", new JSONArray(Collections.singletonList(TestClass.class.getName() + "#run")))
+                .put("exceptionClass", "java.net.SocketTimeoutException").put("exceptionMessage", "Read timed out");
+        JavaMethodExceptionInstrumentation attack = new JavaMethodExceptionInstrumentation(INSTRUMENTATION, config);
+
+        attack.install();
+        assertThatThrownBy(TEST::run).isInstanceOf(SocketTimeoutException.class).hasMessage("Read timed out");
+        attack.reset();
+    }
+
+    @Test
+    void should_fall_back_to_runtime_exception() {
+        JSONObject config = new JSONObject().put("methods", new JSONArray(Collections.singletonList(TestClass.class.getName() + "#run")))
+                .put("exceptionClass", "com.example.MissingException").put("exceptionMessage", "boom");
+        JavaMethodExceptionInstrumentation attack = new JavaMethodExceptionInstrumentation(INSTRUMENTATION, config);
+
+        attack.install();
+        assertThatThrownBy(TEST::run).isExactlyInstanceOf(RuntimeException.class).hasMessage("com.example.MissingException: boom");
+        attack.reset();
+    }
+
     public static class TestClass {
         private String run() {
             return "HelloWorld";
         }
+
+        private String greet(String name) {
+            return "Hello " + name;
+        }
+
+        private String order(Order order) {
+            return "Ordered " + order;
+        }
+    }
+
+    public static class Order {
+        private final Tenant tenant;
+
+        Order(Tenant tenant) {
+            this.tenant = tenant;
+        }
+
+        public Tenant getTenant() {
+            return this.tenant;
+        }
+    }
+
+    public static class Tenant {
+        private final String id;
+
+        Tenant(String id) {
+            this.id = id;
+        }
     }
 }
\ No newline at end of file
@@ -0,0 +1,84 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.instrumentation;
+
+import com.steadybit.attacks.javaagent.Installable;
+import net.bytebuddy.agent.ByteBuddyAgent;
+import org.json.JSONArray;
+import org.json.JSONObject;
+import org.junit.jupiter.api.Test;
+
+import java.lang.instrument.Instrumentation;
+import java.lang.management.ManagementFactory;
+import java.util.Arrays;
+import java.util.Collections;
+import java.util.concurrent.TimeUnit;
+
+import static org.assertj.core.api.Assertions.assertThat;
+import static org.awaitility.Awaitility.await;
+
+class JavaMethodLockInstrumentationTest {
+    private static final Instrumentation INSTRUMENTATION = ByteBuddyAgent.install();
+    private static final TestClass TEST_CLASS = new TestClass();
+
+    @Test
+    void should_serialize_concurrent_calls() throws InterruptedException {
+        JSONObject config = new JSONObject().put("methods", new JSONArray(Collections.singletonList(TestClass.class.getName() + "#first")))
+                .put("lockMode", "CONTENTION")
+                .put("holdTime", 100);
+        JavaMethodLockInstrumentation attack = new JavaMethodLockInstrumentation(INSTRUMENTATION, config);
+
+        Installable.AdviceApplied applied = attack.install();
+        assertThat(applied).isEqualTo(Installable.AdviceApplied.APPLIED);
+
+        long start = System.currentTimeMillis();
+        Thread t1 = startThread(TEST_CLASS::first);
+        Thread t2 = startThread(TEST_CLASS::first);
+        t1.join();
+        t2.join();
+        assertThat(System.currentTimeMillis() - start).isGreaterThanOrEqualTo(200L);
+        attack.reset();
+
+        start = System.currentTimeMillis();
+        TEST_CLASS.first();
+        assertThat(System.currentTimeMillis() - start).isLessThan(100L);
+    }
+
+    @Test
+    void should_deadlock_until_reset() {
+        JSONObject config = new JSONObject().put("methods", new JSONArray(Arrays.asList(TestClass.class.getName() + "#first", TestClass.class.getName() + "#second")))
+                .put("lockMode", "DEADLOCK")
+                .put("holdTime", 100);
+        JavaMethodLockInstrumentation attack = new JavaMethodLockInstrumentation(INSTRUMENTATION, config);
+
+        Installable.AdviceApplied applied = attack.install();
+        assertThat(applied).isEqualTo(Installable.AdviceApplied.APPLIED);
+
+        Thread t1 = startThread(TEST_CLASS::first);
+        Thread t2 = startThread(TEST_CLASS::second);
+
+        await().atMost(5, TimeUnit.SECONDS).until(() -> {
+            long[] deadlocked = ManagementFactory.getThreadMXBean().findDeadlockedThreads();
+            return deadlocked != null && Arrays.stream(deadlocked).filter(id -> id == t1.getId() || id == t2.getId()).count() == 2;
+        });
+
+        attack.reset();
+        await().atMost(5, TimeUnit.SECONDS).until(() -> !t1.isAlive() && !t2.isAlive());
+    }
+
+    private static Thread startThread(Runnable runnable) {
+        Thread thread = new Thread(runnable);
+        thread.start();
+        return thread;
+    }
+
+    public static class TestClass {
+        public void first() {
+        }
+
+        public void second() {
+        }
+    }
+}
@@ -0,0 +1,119 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.instrumentation;
+
+import com.steadybit.attacks.javaagent.Installable;
+import com.steadybit.shaded.net.bytebuddy.description.type.TypeDescription;
+import net.bytebuddy.agent.ByteBuddyAgent;
+import org.json.JSONArray;
+import org.json.JSONObject;
+import org.junit.jupiter.api.Test;
+
+import java.lang.instrument.Instrumentation;
+import java.util.Arrays;
+import java.util.Collections;
+import java.util.List;
+import java.util.Map;
+
+import static org.assertj.core.api.Assertions.assertThat;
+import static org.assertj.core.api.Assertions.assertThatThrownBy;
+
+class JavaMethodReturnValueInstrumentationTest {
+    private static final Instrumentation INSTRUMENTATION = ByteBuddyAgent.install();
+    private static final TestClass TEST = new TestClass();
+
+    @Test
+    void should_return_null() {
+        JavaMethodReturnValueInstrumentation attack = new JavaMethodReturnValueInstrumentation(INSTRUMENTATION, config("name", "NULL", ""));
+
+        Installable.AdviceApplied applied = attack.install();
+        assertThat(applied).isEqualTo(Installable.AdviceApplied.APPLIED);
+        assertThat(TEST.name()).isNull();
+        attack.reset();
+        assertThat(TEST.name()).isEqualTo("steadybit");
+    }
+
+    @Test
+    void should_return_empty_list() {
+        JavaMethodReturnValueInstrumentation attack = new JavaMethodReturnValueInstrumentation(INSTRUMENTATION, config("names", "EMPTY", ""));
+
+        attack.install();
+        assertThat(TEST.names()).isEmpty();
+        attack.reset();
+        assertThat(TEST.names()).containsExactly("a", "b");
+    }
+
+    @Test
+    void should_return_number_for_primitive() {
+        JavaMethodReturnValueInstrumentation attack = new JavaMethodReturnValueInstrumentation(INSTRUMENTATION, config("count", "NUMBER", "-1"));
+
+        attack.install();
+        assertThat(TEST.count()).isEqualTo(-1);
+        attack.reset();
+        assertThat(TEST.count()).isEqualTo(42);
+    }
+
+    @Test
+    void should_return_json_as_map() {
+        JavaMethodReturnValueInstrumentation attack = new JavaMethodReturnValueInstrumentation(INSTRUMENTATION,
+                config("properties", "JSON", "{\"enabled\":false}"));
+
+        attack.install();
+        assertThat(TEST.properties()).containsEntry("enabled", false);
+        attack.reset();
+        assertThat(TEST.properties()).containsEntry("enabled", true);
+    }
+
+    @Test
+    void should_fail_for_incompatible_type() {
+        JavaMethodReturnValueInstrumentation attack = new JavaMethodReturnValueInstrumentation(INSTRUMENTATION, config("count", "NULL", ""));
+
+        assertThatThrownBy(attack::install).isInstanceOf(IllegalArgumentException.class).hasMessageContaining("count()");
+        assertThat(TEST.count()).isEqualTo(42);
+    }
+
+    @Test
+    void should_check_compatibility_of_return_type() {
+        assertThat(new JavaMethodReturnValueInstrumentation(INSTRUMENTATION, config("count", "NUMBER", "1")).isCompatible(TypeDescription.ForLoadedType.of(int.class))).isTrue();
+        assertThat(new JavaMethodReturnValueInstrumentation(INSTRUMENTATION, config("count", "NUMBER", "1")).isCompatible(TypeDescription.ForLoadedType.of(String.class))).isFalse();
+        assertThat(new JavaMethodReturnValueInstrumentation(INSTRUMENTATION, config("names", "EMPTY", "")).isCompatible(TypeDescription.ForLoadedType.of(List.class))).isTrue();
+        assertThat(new JavaMethodReturnValueInstrumentation(INSTRUMENTATION, config("name", "BOOLEAN", "true")).isCompatible(TypeDescription.ForLoadedType.of(String.class))).isFalse();
+        assertThat(new JavaMethodReturnValueInstrumentation(INSTRUMENTATION, config("name", "STRING", "chaos")).isCompatible(TypeDescription.ForLoadedType.of(CharSequence.class))).isTrue();
+        assertThat(new JavaMethodReturnValueInstrumentation(INSTRUMENTATION, config("name", "JSON", "{}")).isCompatible(TypeDescription.ForLoadedType.of(void.class))).isFalse();
+    }
+
+    @Test
+    void should_keep_original_value_if_not_selected() {
+        JavaMethodReturnValueInstrumentation attack = new JavaMethodReturnValueInstrumentation(INSTRUMENTATION,
+                config("name", "STRING", "chaos").put("erroneousCallRate", 0));
+
+        attack.install();
+        assertThat(TEST.name()).isEqualTo("steadybit");
+        attack.reset();
+    }
+
+    private static JSONObject config(String method, String type, String value) {
+        return new JSONObject().put("methods", new JSONArray(Collections.singletonList(TestClass.class.getName() + "#" + method)))
+                .put("returnValue", new JSONObject().put("type", type).put("value", value));
+    }
+
+    public static class TestClass {
+        private String name() {
+            return "steadybit";
+        }
+
+        private List<String> names() {
+            return Arrays.asList("a", "b");
+        }
+
+        private int count() {
+            return 42;
+        }
+
+        private Map<String, Object> properties() {
+            return Collections.singletonMap("enabled", true);
+        }
+    }
+}
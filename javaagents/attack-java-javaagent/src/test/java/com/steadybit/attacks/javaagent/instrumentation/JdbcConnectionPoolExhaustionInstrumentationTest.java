@@ -0,0 +1,146 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.instrumentation;
+
+import net.bytebuddy.agent.ByteBuddyAgent;
+import org.json.JSONObject;
+import org.junit.jupiter.api.Test;
+
+import javax.sql.DataSource;
+import java.io.PrintWriter;
+import java.lang.instrument.Instrumentation;
+import java.sql.Connection;
+import java.sql.DatabaseMetaData;
+import java.sql.SQLException;
+import java.util.concurrent.Semaphore;
+import java.util.concurrent.TimeUnit;
+import java.util.logging.Logger;
+
+import static org.assertj.core.api.Assertions.assertThat;
+import static org.awaitility.Awaitility.await;
+import static org.mockito.Mockito.doAnswer;
+import static org.mockito.Mockito.lenient;
+import static org.mockito.Mockito.mock;
+
+class JdbcConnectionPoolExhaustionInstrumentationTest {
+    private static final Instrumentation INSTRUMENTATION = ByteBuddyAgent.install();
+
+    @Test
+    void should_borrow_and_return_connections() throws Exception {
+        TestPool pool = new TestPool("jdbc:test:pool", 4);
+        JdbcConnectionPoolExhaustionInstrumentation attack = new JdbcConnectionPoolExhaustionInstrumentation(INSTRUMENTATION,
+                new JSONObject().put("jdbc-url", "jdbc:test:pool").put("poolUtilization", 50));
+
+        attack.install();
+        try (Connection ignored = pool.getConnection()) {
+            await().atMost(5, TimeUnit.SECONDS).until(() -> attack.getBorrowed() == 2);
+            assertThat(pool.available()).isEqualTo(1);
+        } finally {
+            attack.reset();
+        }
+
+        assertThat(pool.available()).isEqualTo(4);
+    }
+
+    @Test
+    void should_ignore_other_jdbc_urls() throws Exception {
+        TestPool pool = new TestPool("jdbc:test:other", 4);
+        JdbcConnectionPoolExhaustionInstrumentation attack = new JdbcConnectionPoolExhaustionInstrumentation(INSTRUMENTATION,
+                new JSONObject().put("jdbc-url", "jdbc:test:pool").put("poolUtilization", 100));
+
+        attack.install();
+        try (Connection ignored = pool.getConnection()) {
+            assertThat(attack.getBorrowed()).isZero();
+            assertThat(pool.available()).isEqualTo(3);
+        } finally {
+            attack.reset();
+        }
+    }
+
+    @Test
+    void should_read_max_pool_size() {
+        assertThat(JdbcConnectionPoolExhaustionInstrumentation.getMaxPoolSize(new TestPool("jdbc:test", 7))).isEqualTo(7);
+        assertThat(JdbcConnectionPoolExhaustionInstrumentation.getMaxPoolSize(new Object())).isEqualTo(-1);
+    }
+
+    public static class TestPool implements DataSource {
+        private final String url;
+        private final int maxTotal;
+        private final Semaphore permits;
+
+        TestPool(String url, int maxTotal) {
+            this.url = url;
+            this.maxTotal = maxTotal;
+            this.permits = new Semaphore(maxTotal);
+        }
+
+        public int getMaxTotal() {
+            return this.maxTotal;
+        }
+
+        int available() {
+            return this.permits.availablePermits();
+        }
+
+        @Override
+        public Connection getConnection() throws SQLException {
+            try {
+                if (!this.permits.tryAcquire(100, TimeUnit.MILLISECONDS)) {
+                    throw new SQLException("Connection is not available, request timed out");
+                }
+            } catch (InterruptedException e) {
+                throw new SQLException("Interrupted", e);
+            }
+
+            Connection connection = mock(Connection.class);
+            DatabaseMetaData metaData = mock(DatabaseMetaData.class);
+            lenient().when(metaData.getURL()).thenReturn(this.url);
+            lenient().when(connection.getMetaData()).thenReturn(metaData);
+            doAnswer(invocation -> {
+                this.permits.release();
+                return null;
+            }).when(connection).close();
+            return connection;
+        }
+
+        @Override
+        public Connection getConnection(String username, String password) throws SQLException {
+            return this.getConnection();
+        }
+
+        @Override
+        public PrintWriter getLogWriter() {
+            return null;
+        }
+
+        @Override
+        public void setLogWriter(PrintWriter out) {
+        }
+
+        @Override
+        public void setLoginTimeout(int seconds) {
+        }
+
+        @Override
+        public int getLoginTimeout() {
+            return 0;
+        }
+
+        @Override
+        public Logger getParentLogger() {
+            return null;
+        }
+
+        @Override
+        public <T> T unwrap(Class<T> iface) {
+            return null;
+        }
+
+        @Override
+        public boolean isWrapperFor(Class<?> iface) {
+            return false;
+        }
+    }
+}
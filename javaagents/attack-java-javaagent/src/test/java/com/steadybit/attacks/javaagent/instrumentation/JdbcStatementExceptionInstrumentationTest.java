@@ -0,0 +1,88 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.instrumentation;
+
+import org.json.JSONObject;
+import org.junit.jupiter.api.Test;
+import org.junit.jupiter.api.extension.ExtendWith;
+import org.mockito.Mock;
+import org.mockito.junit.jupiter.MockitoExtension;
+
+import java.sql.Connection;
+import java.sql.DatabaseMetaData;
+import java.sql.SQLException;
+import java.sql.Statement;
+
+import static org.assertj.core.api.Assertions.assertThat;
+import static org.mockito.Mockito.lenient;
+
+@ExtendWith(MockitoExtension.class)
+class JdbcStatementExceptionInstrumentationTest {
+    private static final Object[] NO_ARGS = new Object[0];
+
+    @Mock
+    private Statement statement;
+
+    @Mock
+    private Connection connection;
+
+    @Mock
+    private DatabaseMetaData databaseMetaData;
+
+    @Test
+    void should_attack_outermost_statement_only() {
+        JdbcStatementExceptionInstrumentation plugin = new JdbcStatementExceptionInstrumentation(null, new JSONObject());
+
+        assertThat(plugin.exec(8, this.statement, "executeQuery", NO_ARGS)).isEqualTo(true);
+        assertThat(plugin.exec(8, this.statement, "executeQuery", NO_ARGS)).isEqualTo(false);
+        plugin.exec(9);
+        assertThat(plugin.exec(8, this.statement, "executeQuery", NO_ARGS)).isEqualTo(true);
+    }
+
+    @Test
+    void should_match_operations() {
+        JdbcStatementExceptionInstrumentation reads = new JdbcStatementExceptionInstrumentation(null, new JSONObject().put("operations", "r"));
+        JdbcStatementExceptionInstrumentation writes = new JdbcStatementExceptionInstrumentation(null, new JSONObject().put("operations", "w"));
+
+        assertThat(this.matches(reads, "executeQuery")).isTrue();
+        assertThat(this.matches(reads, "execute", " SELECT * FROM customers")).isTrue();
+        assertThat(this.matches(reads, "executeUpdate")).isFalse();
+        assertThat(this.matches(reads, "execute")).isFalse();
+
+        assertThat(this.matches(writes, "executeUpdate")).isTrue();
+        assertThat(this.matches(writes, "executeBatch")).isTrue();
+        assertThat(this.matches(writes, "execute", "INSERT INTO customers VALUES (1)")).isTrue();
+        assertThat(this.matches(writes, "executeQuery")).isFalse();
+    }
+
+    @Test
+    void should_match_jdbc_url() throws SQLException {
+        lenient().when(this.statement.getConnection()).thenReturn(this.connection);
+        lenient().when(this.connection.getMetaData()).thenReturn(this.databaseMetaData);
+        lenient().when(this.databaseMetaData.getURL()).thenReturn("jdbc:postgresql://localhost/test?user=fred&password=secret");
+
+        JdbcStatementExceptionInstrumentation hidden = new JdbcStatementExceptionInstrumentation(null,
+                new JSONObject().put("jdbc-url", "jdbc:postgresql://localhost/test?user=fred&password=***"));
+        JdbcStatementExceptionInstrumentation other = new JdbcStatementExceptionInstrumentation(null, new JSONObject().put("jdbc-url", "jdbc:mysql://localhost/test"));
+
+        assertThat(this.matches(hidden, "executeQuery")).isTrue();
+        assertThat(this.matches(other, "executeQuery")).isFalse();
+    }
+
+    @Test
+    void should_create_sql_exception() {
+        JdbcStatementExceptionInstrumentation always = new JdbcStatementExceptionInstrumentation(null, new JSONObject().put("erroneousCallRate", 100));
+        JdbcStatementExceptionInstrumentation never = new JdbcStatementExceptionInstrumentation(null, new JSONObject().put("erroneousCallRate", 0));
+
+        assertThat(always.exec(5)).isInstanceOf(SQLException.class);
+        assertThat(never.exec(5)).isNull();
+    }
+
+    private boolean matches(AbstractJdbcStatementInstrumentation plugin, String method, Object... arguments) {
+        boolean matches = Boolean.TRUE.equals(plugin.exec(8, this.statement, method, arguments));
+        plugin.exec(9);
+        return matches;
+    }
+}
@@ -0,0 +1,93 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.instrumentation;
+
+import org.json.JSONObject;
+import org.junit.jupiter.api.Test;
+
+import java.lang.instrument.Instrumentation;
+import java.util.Arrays;
+import java.util.Collection;
+import java.util.HashSet;
+import java.util.Set;
+
+import static org.assertj.core.api.Assertions.assertThat;
+import static org.mockito.Mockito.mock;
+
+public class KafkaConsumerPauseInstrumentationTest {
+    private static final TestPartition ORDERS = new TestPartition("orders");
+    private static final TestPartition PAYMENTS = new TestPartition("payments");
+
+    @Test
+    void should_pause_and_resume_matching_partitions() throws Exception {
+        TestConsumer consumer = new TestConsumer(ORDERS, PAYMENTS);
+        KafkaConsumerPauseInstrumentation attack = new KafkaConsumerPauseInstrumentation(mock(Instrumentation.class), new JSONObject().put("topic", "orders"));
+
+        Object paused = attack.exec(10, consumer);
+        assertThat(consumer.paused()).containsExactly(ORDERS);
+
+        KafkaClients.resume(consumer, (Collection<?>) paused);
+        assertThat(consumer.paused()).isEmpty();
+    }
+
+    @Test
+    void should_not_resume_partitions_paused_by_the_application() throws Exception {
+        TestConsumer consumer = new TestConsumer(ORDERS, PAYMENTS);
+        consumer.pause(Arrays.asList(PAYMENTS));
+        KafkaConsumerPauseInstrumentation attack = new KafkaConsumerPauseInstrumentation(mock(Instrumentation.class), new JSONObject());
+
+        Object paused = attack.exec(10, consumer);
+        assertThat(consumer.paused()).containsExactlyInAnyOrder(ORDERS, PAYMENTS);
+
+        KafkaClients.resume(consumer, (Collection<?>) paused);
+        assertThat(consumer.paused()).containsExactly(PAYMENTS);
+    }
+
+    @Test
+    void should_ignore_consumers_of_other_topics() {
+        TestConsumer consumer = new TestConsumer(PAYMENTS);
+        KafkaConsumerPauseInstrumentation attack = new KafkaConsumerPauseInstrumentation(mock(Instrumentation.class), new JSONObject().put("topic", "orders"));
+
+        assertThat(attack.exec(10, consumer)).isNull();
+        assertThat(consumer.paused()).isEmpty();
+    }
+
+    public static class TestConsumer {
+        private final Set<Object> assignment;
+        private final Set<Object> paused = new HashSet<>();
+
+        public TestConsumer(Object... assignment) {
+            this.assignment = new HashSet<>(Arrays.asList(assignment));
+        }
+
+        public Set<Object> assignment() {
+            return this.assignment;
+        }
+
+        public Set<Object> paused() {
+            return this.paused;
+        }
+
+        public void pause(Collection<?> partitions) {
+            this.paused.addAll(partitions);
+        }
+
+        public void resume(Collection<?> partitions) {
+            this.paused.removeAll(partitions);
+        }
+    }
+
+    public static class TestPartition {
+        private final String topic;
+
+        public TestPartition(String topic) {
+            this.topic = topic;
+        }
+
+        public String topic() {
+            return this.topic;
+        }
+    }
+}
@@ -0,0 +1,39 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.instrumentation;
+
+import org.junit.jupiter.api.Test;
+
+import static org.assertj.core.api.Assertions.assertThat;
+
+class RedisCommandsTest {
+    @Test
+    void should_match_all_commands() {
+        assertThat(RedisCommands.matches("*", "GET")).isTrue();
+        assertThat(RedisCommands.matches("*", "PING")).isTrue();
+        assertThat(RedisCommands.matches("", "SET")).isTrue();
+    }
+
+    @Test
+    void should_match_reads() {
+        assertThat(RedisCommands.matches("r", "GET")).isTrue();
+        assertThat(RedisCommands.matches("r", "HGETALL")).isTrue();
+        assertThat(RedisCommands.matches("r", "SET")).isFalse();
+        assertThat(RedisCommands.matches("r", "PING")).isFalse();
+    }
+
+    @Test
+    void should_match_writes() {
+        assertThat(RedisCommands.matches("w", "SET")).isTrue();
+        assertThat(RedisCommands.matches("w", "DEL")).isTrue();
+        assertThat(RedisCommands.matches("w", "GET")).isFalse();
+        assertThat(RedisCommands.matches("w", "PING")).isFalse();
+    }
+
+    @Test
+    void should_fall_back_to_generic_exception() {
+        assertThat(RedisCommands.createException(new Object(), "injected")).isInstanceOf(IllegalStateException.class).hasMessage("injected");
+    }
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.instrumentation;
+
+import org.glassfish.jersey.server.ContainerRequest;
+import org.json.JSONObject;
+import org.junit.jupiter.api.AfterEach;
+import org.junit.jupiter.api.Test;
+import org.springframework.mock.web.MockHttpServletRequest;
+import org.springframework.web.context.request.RequestContextHolder;
+import org.springframework.web.context.request.ServletRequestAttributes;
+
+import static org.assertj.core.api.Assertions.assertThat;
+import static org.mockito.Mockito.mock;
+import static org.mockito.Mockito.when;
+
+class RequestHeaderMatcherTest {
+
+    @AfterEach
+    void tearDown() {
+        RequestContextHolder.resetRequestAttributes();
+    }
+
+    @Test
+    void should_match_header_of_current_request() {
+        RequestHeaderMatcher matcher = new RequestHeaderMatcher("X-Chaos", "on|yes");
+
+        bindRequest("on");
+        assertThat(matcher.test()).isTrue();
+        bindRequest("yes");
+        assertThat(matcher.test()).isTrue();
+    }
+
+    @Test
+    void should_not_match_other_header_value() {
+        RequestHeaderMatcher matcher = new RequestHeaderMatcher("X-Chaos", "on");
+
+        bindRequest("off");
+        assertThat(matcher.test()).isFalse();
+        bindRequest("only");
+        assertThat(matcher.test()).isFalse();
+    }
+
+    @Test
+    void should_not_match_missing_header() {
+        RequestHeaderMatcher matcher = new RequestHeaderMatcher("X-Chaos", "");
+
+        bindRequest(null);
+        assertThat(matcher.test()).isFalse();
+        bindRequest("anything");
+        assertThat(matcher.test()).isTrue();
+    }
+
+    @Test
+    void should_not_match_outside_request() {
+        RequestHeaderMatcher matcher = new RequestHeaderMatcher("X-Chaos", "on");
+
+        assertThat(matcher.test()).isFalse();
+    }
+
+    @Test
+    void should_match_header_of_bound_jersey_request() {
+        RequestHeaderMatcher matcher = new RequestHeaderMatcher("X-Chaos", "on");
+        ContainerRequest matching = mock(ContainerRequest.class);
+        when(matching.getHeaderString("X-Chaos")).thenReturn("on");
+        ContainerRequest other = mock(ContainerRequest.class);
+        when(other.getHeaderString("X-Chaos")).thenReturn("off");
+
+        matcher.bind(matching);
+        assertThat(matcher.test()).isTrue();
+        matcher.bind(other);
+        assertThat(matcher.test()).isFalse();
+        matcher.bind(null);
+        assertThat(matcher.test()).isFalse();
+    }
+
+    @Test
+    void should_return_null_without_header_config() {
+        assertThat(RequestHeaderMatcher.fromConfig(new JSONObject())).isNull();
+        assertThat(RequestHeaderMatcher.fromConfig(new JSONObject().put("requestHeader", new JSONObject().put("name", "")))).isNull();
+    }
+
+    private static void bindRequest(String chaosHeader) {
+        MockHttpServletRequest request = new MockHttpServletRequest("GET", "/users");
+        if (chaosHeader != null) {
+            request.addHeader("X-Chaos", chaosHeader);
+        }
+        RequestContextHolder.setRequestAttributes(new ServletRequestAttributes(request));
+    }
+}
@@ -0,0 +1,78 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.instrumentation;
+
+import org.json.JSONArray;
+import org.json.JSONObject;
+import org.junit.jupiter.api.Test;
+import org.springframework.http.HttpHeaders;
+import org.springframework.http.HttpMethod;
+import org.springframework.http.server.reactive.ServerHttpRequest;
+import org.springframework.web.server.ServerWebExchange;
+
+import static org.assertj.core.api.Assertions.assertThat;
+import static org.mockito.Mockito.doReturn;
+import static org.mockito.Mockito.mock;
+
+class RouterFunctionMatcherTest {
+
+    @Test
+    void should_match_pattern_and_method() {
+        RouterFunctionMatcher matcher = RouterFunctionMatcher.fromConfig(config("/users/{id}", "GET"));
+
+        assertThat(matcher.test(exchange("/users/{id}", HttpMethod.GET, null), null)).isTrue();
+        assertThat(matcher.test(exchange("/users/{id}", HttpMethod.POST, null), null)).isFalse();
+        assertThat(matcher.test(exchange("/users", HttpMethod.GET, null), null)).isFalse();
+        assertThat(matcher.test(exchange(null, HttpMethod.GET, null), null)).isFalse();
+    }
+
+    @Test
+    void should_match_any_method() {
+        RouterFunctionMatcher matcher = RouterFunctionMatcher.fromConfig(config("/users", "*"));
+
+        assertThat(matcher.test(exchange("/users", HttpMethod.DELETE, null), null)).isTrue();
+    }
+
+    @Test
+    void should_match_request_header() {
+        RouterFunctionMatcher matcher = RouterFunctionMatcher.fromConfig(config("/users", "GET"));
+        RequestHeaderMatcher header = new RequestHeaderMatcher("X-Chaos", "on");
+
+        assertThat(matcher.test(exchange("/users", HttpMethod.GET, "on"), header)).isTrue();
+        assertThat(matcher.test(exchange("/users", HttpMethod.GET, "off"), header)).isFalse();
+        assertThat(matcher.test(exchange("/users", HttpMethod.GET, null), header)).isFalse();
+    }
+
+    @Test
+    void should_not_match_other_objects() {
+        RouterFunctionMatcher matcher = RouterFunctionMatcher.fromConfig(config("/users", "GET"));
+
+        assertThat(matcher.test("no exchange", null)).isFalse();
+    }
+
+    @Test
+    void should_return_null_without_router_function() {
+        assertThat(RouterFunctionMatcher.fromConfig(new JSONObject())).isNull();
+    }
+
+    private static JSONObject config(String pattern, String method) {
+        return new JSONObject().put("routerFunction", new JSONObject().put("pattern", pattern).put("methods", new JSONArray().put(method)));
+    }
+
+    private static ServerWebExchange exchange(String pattern, HttpMethod method, String chaosHeader) {
+        HttpHeaders headers = new HttpHeaders();
+        if (chaosHeader != null) {
+            headers.add("X-Chaos", chaosHeader);
+        }
+        ServerHttpRequest request = mock(ServerHttpRequest.class);
+        doReturn(method).when(request).getMethod();
+        doReturn(headers).when(request).getHeaders();
+
+        ServerWebExchange exchange = mock(ServerWebExchange.class);
+        doReturn(pattern).when(exchange).getAttribute("org.springframework.web.reactive.HandlerMapping.bestMatchingPattern");
+        doReturn(request).when(exchange).getRequest();
+        return exchange;
+    }
+}
@@ -0,0 +1,29 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.stress;
+
+import org.json.JSONObject;
+import org.junit.jupiter.api.Test;
+
+import java.util.ArrayList;
+import java.util.List;
+
+import static org.assertj.core.api.Assertions.assertThat;
+
+class CpuBurnAttackTest {
+
+    @Test
+    void should_start_and_stop_named_threads() {
+        CpuBurnAttack attack = new CpuBurnAttack(null, new JSONObject().put("threads", 2).put("utilization", 50).put("threadNamePrefix", "chaos"));
+
+        attack.install();
+        List<Thread> workers = new ArrayList<>(attack.getWorkers());
+        assertThat(workers).extracting(Thread::getName).containsExactly("chaos-0", "chaos-1");
+        assertThat(workers).allMatch(Thread::isAlive);
+
+        attack.reset();
+        assertThat(workers).noneMatch(Thread::isAlive);
+    }
+}
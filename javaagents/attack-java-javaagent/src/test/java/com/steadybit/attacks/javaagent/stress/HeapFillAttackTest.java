@@ -0,0 +1,44 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.stress;
+
+import org.json.JSONObject;
+import org.junit.jupiter.api.Test;
+
+import java.time.Duration;
+
+import static org.assertj.core.api.Assertions.assertThat;
+import static org.awaitility.Awaitility.await;
+
+class HeapFillAttackTest {
+
+    @Test
+    void should_retain_memory_until_reset() {
+        HeapFillAttack attack = new HeapFillAttack(null, new JSONObject().put("mode", "FILL").put("bytes", 16L * HeapFillAttack.CHUNK_SIZE));
+
+        attack.install();
+        await().atMost(Duration.ofSeconds(5)).until(() -> attack.getRetainedBytes() == 16L * HeapFillAttack.CHUNK_SIZE);
+        attack.reset();
+
+        assertThat(attack.getRetainedBytes()).isZero();
+    }
+
+    @Test
+    void should_not_retain_memory_for_allocation_rate() throws InterruptedException {
+        HeapFillAttack attack = new HeapFillAttack(null, new JSONObject().put("mode", "ALLOCATION_RATE").put("allocationRate", 10L * HeapFillAttack.CHUNK_SIZE));
+
+        attack.install();
+        Thread.sleep(200);
+        assertThat(attack.getRetainedBytes()).isZero();
+        attack.reset();
+    }
+
+    @Test
+    void should_fill_heap_up_to_percentage() {
+        assertThat(HeapFillAttack.bytesToFill(1000L, 0L, 80)).isEqualTo(800L);
+        assertThat(HeapFillAttack.bytesToFill(1000L, 400L, 80)).isEqualTo(400L);
+        assertThat(HeapFillAttack.bytesToFill(1000L, 900L, 80)).isZero();
+    }
+}
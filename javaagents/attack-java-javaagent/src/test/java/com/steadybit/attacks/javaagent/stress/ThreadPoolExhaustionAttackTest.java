@@ -0,0 +1,53 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.attacks.javaagent.stress;
+
+import net.bytebuddy.agent.ByteBuddyAgent;
+import org.json.JSONObject;
+import org.junit.jupiter.api.Test;
+
+import java.util.concurrent.ForkJoinPool;
+import java.util.concurrent.LinkedBlockingQueue;
+import java.util.concurrent.ThreadPoolExecutor;
+import java.util.concurrent.TimeUnit;
+
+import static org.assertj.core.api.Assertions.assertThat;
+import static org.assertj.core.api.Assertions.assertThatThrownBy;
+import static org.awaitility.Awaitility.await;
+
+class ThreadPoolExhaustionAttackTest {
+
+    @Test
+    void should_occupy_and_release_common_pool() {
+        int parallelism = ForkJoinPool.commonPool().getParallelism();
+        ThreadPoolExhaustionAttack attack = new ThreadPoolExhaustionAttack(ByteBuddyAgent.install(),
+                new JSONObject().put("pool", ThreadPoolExhaustionAttack.COMMON_POOL).put("poolUtilization", 100));
+
+        assertThat(attack.install()).isEqualTo(ThreadPoolExhaustionAttack.AdviceApplied.APPLIED);
+        await().atMost(5, TimeUnit.SECONDS).until(() -> attack.getOccupied() == parallelism);
+
+        attack.reset();
+        await().atMost(5, TimeUnit.SECONDS).until(() -> attack.getOccupied() == 0);
+    }
+
+    @Test
+    void should_fail_without_spring_context() {
+        ThreadPoolExhaustionAttack attack = new ThreadPoolExhaustionAttack(ByteBuddyAgent.install(), new JSONObject().put("pool", "taskExecutor"));
+
+        assertThatThrownBy(attack::install).isInstanceOf(IllegalStateException.class).hasMessageContaining("taskExecutor");
+    }
+
+    @Test
+    void should_use_core_pool_size_for_unbounded_queue() {
+        ThreadPoolExecutor unbounded = new ThreadPoolExecutor(2, 10, 1, TimeUnit.SECONDS, new LinkedBlockingQueue<>());
+        ThreadPoolExecutor bounded = new ThreadPoolExecutor(2, 10, 1, TimeUnit.SECONDS, new LinkedBlockingQueue<>(5));
+
+        assertThat(ThreadPoolExhaustionAttack.getMaxPoolSize(unbounded)).isEqualTo(2);
+        assertThat(ThreadPoolExhaustionAttack.getMaxPoolSize(bounded)).isEqualTo(10);
+
+        unbounded.shutdown();
+        bounded.shutdown();
+    }
+}
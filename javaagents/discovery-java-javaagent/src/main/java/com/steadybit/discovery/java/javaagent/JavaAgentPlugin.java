@@ -5,13 +5,21 @@
 package com.steadybit.discovery.java.javaagent;
 
 import com.steadybit.discovery.java.javaagent.handlers.DataSourceCommandHandler;
+import com.steadybit.discovery.java.javaagent.handlers.GrpcCommandHandler;
+import com.steadybit.discovery.java.javaagent.handlers.HttpClientCommandHandler;
+import com.steadybit.discovery.java.javaagent.handlers.KafkaCommandHandler;
+import com.steadybit.discovery.java.javaagent.handlers.RedisCommandHandler;
 import com.steadybit.discovery.java.javaagent.handlers.datasource.DataSourceScanner;
+import com.steadybit.discovery.java.javaagent.handlers.grpc.GrpcServiceScanner;
+import com.steadybit.discovery.java.javaagent.handlers.httpclient.HttpClientRequestScanner;
+import com.steadybit.discovery.java.javaagent.handlers.kafka.KafkaClientScanner;
+import com.steadybit.discovery.java.javaagent.handlers.redis.RedisClientScanner;
 import com.steadybit.javaagent.AgentPlugin;
 import com.steadybit.javaagent.CommandHandler;
 
 import java.io.OutputStream;
 import java.lang.instrument.Instrumentation;
-import java.util.Collections;
+import java.util.Arrays;
 import java.util.List;
 
 /**
@@ -20,20 +28,40 @@ import java.util.List;
 public class JavaAgentPlugin implements AgentPlugin, CommandHandler {
     private final List<CommandHandler> commandHandlers;
     private final DataSourceScanner dataSourceScanner;
+    private final HttpClientRequestScanner httpClientRequestScanner;
+    private final KafkaClientScanner kafkaClientScanner;
+    private final RedisClientScanner redisClientScanner;
+    private final GrpcServiceScanner grpcServiceScanner;
 
     public JavaAgentPlugin(Instrumentation instrumentation) {
         this.dataSourceScanner = new DataSourceScanner(instrumentation);
-        this.commandHandlers = Collections.singletonList(new DataSourceCommandHandler(this.dataSourceScanner::getDataSourceConnections));
+        this.httpClientRequestScanner = new HttpClientRequestScanner(instrumentation);
+        this.kafkaClientScanner = new KafkaClientScanner(instrumentation);
+        this.redisClientScanner = new RedisClientScanner(instrumentation);
+        this.grpcServiceScanner = new GrpcServiceScanner(instrumentation);
+        this.commandHandlers = Arrays.asList(new DataSourceCommandHandler(this.dataSourceScanner::getDataSourceConnections),
+                new HttpClientCommandHandler(this.httpClientRequestScanner::getAddresses),
+                new KafkaCommandHandler(this.kafkaClientScanner::getBootstrapServers, this.kafkaClientScanner::getTopics),
+                new RedisCommandHandler(this.redisClientScanner::getUris),
+                new GrpcCommandHandler(this.grpcServiceScanner::getServices, this.grpcServiceScanner::getClientServices));
     }
 
     @Override
     public void start() {
         this.dataSourceScanner.install();
+        this.httpClientRequestScanner.install();
+        this.kafkaClientScanner.install();
+        this.redisClientScanner.install();
+        this.grpcServiceScanner.install();
     }
 
     @Override
     public void destroy() {
         this.dataSourceScanner.reset();
+        this.httpClientRequestScanner.reset();
+        this.kafkaClientScanner.reset();
+        this.redisClientScanner.reset();
+        this.grpcServiceScanner.reset();
     }
 
     @Override
@@ -49,6 +49,15 @@ public class DataSourceCommandHandler implements CommandHandler {
         JSONObject json = new JSONObject();
         json.put("jdbcUrl", dataSourceConnection.getJdbcUrl());
         json.put("databaseType", dataSourceConnection.getDatabaseType());
+        if (dataSourceConnection.getMaxPoolSize() != null) {
+            json.put("maxPoolSize", dataSourceConnection.getMaxPoolSize());
+        }
+        if (dataSourceConnection.getActiveConnections() != null) {
+            json.put("activeConnections", dataSourceConnection.getActiveConnections());
+        }
+        if (dataSourceConnection.getPoolImplementation() != null) {
+            json.put("poolImplementation", dataSourceConnection.getPoolImplementation());
+        }
         jdbcData.put(json);
     }
 }
@@ -0,0 +1,44 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.java.javaagent.handlers;
+
+import com.steadybit.javaagent.CommandHandler;
+import org.json.JSONArray;
+import org.json.JSONObject;
+
+import java.io.OutputStream;
+import java.io.OutputStreamWriter;
+import java.io.PrintWriter;
+import java.nio.charset.StandardCharsets;
+import java.util.Collection;
+import java.util.function.Supplier;
+
+public class GrpcCommandHandler implements CommandHandler {
+    private static final char BYTE_ORDER_MARK = '\ufeff';
+    private final Supplier<Collection<String>> servicesProvider;
+    private final Supplier<Collection<String>> clientServicesProvider;
+
+    public GrpcCommandHandler(Supplier<Collection<String>> servicesProvider, Supplier<Collection<String>> clientServicesProvider) {
+        this.servicesProvider = servicesProvider;
+        this.clientServicesProvider = clientServicesProvider;
+    }
+
+    @Override
+    public boolean canHandle(String command) {
+        return command.equals("java-grpc-services");
+    }
+
+    @Override
+    public void handle(String command, String argument, OutputStream os) {
+        JSONObject json = new JSONObject();
+        json.put("services", new JSONArray(this.servicesProvider.get()));
+        json.put("clientServices", new JSONArray(this.clientServicesProvider.get()));
+        PrintWriter writer = new PrintWriter(new OutputStreamWriter(os, StandardCharsets.UTF_8));
+        writer.write(RC_OK);
+        writer.write(BYTE_ORDER_MARK);
+        json.write(writer);
+        writer.flush();
+    }
+}
@@ -0,0 +1,44 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.java.javaagent.handlers;
+
+import com.steadybit.javaagent.CommandHandler;
+import org.json.JSONArray;
+import org.json.JSONObject;
+
+import java.io.OutputStream;
+import java.io.OutputStreamWriter;
+import java.io.PrintWriter;
+import java.nio.charset.StandardCharsets;
+import java.util.Collection;
+import java.util.function.Supplier;
+
+public class KafkaCommandHandler implements CommandHandler {
+    private static final char BYTE_ORDER_MARK = '\ufeff';
+    private final Supplier<Collection<String>> bootstrapServersProvider;
+    private final Supplier<Collection<String>> topicsProvider;
+
+    public KafkaCommandHandler(Supplier<Collection<String>> bootstrapServersProvider, Supplier<Collection<String>> topicsProvider) {
+        this.bootstrapServersProvider = bootstrapServersProvider;
+        this.topicsProvider = topicsProvider;
+    }
+
+    @Override
+    public boolean canHandle(String command) {
+        return command.equals("java-kafka-clients");
+    }
+
+    @Override
+    public void handle(String command, String argument, OutputStream os) {
+        JSONObject json = new JSONObject();
+        json.put("bootstrapServers", new JSONArray(this.bootstrapServersProvider.get()));
+        json.put("topics", new JSONArray(this.topicsProvider.get()));
+        PrintWriter writer = new PrintWriter(new OutputStreamWriter(os, StandardCharsets.UTF_8));
+        writer.write(RC_OK);
+        writer.write(BYTE_ORDER_MARK);
+        json.write(writer);
+        writer.flush();
+    }
+}
@@ -0,0 +1,39 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.java.javaagent.handlers;
+
+import com.steadybit.javaagent.CommandHandler;
+import org.json.JSONArray;
+
+import java.io.OutputStream;
+import java.io.OutputStreamWriter;
+import java.io.PrintWriter;
+import java.nio.charset.StandardCharsets;
+import java.util.Collection;
+import java.util.function.Supplier;
+
+public class RedisCommandHandler implements CommandHandler {
+    private static final char BYTE_ORDER_MARK = '\ufeff';
+    private final Supplier<Collection<String>> uriProvider;
+
+    public RedisCommandHandler(Supplier<Collection<String>> uriProvider) {
+        this.uriProvider = uriProvider;
+    }
+
+    @Override
+    public boolean canHandle(String command) {
+        return command.equals("java-redis-uris");
+    }
+
+    @Override
+    public void handle(String command, String argument, OutputStream os) {
+        JSONArray uris = new JSONArray(this.uriProvider.get());
+        PrintWriter writer = new PrintWriter(new OutputStreamWriter(os, StandardCharsets.UTF_8));
+        writer.write(RC_OK);
+        writer.write(BYTE_ORDER_MARK);
+        uris.write(writer);
+        writer.flush();
+    }
+}
@@ -7,10 +7,24 @@ package com.steadybit.discovery.java.javaagent.handlers.datasource;
 public class DataSourceConnection {
     private final String jdbcUrl;
     private final String databaseType;
+    private final Integer maxPoolSize;
+    private final Integer activeConnections;
+    private final String poolImplementation;
 
     public DataSourceConnection(String jdbcUrl, String databaseType) {
+        this(jdbcUrl, databaseType, null, null);
+    }
+
+    public DataSourceConnection(String jdbcUrl, String databaseType, Integer maxPoolSize, Integer activeConnections) {
+        this(jdbcUrl, databaseType, maxPoolSize, activeConnections, null);
+    }
+
+    public DataSourceConnection(String jdbcUrl, String databaseType, Integer maxPoolSize, Integer activeConnections, String poolImplementation) {
         this.jdbcUrl = jdbcUrl;
         this.databaseType = databaseType;
+        this.maxPoolSize = maxPoolSize;
+        this.activeConnections = activeConnections;
+        this.poolImplementation = poolImplementation;
     }
 
     public String getJdbcUrl() {
@@ -20,4 +34,16 @@ public class DataSourceConnection {
     public String getDatabaseType() {
         return this.databaseType;
     }
+
+    public Integer getMaxPoolSize() {
+        return this.maxPoolSize;
+    }
+
+    public Integer getActiveConnections() {
+        return this.activeConnections;
+    }
+
+    public String getPoolImplementation() {
+        return this.poolImplementation;
+    }
 }
@@ -44,8 +44,12 @@ public class DataSourceConnections {
         this.connections.expungeStaleEntries();
         List<DataSourceConnection> result = new ArrayList<>();
         this.connections.iterator().forEachRemaining(dataSourceConnectionEntry -> {
-            if (dataSourceConnectionEntry.getValue() != null)
-                result.add(dataSourceConnectionEntry.getValue());
+            DataSourceConnection connection = dataSourceConnectionEntry.getValue();
+            if (connection != null) {
+                DataSource dataSource = dataSourceConnectionEntry.getKey();
+                result.add(new DataSourceConnection(connection.getJdbcUrl(), connection.getDatabaseType(), DataSourcePools.getMaxPoolSize(dataSource),
+                        DataSourcePools.getActiveConnections(dataSource), DataSourcePools.getPoolImplementation(dataSource)));
+            }
         });
         return result;
     }
@@ -0,0 +1,71 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.java.javaagent.handlers.datasource;
+
+import java.lang.reflect.Method;
+
+/**
+ * Reads the implementation and size of connection pools (HikariCP, Tomcat JDBC, DBCP2) reflectively, as their classes
+ * are not visible to the agent.
+ */
+final class DataSourcePools {
+    private static final String[] MAX_POOL_SIZE_METHODS = { "getMaximumPoolSize", "getMaxActive", "getMaxTotal" };
+    private static final String[] ACTIVE_CONNECTIONS_METHODS = { "getNumActive", "getActive" };
+    private static final String[][] POOL_IMPLEMENTATIONS = {
+            { "com.zaxxer.hikari.", "HikariCP" },
+            { "org.apache.tomcat.jdbc.pool.", "Tomcat JDBC" },
+            { "org.apache.commons.dbcp2.", "DBCP2" },
+            { "com.mchange.v2.c3p0.", "c3p0" },
+            { "io.agroal.", "Agroal" },
+            { "oracle.ucp.", "Oracle UCP" } };
+
+    private DataSourcePools() {
+    }
+
+    /**
+     * @return the name of a known pool or the class name of the DataSource otherwise.
+     */
+    static String getPoolImplementation(Object dataSource) {
+        String className = dataSource.getClass().getName();
+        for (String[] pool : POOL_IMPLEMENTATIONS) {
+            if (className.startsWith(pool[0])) {
+                return pool[1];
+            }
+        }
+        return className;
+    }
+
+    static Integer getMaxPoolSize(Object dataSource) {
+        return invokeFirst(dataSource, MAX_POOL_SIZE_METHODS);
+    }
+
+    static Integer getActiveConnections(Object dataSource) {
+        Object hikariPool = invoke(dataSource, "getHikariPoolMXBean");
+        if (hikariPool != null) {
+            return invokeFirst(hikariPool, "getActiveConnections");
+        }
+        return invokeFirst(dataSource, ACTIVE_CONNECTIONS_METHODS);
+    }
+
+    private static Integer invokeFirst(Object target, String... names) {
+        for (String name : names) {
+            Object result = invoke(target, name);
+            if (result instanceof Number) {
+                return ((Number) result).intValue();
+            }
+        }
+        return null;
+    }
+
+    private static Object invoke(Object target, String name) {
+        try {
+            Method method = target.getClass().getMethod(name);
+            method.setAccessible(true);
+            return method.invoke(target);
+        } catch (Exception e) {
+            return null;
+        }
+    }
+}
@@ -0,0 +1,17 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.java.javaagent.handlers.grpc;
+
+import com.steadybit.javaagent.instrumentation.InstrumentationPluginDispatcher;
+import com.steadybit.javaagent.instrumentation.Registration;
+import com.steadybit.shaded.net.bytebuddy.asm.Advice;
+
+public class CaptureGrpcServerCallAdvice {
+
+    @Advice.OnMethodEnter(suppress = Throwable.class)
+    static void enter(@Registration int registration, @Advice.This Object transportListener, @Advice.Argument(1) String methodName) {
+        InstrumentationPluginDispatcher.find(registration).exec(1, transportListener, methodName);
+    }
+}
@@ -0,0 +1,127 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.java.javaagent.handlers.grpc;
+
+import com.steadybit.discovery.java.javaagent.handlers.instrumentation.ClassTransformationPlugin;
+import com.steadybit.javaagent.instrumentation.Registration;
+import com.steadybit.javaagent.log.Logger;
+import com.steadybit.javaagent.log.RemoteAgentLogger;
+import com.steadybit.shaded.net.bytebuddy.agent.builder.AgentBuilder;
+import com.steadybit.shaded.net.bytebuddy.asm.Advice;
+import com.steadybit.shaded.net.bytebuddy.description.method.MethodDescription;
+import com.steadybit.shaded.net.bytebuddy.matcher.ElementMatcher;
+
+import java.lang.instrument.Instrumentation;
+import java.lang.reflect.Field;
+import java.util.ArrayList;
+import java.util.Collection;
+import java.util.Collections;
+import java.util.List;
+import java.util.Set;
+import java.util.WeakHashMap;
+import java.util.concurrent.ConcurrentHashMap;
+
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.named;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.takesArguments;
+
+/**
+ * Records the gRPC services registered on the servers and the services called by the clients of grpc-java.
+ * <p>
+ * The plugin is loaded after the servers have been started, so the registered services are read from the server when
+ * it receives its first call. Client calls are recorded when they are started.
+ */
+public class GrpcServiceScanner extends ClassTransformationPlugin {
+    private static final Logger log = RemoteAgentLogger.getLogger(GrpcServiceScanner.class);
+    private static final String SERVER = "io.grpc.internal.ServerImpl";
+    private static final String CLIENT_CALL = "io.grpc.internal.ClientCallImpl";
+    private final Set<String> services = ConcurrentHashMap.newKeySet();
+    private final Set<String> clientServices = ConcurrentHashMap.newKeySet();
+    private final Set<Object> servers = Collections.synchronizedSet(Collections.newSetFromMap(new WeakHashMap<>()));
+    private final ElementMatcher<MethodDescription> streamCreated = named("streamCreated").and(takesArguments(3));
+    private final ElementMatcher<MethodDescription> start = named("start").and(takesArguments(2));
+
+    public GrpcServiceScanner(Instrumentation instrumentation) {
+        super(instrumentation);
+    }
+
+    @Override
+    protected AgentBuilder doInstall(AgentBuilder agentBuilder) {
+        return agentBuilder
+                .type(named(SERVER + "$ServerTransportListenerImpl"))
+                .transform(this.advice(this.streamCreated, CaptureGrpcServerCallAdvice.class))
+                .type(named(CLIENT_CALL))
+                .transform(this.advice(this.start, CaptureGrpcClientCallAdvice.class));
+    }
+
+    private AgentBuilder.Transformer advice(ElementMatcher<? super MethodDescription> method, Class<?> adviceClass) {
+        return new AgentBuilder.Transformer.ForAdvice(Advice.withCustomMapping().bind(Registration.class, this.getRegistration()))
+                .include(adviceClass.getClassLoader())
+                .advice(method, adviceClass.getName());
+    }
+
+    public Collection<String> getServices() {
+        return new ArrayList<>(this.services);
+    }
+
+    public Collection<String> getClientServices() {
+        return new ArrayList<>(this.clientServices);
+    }
+
+    @Override
+    public Object exec(int code, Object owner, Object methodName) {
+        try {
+            if (code == 1) {
+                addService(this.services, (String) methodName);
+                Object server = getServer(owner);
+                if (server != null && this.servers.add(server)) {
+                    for (String service : readRegisteredServices(server)) {
+                        addService(this.services, service);
+                    }
+                }
+            } else if (code == 2) {
+                Field field = owner.getClass().getDeclaredField("method");
+                field.setAccessible(true);
+                Object methodDescriptor = field.get(owner);
+                addService(this.clientServices, (String) methodDescriptor.getClass().getMethod("getFullMethodName").invoke(methodDescriptor));
+            }
+        } catch (ReflectiveOperationException | RuntimeException e) {
+            log.trace("Could not read gRPC call of " + owner.getClass().getName() + ": " + e.getMessage());
+        }
+        return null;
+    }
+
+    /**
+     * @return the enclosing server of the transport listener.
+     */
+    private static Object getServer(Object transportListener) throws IllegalAccessException {
+        for (Field field : transportListener.getClass().getDeclaredFields()) {
+            if (field.getType().getName().equals(SERVER)) {
+                field.setAccessible(true);
+                return field.get(transportListener);
+            }
+        }
+        return null;
+    }
+
+    static List<String> readRegisteredServices(Object server) throws ReflectiveOperationException {
+        List<String> names = new ArrayList<>();
+        for (Object definition : (List<?>) server.getClass().getMethod("getServices").invoke(server)) {
+            Object descriptor = definition.getClass().getMethod("getServiceDescriptor").invoke(definition);
+            names.add((String) descriptor.getClass().getMethod("getName").invoke(descriptor));
+        }
+        return names;
+    }
+
+    /**
+     * @param fullMethodName the full name of a method, e.g. {@code com.example.OrderService/PlaceOrder}, or a service
+     */
+    static void addService(Set<String> services, String fullMethodName) {
+        if (fullMethodName == null || fullMethodName.isEmpty()) {
+            return;
+        }
+        int separator = fullMethodName.lastIndexOf('/');
+        services.add(separator >= 0 ? fullMethodName.substring(0, separator) : fullMethodName);
+    }
+}
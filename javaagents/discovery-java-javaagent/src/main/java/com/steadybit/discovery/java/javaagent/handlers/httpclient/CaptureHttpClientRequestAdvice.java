@@ -0,0 +1,18 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.java.javaagent.handlers.httpclient;
+
+import com.steadybit.javaagent.instrumentation.InstrumentationPluginDispatcher;
+import com.steadybit.javaagent.instrumentation.Registration;
+import com.steadybit.shaded.net.bytebuddy.asm.Advice;
+
+public class CaptureHttpClientRequestAdvice {
+
+    @Advice.OnMethodEnter(suppress = Throwable.class)
+    static void enter(@Registration int registration, @Advice.This Object owner, @Advice.Origin("#m") String method,
+                      @Advice.AllArguments Object[] arguments) {
+        InstrumentationPluginDispatcher.find(registration).exec(0, owner, method, arguments);
+    }
+}
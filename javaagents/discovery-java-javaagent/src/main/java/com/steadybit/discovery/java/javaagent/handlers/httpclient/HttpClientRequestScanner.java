@@ -0,0 +1,122 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.java.javaagent.handlers.httpclient;
+
+import com.steadybit.discovery.java.javaagent.handlers.instrumentation.ClassTransformationPlugin;
+import com.steadybit.javaagent.instrumentation.InstrumentationPluginDispatcher;
+import com.steadybit.javaagent.instrumentation.Registration;
+import com.steadybit.javaagent.log.Logger;
+import com.steadybit.javaagent.log.RemoteAgentLogger;
+import com.steadybit.shaded.net.bytebuddy.agent.builder.AgentBuilder;
+import com.steadybit.shaded.net.bytebuddy.asm.Advice;
+import com.steadybit.shaded.net.bytebuddy.description.method.MethodDescription;
+import com.steadybit.shaded.net.bytebuddy.matcher.ElementMatcher;
+
+import java.lang.instrument.Instrumentation;
+import java.net.URI;
+import java.util.ArrayList;
+import java.util.Collection;
+import java.util.Set;
+import java.util.concurrent.ConcurrentHashMap;
+
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.hasSuperType;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.isAbstract;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.isSynthetic;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.nameStartsWith;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.named;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.namedOneOf;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.not;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.takesArguments;
+
+/**
+ * Records the addresses (host:port) called through the JDK HttpClient, OkHttp and Apache HttpClient 5, like the
+ * Spring Boot discovery does for RestTemplate and WebClient.
+ */
+public class HttpClientRequestScanner extends ClassTransformationPlugin {
+    private static final Logger log = RemoteAgentLogger.getLogger(HttpClientRequestScanner.class);
+    private final Set<String> addresses = ConcurrentHashMap.newKeySet();
+    private final Instrumentation instrumentation;
+    private final ElementMatcher<MethodDescription> sendMethod = namedOneOf("send", "sendAsync").and(not(isAbstract()));
+    private final ElementMatcher<MethodDescription> executeMethod = namedOneOf("execute", "enqueue").and(not(isAbstract()));
+    private final ElementMatcher<MethodDescription> doExecuteMethod = named("doExecute").and(takesArguments(3)).and(not(isAbstract()));
+
+    public HttpClientRequestScanner(Instrumentation instrumentation) {
+        super(instrumentation);
+        this.instrumentation = instrumentation;
+    }
+
+    @Override
+    protected AgentBuilder doInstall(AgentBuilder agentBuilder) {
+        return agentBuilder
+                // the JDK HttpClient is loaded by the platform classloader, which is ignored by default
+                .ignore(isSynthetic().or(nameStartsWith("com.steadybit.shaded.")))
+                .type(hasSuperType(named("java.net.http.HttpClient")).and(not(isAbstract())))
+                .assureReadEdgeTo(this.instrumentation, InstrumentationPluginDispatcher.class)
+                .transform(this.advice(this.sendMethod))
+                .type(hasSuperType(named("okhttp3.Call")).and(not(isAbstract())))
+                .transform(this.advice(this.executeMethod))
+                .type(hasSuperType(named("org.apache.hc.client5.http.impl.classic.CloseableHttpClient")).and(not(isAbstract())))
+                .transform(this.advice(this.doExecuteMethod));
+    }
+
+    private AgentBuilder.Transformer advice(ElementMatcher<MethodDescription> method) {
+        return new AgentBuilder.Transformer.ForAdvice(Advice.withCustomMapping().bind(Registration.class, this.getRegistration()))
+                .include(CaptureHttpClientRequestAdvice.class.getClassLoader())
+                .advice(method, CaptureHttpClientRequestAdvice.class.getName());
+    }
+
+    public Collection<String> getAddresses() {
+        return new ArrayList<>(this.addresses);
+    }
+
+    @Override
+    public Object exec(int code, Object owner, Object method, Object arguments) {
+        if (code == 0) {
+            try {
+                this.add(getUri(owner, (String) method, (Object[]) arguments));
+            } catch (ReflectiveOperationException | RuntimeException e) {
+                log.trace("Could not read request of " + owner.getClass().getName() + ": " + e.getMessage());
+            }
+        }
+        return null;
+    }
+
+    void add(URI uri) {
+        if (uri == null || uri.getHost() == null || uri.getScheme() == null) {
+            return;
+        }
+        int port = uri.getPort();
+        if (port == -1) {
+            port = uri.getScheme().equalsIgnoreCase("https") ? 443 : 80;
+        }
+        this.addresses.add(uri.getHost() + ":" + port);
+    }
+
+    private static URI getUri(Object owner, String method, Object[] arguments) throws ReflectiveOperationException {
+        switch (method) {
+            case "send":
+            case "sendAsync":
+                return (URI) invoke(arguments[0], "java.net.http.HttpRequest", "uri");
+            case "execute":
+            case "enqueue":
+                Object url = invoke(invoke(owner, "okhttp3.Call", "request"), "okhttp3.Request", "url");
+                return (URI) invoke(url, "okhttp3.HttpUrl", "uri");
+            case "doExecute":
+                // the target host is null if the request URI is absolute
+                Object target = arguments[0];
+                return target != null ? URI.create((String) invoke(target, "org.apache.hc.core5.http.HttpHost", "toURI"))
+                        : (URI) invoke(arguments[1], "org.apache.hc.core5.http.HttpRequest", "getUri");
+            default:
+                return null;
+        }
+    }
+
+    private static Object invoke(Object target, String type, String method) throws ReflectiveOperationException {
+        // Resolve the method on the public API type, the implementations might not be accessible (e.g. jdk.internal)
+        ClassLoader classLoader = target.getClass().getClassLoader();
+        Class<?> typeClass = Class.forName(type, false, classLoader != null ? classLoader : ClassLoader.getSystemClassLoader());
+        return typeClass.getMethod(method).invoke(target);
+    }
+}
@@ -0,0 +1,17 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.java.javaagent.handlers.kafka;
+
+import com.steadybit.javaagent.instrumentation.InstrumentationPluginDispatcher;
+import com.steadybit.javaagent.instrumentation.Registration;
+import com.steadybit.shaded.net.bytebuddy.asm.Advice;
+
+public class CaptureKafkaConsumerAdvice {
+
+    @Advice.OnMethodExit(suppress = Throwable.class)
+    static void exit(@Registration int registration, @Advice.This Object consumer) {
+        InstrumentationPluginDispatcher.find(registration).exec(3, consumer, null);
+    }
+}
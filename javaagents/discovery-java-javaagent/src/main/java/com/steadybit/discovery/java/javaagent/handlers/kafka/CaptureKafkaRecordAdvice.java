@@ -0,0 +1,17 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.java.javaagent.handlers.kafka;
+
+import com.steadybit.javaagent.instrumentation.InstrumentationPluginDispatcher;
+import com.steadybit.javaagent.instrumentation.Registration;
+import com.steadybit.shaded.net.bytebuddy.asm.Advice;
+
+public class CaptureKafkaRecordAdvice {
+
+    @Advice.OnMethodEnter(suppress = Throwable.class)
+    static void enter(@Registration int registration, @Advice.This Object producer, @Advice.Argument(0) Object record) {
+        InstrumentationPluginDispatcher.find(registration).exec(2, producer, record);
+    }
+}
@@ -0,0 +1,172 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.java.javaagent.handlers.kafka;
+
+import com.steadybit.discovery.java.javaagent.handlers.instrumentation.ClassTransformationPlugin;
+import com.steadybit.javaagent.instrumentation.Registration;
+import com.steadybit.javaagent.log.Logger;
+import com.steadybit.javaagent.log.RemoteAgentLogger;
+import com.steadybit.shaded.net.bytebuddy.agent.builder.AgentBuilder;
+import com.steadybit.shaded.net.bytebuddy.asm.Advice;
+import com.steadybit.shaded.net.bytebuddy.description.method.MethodDescription;
+import com.steadybit.shaded.net.bytebuddy.matcher.ElementMatcher;
+
+import java.lang.instrument.Instrumentation;
+import java.lang.reflect.Field;
+import java.lang.reflect.Modifier;
+import java.util.ArrayList;
+import java.util.Collection;
+import java.util.Collections;
+import java.util.List;
+import java.util.Set;
+import java.util.WeakHashMap;
+import java.util.concurrent.ConcurrentHashMap;
+
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.named;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.takesArguments;
+
+/**
+ * Records the bootstrap servers and topics of the Kafka producers and consumers.
+ * <p>
+ * The plugin is loaded after the clients have been created, so everything is read when the clients are used: the
+ * topics from the records sent and the subscriptions and assignments of polling consumers, the bootstrap servers from
+ * the config kept by the client. Consumers which don't keep their config report the brokers of their cluster metadata.
+ */
+public class KafkaClientScanner extends ClassTransformationPlugin {
+    private static final Logger log = RemoteAgentLogger.getLogger(KafkaClientScanner.class);
+    private final Set<String> bootstrapServers = ConcurrentHashMap.newKeySet();
+    private final Set<String> topics = ConcurrentHashMap.newKeySet();
+    private final Set<Object> clients = Collections.synchronizedSet(Collections.newSetFromMap(new WeakHashMap<>()));
+    private final ElementMatcher<MethodDescription> sendMethod = named("send").and(takesArguments(2));
+    private final ElementMatcher<MethodDescription> pollMethod = named("poll").and(takesArguments(1));
+
+    public KafkaClientScanner(Instrumentation instrumentation) {
+        super(instrumentation);
+    }
+
+    @Override
+    protected AgentBuilder doInstall(AgentBuilder agentBuilder) {
+        return agentBuilder
+                .type(named("org.apache.kafka.clients.producer.KafkaProducer"))
+                .transform(this.advice(this.sendMethod, CaptureKafkaRecordAdvice.class))
+                .type(named("org.apache.kafka.clients.consumer.KafkaConsumer"))
+                .transform(this.advice(this.pollMethod, CaptureKafkaConsumerAdvice.class));
+    }
+
+    private AgentBuilder.Transformer advice(ElementMatcher<? super MethodDescription> method, Class<?> adviceClass) {
+        return new AgentBuilder.Transformer.ForAdvice(Advice.withCustomMapping().bind(Registration.class, this.getRegistration()))
+                .include(adviceClass.getClassLoader())
+                .advice(method, adviceClass.getName());
+    }
+
+    public Collection<String> getBootstrapServers() {
+        return new ArrayList<>(this.bootstrapServers);
+    }
+
+    public Collection<String> getTopics() {
+        return new ArrayList<>(this.topics);
+    }
+
+    @Override
+    public Object exec(int code, Object client, Object arg) {
+        try {
+            if (code == 2) {
+                this.addTopic(getTopic(arg));
+            } else if (code == 3) {
+                for (Object topic : (Collection<?>) client.getClass().getMethod("subscription").invoke(client)) {
+                    this.addTopic((String) topic);
+                }
+                for (Object partition : (Collection<?>) client.getClass().getMethod("assignment").invoke(client)) {
+                    this.addTopic(getTopic(partition));
+                }
+            }
+            if (!this.clients.contains(client)) {
+                List<String> servers = readBootstrapServers(client);
+                // the metadata of a consumer is empty until it has connected, look again on the next poll
+                if (!servers.isEmpty()) {
+                    this.addBootstrapServers(servers);
+                    this.clients.add(client);
+                }
+            }
+        } catch (ReflectiveOperationException | RuntimeException e) {
+            log.trace("Could not read Kafka client " + client.getClass().getName() + ": " + e.getMessage());
+        }
+        return null;
+    }
+
+    /**
+     * Looks for the config (producers) or the cluster metadata (consumers) in the fields of the client. Since Kafka
+     * 3.7 the consumer delegates to an implementation, which is searched instead.
+     */
+    static List<String> readBootstrapServers(Object client) throws ReflectiveOperationException {
+        List<String> brokers = new ArrayList<>();
+        for (Class<?> type = client.getClass(); type != null && type != Object.class; type = type.getSuperclass()) {
+            for (Field field : type.getDeclaredFields()) {
+                if (Modifier.isStatic(field.getModifiers()) || field.getType().isPrimitive()) {
+                    continue;
+                }
+                field.setAccessible(true);
+                Object value = field.get(client);
+                if (value == null) {
+                    continue;
+                }
+                if ("delegate".equals(field.getName())) {
+                    return readBootstrapServers(value);
+                }
+                if (isConfig(value)) {
+                    List<String> servers = new ArrayList<>();
+                    for (Object server : (List<?>) value.getClass().getMethod("getList", String.class).invoke(value, "bootstrap.servers")) {
+                        servers.add(server.toString());
+                    }
+                    return servers;
+                }
+                if (brokers.isEmpty() && isType(value, "org.apache.kafka.clients.Metadata")) {
+                    Object cluster = value.getClass().getMethod("fetch").invoke(value);
+                    for (Object node : (List<?>) cluster.getClass().getMethod("nodes").invoke(cluster)) {
+                        brokers.add(node.getClass().getMethod("host").invoke(node) + ":" + node.getClass().getMethod("port").invoke(node));
+                    }
+                }
+            }
+        }
+        return brokers;
+    }
+
+    private static boolean isConfig(Object value) {
+        // only the Kafka configs (AbstractConfig) offer this accessor
+        try {
+            value.getClass().getMethod("getList", String.class);
+            return true;
+        } catch (NoSuchMethodException e) {
+            return false;
+        }
+    }
+
+    private static boolean isType(Object value, String typeName) {
+        for (Class<?> type = value.getClass(); type != null; type = type.getSuperclass()) {
+            if (type.getName().equals(typeName)) {
+                return true;
+            }
+        }
+        return false;
+    }
+
+    void addBootstrapServers(List<?> servers) {
+        if (servers != null) {
+            for (Object server : servers) {
+                this.bootstrapServers.add(server.toString().trim());
+            }
+        }
+    }
+
+    void addTopic(String topic) {
+        if (topic != null && !topic.isEmpty()) {
+            this.topics.add(topic);
+        }
+    }
+
+    private static String getTopic(Object recordOrPartition) throws ReflectiveOperationException {
+        return (String) recordOrPartition.getClass().getMethod("topic").invoke(recordOrPartition);
+    }
+}
@@ -0,0 +1,17 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.java.javaagent.handlers.redis;
+
+import com.steadybit.javaagent.instrumentation.InstrumentationPluginDispatcher;
+import com.steadybit.javaagent.instrumentation.Registration;
+import com.steadybit.shaded.net.bytebuddy.asm.Advice;
+
+public class CaptureRedisConnectionFactoryAdvice {
+
+    @Advice.OnMethodExit(suppress = Throwable.class)
+    static void exit(@Registration int registration, @Advice.This Object factory) {
+        InstrumentationPluginDispatcher.find(registration).exec(3, factory, null);
+    }
+}
@@ -0,0 +1,149 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.java.javaagent.handlers.redis;
+
+import com.steadybit.discovery.java.javaagent.handlers.instrumentation.ClassTransformationPlugin;
+import com.steadybit.javaagent.instrumentation.Registration;
+import com.steadybit.javaagent.log.Logger;
+import com.steadybit.javaagent.log.RemoteAgentLogger;
+import com.steadybit.shaded.net.bytebuddy.agent.builder.AgentBuilder;
+import com.steadybit.shaded.net.bytebuddy.asm.Advice;
+import com.steadybit.shaded.net.bytebuddy.description.method.MethodDescription;
+import com.steadybit.shaded.net.bytebuddy.matcher.ElementMatcher;
+
+import javax.net.ssl.SSLSocket;
+import java.lang.instrument.Instrumentation;
+import java.lang.reflect.Field;
+import java.net.InetSocketAddress;
+import java.net.Socket;
+import java.net.SocketAddress;
+import java.util.ArrayList;
+import java.util.Collection;
+import java.util.Collections;
+import java.util.Map;
+import java.util.Set;
+import java.util.WeakHashMap;
+import java.util.concurrent.ConcurrentHashMap;
+
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.hasSuperType;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.isAbstract;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.named;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.not;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.takesArguments;
+
+/**
+ * Records the addresses of the Redis servers used through Lettuce, Jedis and Spring's {@code RedisConnectionFactory}.
+ * <p>
+ * The plugin is loaded after the clients have been created, so the addresses are read when the clients are used:
+ * from the channel of a Lettuce connection writing a command, the socket of a Jedis connection sending a command and
+ * the settings of a connection factory handing out a connection. Every client is only looked at once.
+ */
+public class RedisClientScanner extends ClassTransformationPlugin {
+    private static final Logger log = RemoteAgentLogger.getLogger(RedisClientScanner.class);
+    private final Set<String> uris = ConcurrentHashMap.newKeySet();
+    private final Set<Object> clients = Collections.synchronizedSet(Collections.newSetFromMap(new WeakHashMap<>()));
+    private final ElementMatcher<MethodDescription> lettuceWrite = named("write").and(takesArguments(3));
+    private final ElementMatcher<MethodDescription> jedisSend = named("sendCommand");
+    private final ElementMatcher<MethodDescription> getConnection = named("getConnection").and(takesArguments(0)).and(not(isAbstract()));
+
+    public RedisClientScanner(Instrumentation instrumentation) {
+        super(instrumentation);
+    }
+
+    @Override
+    protected AgentBuilder doInstall(AgentBuilder agentBuilder) {
+        return agentBuilder
+                .type(named("io.lettuce.core.protocol.CommandHandler"))
+                .transform(this.advice(this.lettuceWrite, CaptureLettuceCommandAdvice.class))
+                .type(named("redis.clients.jedis.Connection"))
+                .transform(this.advice(this.jedisSend, CaptureJedisCommandAdvice.class))
+                .type(hasSuperType(named("org.springframework.data.redis.connection.RedisConnectionFactory")).and(not(isAbstract())))
+                .transform(this.advice(this.getConnection, CaptureRedisConnectionFactoryAdvice.class));
+    }
+
+    private AgentBuilder.Transformer advice(ElementMatcher<? super MethodDescription> method, Class<?> adviceClass) {
+        return new AgentBuilder.Transformer.ForAdvice(Advice.withCustomMapping().bind(Registration.class, this.getRegistration()))
+                .include(adviceClass.getClassLoader())
+                .advice(method, adviceClass.getName());
+    }
+
+    public Collection<String> getUris() {
+        return new ArrayList<>(this.uris);
+    }
+
+    @Override
+    public Object exec(int code, Object client, Object arg) {
+        if (this.clients.contains(client)) {
+            return null;
+        }
+
+        try {
+            String uri = null;
+            if (code == 1) {
+                uri = readLettuceUri(arg);
+            } else if (code == 2) {
+                uri = readJedisUri(client);
+            } else if (code == 3) {
+                uri = readConnectionFactoryUri(client);
+            }
+            // connections might not be established yet, look again on the next command
+            if (uri != null) {
+                this.uris.add(uri);
+                this.clients.add(client);
+            }
+        } catch (ReflectiveOperationException | RuntimeException e) {
+            log.trace("Could not read Redis client " + client.getClass().getName() + ": " + e.getMessage());
+            this.clients.add(client);
+        }
+        return null;
+    }
+
+    private static String readLettuceUri(Object channelHandlerContext) throws ReflectiveOperationException {
+        Object channel = invoke(channelHandlerContext, "io.netty.channel.ChannelHandlerContext", "channel");
+        Object pipeline = invoke(channelHandlerContext, "io.netty.channel.ChannelHandlerContext", "pipeline");
+        boolean ssl = false;
+        for (Object handler : ((Map<?, ?>) invoke(pipeline, "io.netty.channel.ChannelPipeline", "toMap")).values()) {
+            ssl |= handler.getClass().getName().endsWith(".SslHandler");
+        }
+        return toUri(ssl, (SocketAddress) invoke(channel, "io.netty.channel.Channel", "remoteAddress"));
+    }
+
+    private static String readJedisUri(Object connection) throws ReflectiveOperationException {
+        // the clients of Jedis 3 extend the connection
+        Class<?> type = connection.getClass();
+        while (!type.getName().equals("redis.clients.jedis.Connection")) {
+            type = type.getSuperclass();
+        }
+        Field field = type.getDeclaredField("socket");
+        field.setAccessible(true);
+        Socket socket = (Socket) field.get(connection);
+        return socket != null ? toUri(socket instanceof SSLSocket, socket.getRemoteSocketAddress()) : null;
+    }
+
+    private static String readConnectionFactoryUri(Object factory) throws ReflectiveOperationException {
+        String host = (String) factory.getClass().getMethod("getHostName").invoke(factory);
+        int port = (Integer) factory.getClass().getMethod("getPort").invoke(factory);
+        boolean ssl = (Boolean) factory.getClass().getMethod("isUseSsl").invoke(factory);
+        return toUri(ssl, host, port);
+    }
+
+    private static String toUri(boolean ssl, SocketAddress address) {
+        if (!(address instanceof InetSocketAddress)) {
+            return null;
+        }
+        // getHostString() doesn't resolve the name, the host is reported as configured
+        return toUri(ssl, ((InetSocketAddress) address).getHostString(), ((InetSocketAddress) address).getPort());
+    }
+
+    static String toUri(boolean ssl, String host, int port) {
+        return (ssl ? "rediss://" : "redis://") + host + ":" + port;
+    }
+
+    private static Object invoke(Object target, String type, String method) throws ReflectiveOperationException {
+        // Resolve the method on the public API type, the implementations might not be accessible
+        ClassLoader classLoader = target.getClass().getClassLoader();
+        return Class.forName(type, false, classLoader).getMethod(method).invoke(target);
+    }
+}
@@ -23,6 +23,24 @@ class DataSourceCommandHandlerTest {
         assertThat(response).isEqualTo("\uFEFF[{\"databaseType\":\"test\",\"jdbcUrl\":\"jdbc:test\"}]");
     }
 
+    @Test
+    void should_return_datasources_with_pool_size() {
+        DataSourceConnection connection = new DataSourceConnection("jdbc:test", "test", 10, 3);
+        CommandHandler handler = new DataSourceCommandHandler(() -> Collections.singletonList(connection));
+
+        String response = this.command(handler, "java-datasource-connection");
+        assertThat(response).isEqualTo("\uFEFF[{\"activeConnections\":3,\"databaseType\":\"test\",\"jdbcUrl\":\"jdbc:test\",\"maxPoolSize\":10}]");
+    }
+
+    @Test
+    void should_return_datasources_with_pool_implementation() {
+        DataSourceConnection connection = new DataSourceConnection("jdbc:test", "test", 10, 3, "HikariCP");
+        CommandHandler handler = new DataSourceCommandHandler(() -> Collections.singletonList(connection));
+
+        String response = this.command(handler, "java-datasource-connection");
+        assertThat(response).contains("\"poolImplementation\":\"HikariCP\"");
+    }
+
     @Test
     void should_return_empty_datasources() {
         CommandHandler handler = new DataSourceCommandHandler(Collections::emptyList);
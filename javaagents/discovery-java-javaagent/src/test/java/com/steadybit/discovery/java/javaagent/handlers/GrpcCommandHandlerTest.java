@@ -0,0 +1,40 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.java.javaagent.handlers;
+
+import com.steadybit.javaagent.CommandHandler;
+import org.junit.jupiter.api.Test;
+
+import java.io.ByteArrayOutputStream;
+import java.util.Arrays;
+import java.util.Collections;
+
+import static org.assertj.core.api.Assertions.assertThat;
+
+class GrpcCommandHandlerTest {
+    @Test
+    void should_return_services_and_client_services() {
+        CommandHandler handler = new GrpcCommandHandler(() -> Arrays.asList("com.example.OrderService", "grpc.health.v1.Health"), () -> Collections.singletonList("com.example.PaymentService"));
+
+        String response = this.command(handler, "java-grpc-services");
+        assertThat(response).isEqualTo("\uFEFF{\"clientServices\":[\"com.example.PaymentService\"],\"services\":[\"com.example.OrderService\",\"grpc.health.v1.Health\"]}");
+    }
+
+    @Test
+    void should_return_empty_services() {
+        CommandHandler handler = new GrpcCommandHandler(Collections::emptyList, Collections::emptyList);
+
+        String response = this.command(handler, "java-grpc-services");
+        assertThat(response).isEqualTo("\uFEFF{\"clientServices\":[],\"services\":[]}");
+    }
+
+    private String command(CommandHandler handler, String command) {
+        ByteArrayOutputStream os = new ByteArrayOutputStream();
+        handler.handle(command, "", os);
+        byte[] buf = os.toByteArray();
+        assertThat(buf[0]).isEqualTo(CommandHandler.RC_OK);
+        return new String(buf, 1, buf.length - 1);
+    }
+}
@@ -0,0 +1,39 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.java.javaagent.handlers;
+
+import com.steadybit.javaagent.CommandHandler;
+import org.junit.jupiter.api.Test;
+
+import java.io.ByteArrayOutputStream;
+import java.util.Collections;
+
+import static org.assertj.core.api.Assertions.assertThat;
+
+class HttpClientCommandHandlerTest {
+    @Test
+    void should_return_addresses() {
+        CommandHandler handler = new HttpClientCommandHandler(() -> Collections.singletonList("example.com:443"));
+
+        String response = this.command(handler, "java-httpclient-addresses");
+        assertThat(response).isEqualTo("\uFEFF[\"example.com:443\"]");
+    }
+
+    @Test
+    void should_return_empty_addresses() {
+        CommandHandler handler = new HttpClientCommandHandler(Collections::emptyList);
+
+        String response = this.command(handler, "java-httpclient-addresses");
+        assertThat(response).isEqualTo("\uFEFF[]");
+    }
+
+    private String command(CommandHandler handler, String command) {
+        ByteArrayOutputStream os = new ByteArrayOutputStream();
+        handler.handle(command, "", os);
+        byte[] buf = os.toByteArray();
+        assertThat(buf[0]).isEqualTo(CommandHandler.RC_OK);
+        return new String(buf, 1, buf.length - 1);
+    }
+}
@@ -0,0 +1,40 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.java.javaagent.handlers;
+
+import com.steadybit.javaagent.CommandHandler;
+import org.junit.jupiter.api.Test;
+
+import java.io.ByteArrayOutputStream;
+import java.util.Arrays;
+import java.util.Collections;
+
+import static org.assertj.core.api.Assertions.assertThat;
+
+class KafkaCommandHandlerTest {
+    @Test
+    void should_return_bootstrap_servers_and_topics() {
+        CommandHandler handler = new KafkaCommandHandler(() -> Collections.singletonList("kafka:9092"), () -> Arrays.asList("orders", "payments"));
+
+        String response = this.command(handler, "java-kafka-clients");
+        assertThat(response).isEqualTo("\uFEFF{\"bootstrapServers\":[\"kafka:9092\"],\"topics\":[\"orders\",\"payments\"]}");
+    }
+
+    @Test
+    void should_return_empty_clients() {
+        CommandHandler handler = new KafkaCommandHandler(Collections::emptyList, Collections::emptyList);
+
+        String response = this.command(handler, "java-kafka-clients");
+        assertThat(response).isEqualTo("\uFEFF{\"bootstrapServers\":[],\"topics\":[]}");
+    }
+
+    private String command(CommandHandler handler, String command) {
+        ByteArrayOutputStream os = new ByteArrayOutputStream();
+        handler.handle(command, "", os);
+        byte[] buf = os.toByteArray();
+        assertThat(buf[0]).isEqualTo(CommandHandler.RC_OK);
+        return new String(buf, 1, buf.length - 1);
+    }
+}
@@ -0,0 +1,39 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.java.javaagent.handlers;
+
+import com.steadybit.javaagent.CommandHandler;
+import org.junit.jupiter.api.Test;
+
+import java.io.ByteArrayOutputStream;
+import java.util.Collections;
+
+import static org.assertj.core.api.Assertions.assertThat;
+
+class RedisCommandHandlerTest {
+    @Test
+    void should_return_uris() {
+        CommandHandler handler = new RedisCommandHandler(() -> Collections.singletonList("redis://redis:6379"));
+
+        String response = this.command(handler, "java-redis-uris");
+        assertThat(response).isEqualTo("\uFEFF[\"redis://redis:6379\"]");
+    }
+
+    @Test
+    void should_return_empty_uris() {
+        CommandHandler handler = new RedisCommandHandler(Collections::emptyList);
+
+        String response = this.command(handler, "java-redis-uris");
+        assertThat(response).isEqualTo("\uFEFF[]");
+    }
+
+    private String command(CommandHandler handler, String command) {
+        ByteArrayOutputStream os = new ByteArrayOutputStream();
+        handler.handle(command, "", os);
+        byte[] buf = os.toByteArray();
+        assertThat(buf[0]).isEqualTo(CommandHandler.RC_OK);
+        return new String(buf, 1, buf.length - 1);
+    }
+}
@@ -0,0 +1,56 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.java.javaagent.handlers.datasource;
+
+import com.zaxxer.hikari.HikariDataSource;
+import org.junit.jupiter.api.Test;
+
+import java.sql.Connection;
+
+import static org.assertj.core.api.Assertions.assertThat;
+
+class DataSourcePoolsTest {
+
+    @Test
+    void should_read_hikari_pool_size() throws Exception {
+        try (HikariDataSource dataSource = new HikariDataSource()) {
+            dataSource.setJdbcUrl("jdbc:hsqldb:mem:pools");
+            dataSource.setMaximumPoolSize(5);
+
+            try (Connection ignored = dataSource.getConnection()) {
+                assertThat(DataSourcePools.getMaxPoolSize(dataSource)).isEqualTo(5);
+                assertThat(DataSourcePools.getActiveConnections(dataSource)).isEqualTo(1);
+                assertThat(DataSourcePools.getPoolImplementation(dataSource)).isEqualTo("HikariCP");
+            }
+        }
+    }
+
+    @Test
+    void should_read_dbcp_style_pool_size() {
+        assertThat(DataSourcePools.getMaxPoolSize(new BasicPool())).isEqualTo(8);
+        assertThat(DataSourcePools.getActiveConnections(new BasicPool())).isEqualTo(2);
+    }
+
+    @Test
+    void should_return_null_for_unknown_datasource() {
+        assertThat(DataSourcePools.getMaxPoolSize(new Object())).isNull();
+        assertThat(DataSourcePools.getActiveConnections(new Object())).isNull();
+    }
+
+    @Test
+    void should_return_class_name_for_unknown_pool_implementation() {
+        assertThat(DataSourcePools.getPoolImplementation(new BasicPool())).isEqualTo(BasicPool.class.getName());
+    }
+
+    public static class BasicPool {
+        public int getMaxTotal() {
+            return 8;
+        }
+
+        public int getNumActive() {
+            return 2;
+        }
+    }
+}
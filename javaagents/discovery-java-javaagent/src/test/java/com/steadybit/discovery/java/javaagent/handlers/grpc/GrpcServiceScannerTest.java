@@ -0,0 +1,73 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.java.javaagent.handlers.grpc;
+
+import org.junit.jupiter.api.Test;
+
+import java.lang.instrument.Instrumentation;
+import java.util.Arrays;
+import java.util.HashSet;
+import java.util.List;
+import java.util.Set;
+
+import static org.assertj.core.api.Assertions.assertThat;
+import static org.mockito.Mockito.mock;
+
+class GrpcServiceScannerTest {
+    @Test
+    void should_record_service_of_method_names() {
+        Set<String> services = new HashSet<>();
+        GrpcServiceScanner.addService(services, "com.example.OrderService/PlaceOrder");
+        GrpcServiceScanner.addService(services, "com.example.OrderService/CancelOrder");
+        GrpcServiceScanner.addService(services, "grpc.health.v1.Health");
+        GrpcServiceScanner.addService(services, null);
+
+        assertThat(services).containsExactlyInAnyOrder("com.example.OrderService", "grpc.health.v1.Health");
+    }
+
+    @Test
+    void should_read_registered_services() throws ReflectiveOperationException {
+        TestServer server = new TestServer(new TestServiceDefinition("com.example.OrderService"), new TestServiceDefinition("grpc.health.v1.Health"));
+
+        assertThat(GrpcServiceScanner.readRegisteredServices(server)).containsExactly("com.example.OrderService", "grpc.health.v1.Health");
+    }
+
+    @Test
+    void should_record_service_of_server_call() {
+        GrpcServiceScanner scanner = new GrpcServiceScanner(mock(Instrumentation.class));
+        scanner.exec(1, new Object(), "com.example.OrderService/PlaceOrder");
+
+        assertThat(scanner.getServices()).containsExactly("com.example.OrderService");
+        assertThat(scanner.getClientServices()).isEmpty();
+    }
+
+    public static class TestServer {
+        private final List<TestServiceDefinition> services;
+
+        TestServer(TestServiceDefinition... services) {
+            this.services = Arrays.asList(services);
+        }
+
+        public List<TestServiceDefinition> getServices() {
+            return this.services;
+        }
+    }
+
+    public static class TestServiceDefinition {
+        private final String name;
+
+        TestServiceDefinition(String name) {
+            this.name = name;
+        }
+
+        public TestServiceDefinition getServiceDescriptor() {
+            return this;
+        }
+
+        public String getName() {
+            return this.name;
+        }
+    }
+}
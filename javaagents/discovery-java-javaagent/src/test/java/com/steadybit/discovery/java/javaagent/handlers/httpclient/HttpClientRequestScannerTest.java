@@ -0,0 +1,34 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.java.javaagent.handlers.httpclient;
+
+import org.junit.jupiter.api.Test;
+
+import java.lang.instrument.Instrumentation;
+import java.net.URI;
+
+import static org.assertj.core.api.Assertions.assertThat;
+import static org.mockito.Mockito.mock;
+
+class HttpClientRequestScannerTest {
+    @Test
+    void should_record_addresses_with_default_ports() {
+        HttpClientRequestScanner scanner = new HttpClientRequestScanner(mock(Instrumentation.class));
+        scanner.add(URI.create("https://example.com/api"));
+        scanner.add(URI.create("http://example.com/api"));
+        scanner.add(URI.create("http://example.com:8080/api"));
+        scanner.add(URI.create("/relative"));
+
+        assertThat(scanner.getAddresses()).containsExactlyInAnyOrder("example.com:443", "example.com:80", "example.com:8080");
+    }
+
+    @Test
+    void should_ignore_unknown_methods() {
+        HttpClientRequestScanner scanner = new HttpClientRequestScanner(mock(Instrumentation.class));
+        scanner.exec(0, new Object(), "unknown", new Object[0]);
+
+        assertThat(scanner.getAddresses()).isEmpty();
+    }
+}
@@ -0,0 +1,117 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.java.javaagent.handlers.kafka;
+
+import org.junit.jupiter.api.Test;
+
+import java.lang.instrument.Instrumentation;
+import java.util.Arrays;
+import java.util.Collections;
+import java.util.HashSet;
+import java.util.List;
+import java.util.Set;
+
+import static org.assertj.core.api.Assertions.assertThat;
+import static org.mockito.Mockito.mock;
+
+class KafkaClientScannerTest {
+    @Test
+    void should_record_bootstrap_servers_and_topics_of_producers() {
+        KafkaClientScanner scanner = new KafkaClientScanner(mock(Instrumentation.class));
+        TestProducer producer = new TestProducer(new TestConfig(Arrays.asList("kafka-1:9092", " kafka-2:9092")));
+        scanner.exec(2, producer, new TestTopic("orders"));
+        scanner.exec(2, producer, new TestTopic("payments"));
+
+        assertThat(scanner.getBootstrapServers()).containsExactlyInAnyOrder("kafka-1:9092", "kafka-2:9092");
+        assertThat(scanner.getTopics()).containsExactlyInAnyOrder("orders", "payments");
+    }
+
+    @Test
+    void should_record_topics_of_consumers() {
+        KafkaClientScanner scanner = new KafkaClientScanner(mock(Instrumentation.class));
+        scanner.exec(3, new TestConsumer(Collections.singleton("payments"), Collections.singleton(new TestTopic("invoices"))), null);
+
+        assertThat(scanner.getTopics()).containsExactlyInAnyOrder("payments", "invoices");
+    }
+
+    @Test
+    void should_read_bootstrap_servers_of_delegate() throws ReflectiveOperationException {
+        TestDelegatingClient client = new TestDelegatingClient(new TestProducer(new TestConfig(Collections.singletonList("kafka:9092"))));
+
+        assertThat(KafkaClientScanner.readBootstrapServers(client)).containsExactly("kafka:9092");
+    }
+
+    @Test
+    void should_ignore_unknown_objects() {
+        KafkaClientScanner scanner = new KafkaClientScanner(mock(Instrumentation.class));
+        scanner.exec(2, new Object(), new Object());
+        scanner.exec(3, new Object(), null);
+
+        assertThat(scanner.getBootstrapServers()).isEmpty();
+        assertThat(scanner.getTopics()).isEmpty();
+    }
+
+    public static class TestProducer {
+        private final TestConfig producerConfig;
+
+        TestProducer(TestConfig producerConfig) {
+            this.producerConfig = producerConfig;
+        }
+    }
+
+    public static class TestDelegatingClient {
+        private final String clientId = "consumer-1";
+        private final Object delegate;
+
+        TestDelegatingClient(Object delegate) {
+            this.delegate = delegate;
+        }
+    }
+
+    public static class TestConfig {
+        private final List<String> servers;
+
+        TestConfig(List<String> servers) {
+            this.servers = servers;
+        }
+
+        public List<String> getList(String key) {
+            return "bootstrap.servers".equals(key) ? this.servers : null;
+        }
+    }
+
+    /**
+     * Stands in for records and topic partitions, both expose a {@code topic()} method.
+     */
+    public static class TestTopic {
+        private final String topic;
+
+        TestTopic(String topic) {
+            this.topic = topic;
+        }
+
+        public String topic() {
+            return this.topic;
+        }
+    }
+
+    public static class TestConsumer {
+        private final Set<String> subscription;
+        private final Set<TestTopic> assignment;
+
+        TestConsumer(Set<String> subscription, Set<TestTopic> assignment) {
+            this.subscription = new HashSet<>(subscription);
+            this.assignment = new HashSet<>(assignment);
+        }
+
+        public Set<String> subscription() {
+            return this.subscription;
+        }
+
+        public Set<TestTopic> assignment() {
+            return this.assignment;
+        }
+    }
+}
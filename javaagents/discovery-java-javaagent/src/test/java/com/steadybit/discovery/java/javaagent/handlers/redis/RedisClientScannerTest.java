@@ -0,0 +1,67 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.java.javaagent.handlers.redis;
+
+import org.junit.jupiter.api.Test;
+
+import java.lang.instrument.Instrumentation;
+
+import static org.assertj.core.api.Assertions.assertThat;
+import static org.mockito.Mockito.mock;
+
+class RedisClientScannerTest {
+    @Test
+    void should_record_uri_of_connection_factory() {
+        RedisClientScanner scanner = new RedisClientScanner(mock(Instrumentation.class));
+        scanner.exec(3, new TestConnectionFactory("redis", 6379, false), null);
+        scanner.exec(3, new TestConnectionFactory("cache.example.com", 6380, true), null);
+
+        assertThat(scanner.getUris()).containsExactlyInAnyOrder("redis://redis:6379", "rediss://cache.example.com:6380");
+    }
+
+    @Test
+    void should_read_client_only_once() {
+        RedisClientScanner scanner = new RedisClientScanner(mock(Instrumentation.class));
+        TestConnectionFactory factory = new TestConnectionFactory("redis", 6379, false);
+        scanner.exec(3, factory, null);
+        factory.host = "other";
+        scanner.exec(3, factory, null);
+
+        assertThat(scanner.getUris()).containsExactly("redis://redis:6379");
+    }
+
+    @Test
+    void should_ignore_unknown_objects() {
+        RedisClientScanner scanner = new RedisClientScanner(mock(Instrumentation.class));
+        scanner.exec(1, new Object(), new Object());
+        scanner.exec(3, new Object(), null);
+
+        assertThat(scanner.getUris()).isEmpty();
+    }
+
+    public static class TestConnectionFactory {
+        private final int port;
+        private final boolean ssl;
+        private String host;
+
+        TestConnectionFactory(String host, int port, boolean ssl) {
+            this.host = host;
+            this.port = port;
+            this.ssl = ssl;
+        }
+
+        public String getHostName() {
+            return this.host;
+        }
+
+        public int getPort() {
+            return this.port;
+        }
+
+        public boolean isUseSsl() {
+            return this.ssl;
+        }
+    }
+}
@@ -0,0 +1,50 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.jaxrs.javaagent;
+
+import com.steadybit.javaagent.AgentPlugin;
+import com.steadybit.javaagent.CommandHandler;
+import org.json.JSONArray;
+
+import java.io.OutputStream;
+import java.io.OutputStreamWriter;
+import java.io.PrintWriter;
+import java.lang.instrument.Instrumentation;
+import java.nio.charset.StandardCharsets;
+
+/**
+ * AgentPlugin to discover the resources of JAX-RS applications, e.g. Jersey or RESTEasy. The plugin doesn't need the
+ * classloader of the application, as the annotations are matched by name.
+ */
+public class JaxRsAgentPlugin implements AgentPlugin, CommandHandler {
+    private static final char BYTE_ORDER_MARK = '\ufeff';
+    private final Instrumentation instrumentation;
+    private final JaxRsEndpointScanner scanner = new JaxRsEndpointScanner();
+
+    public JaxRsAgentPlugin(Instrumentation instrumentation) {
+        this.instrumentation = instrumentation;
+    }
+
+    @Override
+    public boolean canHandle(String command) {
+        return command.equals("jaxrs-endpoints") || command.equals("jaxrs-application-name");
+    }
+
+    @Override
+    public void handle(String command, String argument, OutputStream os) {
+        if (command.equals("jaxrs-endpoints")) {
+            PrintWriter writer = new PrintWriter(new OutputStreamWriter(os, StandardCharsets.UTF_8));
+            writer.write(RC_OK);
+            writer.write(BYTE_ORDER_MARK);
+            new JSONArray(this.scanner.scan(this.instrumentation.getAllLoadedClasses())).write(writer);
+            writer.flush();
+        } else {
+            // JAX-RS has no notion of an application name
+            PrintWriter writer = new PrintWriter(new OutputStreamWriter(os, StandardCharsets.UTF_8), true);
+            writer.write(RC_OK);
+            writer.println();
+        }
+    }
+}
@@ -0,0 +1,186 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.jaxrs.javaagent;
+
+import org.json.JSONObject;
+
+import java.lang.annotation.Annotation;
+import java.lang.reflect.Method;
+import java.lang.reflect.Modifier;
+import java.util.ArrayList;
+import java.util.Arrays;
+import java.util.Collections;
+import java.util.List;
+
+/**
+ * Finds the JAX-RS resource methods of the loaded classes. The annotations are matched by name for both, the jakarta
+ * and the javax namespace, as the agent can't link against the API of the application.
+ * <p>
+ * The endpoints are reported in the shape of the Spring MVC mappings: patterns, methods, consumes, produces,
+ * handlerClass and handlerName.
+ */
+public class JaxRsEndpointScanner {
+    private static final String[] NAMESPACES = { "jakarta.ws.rs.", "javax.ws.rs." };
+    private static final String[] EXCLUDED_PACKAGES = { "io.quarkus.", "org.jboss.resteasy.", "org.glassfish.jersey." };
+
+    public List<JSONObject> scan(Class<?>[] classes) {
+        String applicationPath = this.findApplicationPath(classes);
+        List<JSONObject> endpoints = new ArrayList<>();
+        for (Class<?> clazz : classes) {
+            if (clazz.isInterface() || Modifier.isAbstract(clazz.getModifiers()) || isExcluded(clazz)) {
+                continue;
+            }
+            Annotation classPath = findResourceAnnotation(clazz, "Path");
+            if (classPath == null) {
+                continue;
+            }
+            for (Method method : clazz.getMethods()) {
+                JSONObject endpoint = this.toEndpoint(clazz, method, applicationPath, classPath);
+                if (endpoint != null) {
+                    endpoints.add(endpoint);
+                }
+            }
+        }
+        return endpoints;
+    }
+
+    private JSONObject toEndpoint(Class<?> clazz, Method method, String applicationPath, Annotation classPath) {
+        Method annotated = findAnnotatedMethod(clazz, method);
+        if (annotated == null) {
+            return null;
+        }
+        String httpMethod = getHttpMethod(annotated);
+        if (httpMethod == null) {
+            // sub-resource locators don't handle requests themselves
+            return null;
+        }
+
+        Annotation methodPath = findAnnotation(annotated.getAnnotations(), "Path");
+        String pattern = joinPaths(applicationPath, (String) value(classPath), methodPath != null ? (String) value(methodPath) : null);
+
+        JSONObject endpoint = new JSONObject();
+        endpoint.put("patterns", Collections.singletonList(pattern));
+        endpoint.put("methods", Collections.singletonList(httpMethod));
+        endpoint.put("consumes", this.getMediaTypes(clazz, annotated, "Consumes"));
+        endpoint.put("produces", this.getMediaTypes(clazz, annotated, "Produces"));
+        endpoint.put("handlerClass", clazz.getName());
+        endpoint.put("handlerName", method.getName());
+        return endpoint;
+    }
+
+    private String findApplicationPath(Class<?>[] classes) {
+        for (Class<?> clazz : classes) {
+            Annotation applicationPath = findAnnotation(clazz.getAnnotations(), "ApplicationPath");
+            if (applicationPath != null) {
+                return (String) value(applicationPath);
+            }
+        }
+        return null;
+    }
+
+    private List<String> getMediaTypes(Class<?> clazz, Method method, String annotationName) {
+        Annotation annotation = findAnnotation(method.getAnnotations(), annotationName);
+        if (annotation == null) {
+            annotation = findResourceAnnotation(clazz, annotationName);
+        }
+        return annotation != null ? Arrays.asList((String[]) value(annotation)) : Collections.<String>emptyList();
+    }
+
+    /**
+     * Resource annotations may be placed on an interface implemented by the resource class.
+     */
+    private static Annotation findResourceAnnotation(Class<?> clazz, String name) {
+        Annotation annotation = findAnnotation(clazz.getAnnotations(), name);
+        if (annotation != null) {
+            return annotation;
+        }
+        for (Class<?> anInterface : clazz.getInterfaces()) {
+            annotation = findAnnotation(anInterface.getAnnotations(), name);
+            if (annotation != null) {
+                return annotation;
+            }
+        }
+        return null;
+    }
+
+    /**
+     * Returns the method or the method of an implemented interface carrying the http method annotation.
+     */
+    private static Method findAnnotatedMethod(Class<?> clazz, Method method) {
+        if (method.getDeclaringClass() == Object.class) {
+            return null;
+        }
+        if (getHttpMethod(method) != null || findAnnotation(method.getAnnotations(), "Path") != null) {
+            return method;
+        }
+        for (Class<?> anInterface : clazz.getInterfaces()) {
+            try {
+                Method interfaceMethod = anInterface.getMethod(method.getName(), method.getParameterTypes());
+                if (getHttpMethod(interfaceMethod) != null || findAnnotation(interfaceMethod.getAnnotations(), "Path") != null) {
+                    return interfaceMethod;
+                }
+            } catch (NoSuchMethodException e) {
+                //not declared by this interface
+            }
+        }
+        return null;
+    }
+
+    /**
+     * GET, POST, ... are annotations themselves annotated with {@code @HttpMethod("GET")}.
+     */
+    private static String getHttpMethod(Method method) {
+        for (Annotation annotation : method.getAnnotations()) {
+            Annotation httpMethod = findAnnotation(annotation.annotationType().getAnnotations(), "HttpMethod");
+            if (httpMethod != null) {
+                return (String) value(httpMethod);
+            }
+        }
+        return null;
+    }
+
+    private static Annotation findAnnotation(Annotation[] annotations, String name) {
+        for (Annotation annotation : annotations) {
+            String annotationName = annotation.annotationType().getName();
+            for (String namespace : NAMESPACES) {
+                if (annotationName.equals(namespace + name)) {
+                    return annotation;
+                }
+            }
+        }
+        return null;
+    }
+
+    private static Object value(Annotation annotation) {
+        try {
+            return annotation.annotationType().getMethod("value").invoke(annotation);
+        } catch (Exception e) {
+            throw new IllegalStateException("Could not read value of " + annotation, e);
+        }
+    }
+
+    private static boolean isExcluded(Class<?> clazz) {
+        for (String excludedPackage : EXCLUDED_PACKAGES) {
+            if (clazz.getName().startsWith(excludedPackage)) {
+                return true;
+            }
+        }
+        return false;
+    }
+
+    static String joinPaths(String... paths) {
+        StringBuilder result = new StringBuilder();
+        for (String path : paths) {
+            if (path == null) {
+                continue;
+            }
+            String trimmed = path.replaceAll("^/+|/+$", "");
+            if (!trimmed.isEmpty()) {
+                result.append('/').append(trimmed);
+            }
+        }
+        return result.length() > 0 ? result.toString() : "/";
+    }
+}
@@ -0,0 +1,107 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.jaxrs.javaagent;
+
+import org.json.JSONArray;
+import org.json.JSONObject;
+import org.junit.jupiter.api.Test;
+
+import javax.ws.rs.ApplicationPath;
+import javax.ws.rs.Consumes;
+import javax.ws.rs.GET;
+import javax.ws.rs.POST;
+import javax.ws.rs.Path;
+import javax.ws.rs.PathParam;
+import javax.ws.rs.Produces;
+import javax.ws.rs.core.Application;
+import java.util.List;
+
+import static org.assertj.core.api.Assertions.assertThat;
+
+class JaxRsEndpointScannerTest {
+    private final JaxRsEndpointScanner scanner = new JaxRsEndpointScanner();
+
+    @Test
+    void should_find_resource_methods() {
+        List<JSONObject> endpoints = this.scanner.scan(new Class<?>[] { OrderResource.class, String.class });
+
+        assertThat(new JSONArray(endpoints).toString()).doesNotContain("subResource");
+        assertThat(endpoints).hasSize(2);
+
+        JSONObject order = find(endpoints, "order");
+        assertThat(order.getJSONArray("patterns").toList()).containsExactly("/orders/{id}");
+        assertThat(order.getJSONArray("methods").toList()).containsExactly("GET");
+        assertThat(order.getJSONArray("produces").toList()).containsExactly("application/json");
+
+        JSONObject create = find(endpoints, "create");
+        assertThat(create.getJSONArray("patterns").toList()).containsExactly("/orders");
+        assertThat(create.getJSONArray("methods").toList()).containsExactly("POST");
+        assertThat(create.getJSONArray("consumes").toList()).containsExactly("application/xml");
+        assertThat(create.getString("handlerClass")).isEqualTo(OrderResource.class.getName());
+    }
+
+    @Test
+    void should_prefix_application_path_and_read_interface_annotations() {
+        List<JSONObject> endpoints = this.scanner.scan(new Class<?>[] { RestApplication.class, CustomerResourceImpl.class, CustomerResource.class });
+
+        assertThat(endpoints).hasSize(1);
+        assertThat(endpoints.get(0).getJSONArray("patterns").toList()).containsExactly("/api/customers");
+        assertThat(endpoints.get(0).getString("handlerClass")).isEqualTo(CustomerResourceImpl.class.getName());
+        assertThat(endpoints.get(0).getString("handlerName")).isEqualTo("customers");
+    }
+
+    @Test
+    void should_join_paths() {
+        assertThat(JaxRsEndpointScanner.joinPaths(null, "/orders/", "{id}")).isEqualTo("/orders/{id}");
+        assertThat(JaxRsEndpointScanner.joinPaths("api", "/", "")).isEqualTo("/api");
+        assertThat(JaxRsEndpointScanner.joinPaths(null, "/", null)).isEqualTo("/");
+    }
+
+    private static JSONObject find(List<JSONObject> endpoints, String handlerName) {
+        for (JSONObject endpoint : endpoints) {
+            if (endpoint.getString("handlerName").equals(handlerName)) {
+                return endpoint;
+            }
+        }
+        throw new AssertionError("No endpoint for " + handlerName);
+    }
+
+    @Path("/orders")
+    @Produces("application/json")
+    public static class OrderResource {
+        @GET
+        @Path("{id}")
+        public String order(@PathParam("id") String id) {
+            return id;
+        }
+
+        @POST
+        @Consumes("application/xml")
+        public void create(String order) {
+        }
+
+        @Path("items")
+        public Object subResource() {
+            return null;
+        }
+    }
+
+    @ApplicationPath("/api")
+    public static class RestApplication extends Application {
+    }
+
+    @Path("customers")
+    public interface CustomerResource {
+        @GET
+        String customers();
+    }
+
+    public static class CustomerResourceImpl implements CustomerResource {
+        @Override
+        public String customers() {
+            return "";
+        }
+    }
+}
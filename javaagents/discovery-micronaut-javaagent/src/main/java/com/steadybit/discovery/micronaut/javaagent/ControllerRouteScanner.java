@@ -0,0 +1,115 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.micronaut.javaagent;
+
+import org.json.JSONObject;
+
+import java.lang.annotation.Annotation;
+import java.lang.reflect.Method;
+import java.util.ArrayList;
+import java.util.Arrays;
+import java.util.Collections;
+import java.util.List;
+import java.util.Locale;
+
+/**
+ * Finds the routes of the loaded {@code @Controller} classes. The annotations are matched by name, as the agent can't
+ * link against the Micronaut API of the application.
+ * <p>
+ * The routes are reported in the shape of the Spring MVC mappings: patterns, methods, consumes, produces, handlerClass
+ * and handlerName.
+ */
+public class ControllerRouteScanner {
+    private static final String ANNOTATION_PACKAGE = "io.micronaut.http.annotation.";
+    private static final List<String> HTTP_METHODS = Arrays.asList("Get", "Post", "Put", "Delete", "Patch", "Head", "Options", "Trace");
+
+    public List<JSONObject> scan(Class<?>[] classes) {
+        List<JSONObject> routes = new ArrayList<>();
+        for (Class<?> clazz : classes) {
+            Annotation controller = findAnnotation(clazz.getAnnotations(), "Controller");
+            if (controller == null || clazz.isInterface()) {
+                continue;
+            }
+            for (Method method : clazz.getMethods()) {
+                JSONObject route = this.toRoute(clazz, method, controller);
+                if (route != null) {
+                    routes.add(route);
+                }
+            }
+        }
+        return routes;
+    }
+
+    private JSONObject toRoute(Class<?> clazz, Method method, Annotation controller) {
+        for (Annotation annotation : method.getAnnotations()) {
+            String name = annotation.annotationType().getName();
+            if (!name.startsWith(ANNOTATION_PACKAGE) || !HTTP_METHODS.contains(name.substring(ANNOTATION_PACKAGE.length()))) {
+                continue;
+            }
+
+            JSONObject route = new JSONObject();
+            route.put("patterns", Collections.singletonList(joinPaths(uri(controller), uri(annotation))));
+            route.put("methods", Collections.singletonList(name.substring(ANNOTATION_PACKAGE.length()).toUpperCase(Locale.ROOT)));
+            route.put("consumes", this.getMediaTypes(clazz, method, "Consumes"));
+            route.put("produces", this.getMediaTypes(clazz, method, "Produces"));
+            route.put("handlerClass", clazz.getName());
+            route.put("handlerName", method.getName());
+            return route;
+        }
+        return null;
+    }
+
+    private List<String> getMediaTypes(Class<?> clazz, Method method, String annotationName) {
+        Annotation annotation = findAnnotation(method.getAnnotations(), annotationName);
+        if (annotation == null) {
+            annotation = findAnnotation(clazz.getAnnotations(), annotationName);
+        }
+        return annotation != null ? Arrays.asList((String[]) attribute(annotation, "value")) : Collections.<String>emptyList();
+    }
+
+    /**
+     * Route annotations have the attributes value and uri as aliases, both defaulting to "/".
+     */
+    private static String uri(Annotation annotation) {
+        String value = (String) attribute(annotation, "value");
+        if (value != null && !value.isEmpty() && !value.equals("/")) {
+            return value;
+        }
+        return (String) attribute(annotation, "uri");
+    }
+
+    private static Annotation findAnnotation(Annotation[] annotations, String name) {
+        for (Annotation annotation : annotations) {
+            if (annotation.annotationType().getName().equals(ANNOTATION_PACKAGE + name)) {
+                return annotation;
+            }
+        }
+        return null;
+    }
+
+    private static Object attribute(Annotation annotation, String attribute) {
+        try {
+            return annotation.annotationType().getMethod(attribute).invoke(annotation);
+        } catch (NoSuchMethodException e) {
+            return null;
+        } catch (Exception e) {
+            throw new IllegalStateException("Could not read " + attribute + " of " + annotation, e);
+        }
+    }
+
+    static String joinPaths(String... paths) {
+        StringBuilder result = new StringBuilder();
+        for (String path : paths) {
+            if (path == null) {
+                continue;
+            }
+            String trimmed = path.replaceAll("^/+|/+$", "");
+            if (!trimmed.isEmpty()) {
+                result.append('/').append(trimmed);
+            }
+        }
+        return result.length() > 0 ? result.toString() : "/";
+    }
+}
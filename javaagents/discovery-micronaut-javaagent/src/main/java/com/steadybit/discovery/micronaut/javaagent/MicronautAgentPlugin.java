@@ -0,0 +1,99 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.micronaut.javaagent;
+
+import com.steadybit.javaagent.AgentPlugin;
+import com.steadybit.javaagent.CommandHandler;
+import com.steadybit.javaagent.log.Logger;
+import com.steadybit.javaagent.log.RemoteAgentLogger;
+import org.json.JSONArray;
+
+import java.io.InputStream;
+import java.io.OutputStream;
+import java.io.OutputStreamWriter;
+import java.io.PrintWriter;
+import java.lang.instrument.Instrumentation;
+import java.nio.charset.StandardCharsets;
+import java.util.Properties;
+
+/**
+ * AgentPlugin to discover the controller routes of a Micronaut application
+ */
+public class MicronautAgentPlugin implements AgentPlugin, CommandHandler {
+    private static final Logger log = RemoteAgentLogger.getLogger(MicronautAgentPlugin.class);
+    private static final char BYTE_ORDER_MARK = '\ufeff';
+    private static final String MARKER_CLASS = "io.micronaut.context.ApplicationContext";
+    private static final String APPLICATION_NAME = "micronaut.application.name";
+    private final Instrumentation instrumentation;
+    private final ControllerRouteScanner scanner = new ControllerRouteScanner();
+
+    public MicronautAgentPlugin(Instrumentation instrumentation) {
+        this.instrumentation = instrumentation;
+    }
+
+    @Override
+    public boolean canHandle(String command) {
+        return command.equals("micronaut-endpoints") || command.equals("micronaut-application-name");
+    }
+
+    @Override
+    public void handle(String command, String argument, OutputStream os) {
+        if (command.equals("micronaut-endpoints")) {
+            PrintWriter writer = new PrintWriter(new OutputStreamWriter(os, StandardCharsets.UTF_8));
+            writer.write(RC_OK);
+            writer.write(BYTE_ORDER_MARK);
+            new JSONArray(this.scanner.scan(this.instrumentation.getAllLoadedClasses())).write(writer);
+            writer.flush();
+        } else {
+            PrintWriter writer = new PrintWriter(new OutputStreamWriter(os, StandardCharsets.UTF_8), true);
+            writer.write(RC_OK);
+            writer.println(this.getApplicationName());
+        }
+    }
+
+    /**
+     * Reads micronaut.application.name from the system properties, the environment or the application configuration
+     * files, as the application context isn't reachable from the agent.
+     */
+    private String getApplicationName() {
+        String name = System.getProperty(APPLICATION_NAME);
+        if (name == null) {
+            name = System.getenv("MICRONAUT_APPLICATION_NAME");
+        }
+        if (name == null) {
+            name = this.readConfigurationFiles();
+        }
+        return name != null ? name : "";
+    }
+
+    private String readConfigurationFiles() {
+        try {
+            ClassLoader classLoader = Class.forName(MARKER_CLASS).getClassLoader();
+            try (InputStream is = classLoader.getResourceAsStream("application.properties")) {
+                if (is != null) {
+                    Properties properties = new Properties();
+                    properties.load(is);
+                    if (properties.getProperty(APPLICATION_NAME) != null) {
+                        return properties.getProperty(APPLICATION_NAME);
+                    }
+                }
+            }
+            for (String file : new String[] { "application.yml", "application.yaml" }) {
+                try (InputStream is = classLoader.getResourceAsStream(file)) {
+                    if (is != null) {
+                        String name = YamlProperty.read(is, APPLICATION_NAME);
+                        if (name != null) {
+                            return name;
+                        }
+                    }
+                }
+            }
+            return null;
+        } catch (Exception e) {
+            log.debug("Could not read " + APPLICATION_NAME + ": " + e.getClass() + ": " + e.getMessage());
+            return null;
+        }
+    }
+}
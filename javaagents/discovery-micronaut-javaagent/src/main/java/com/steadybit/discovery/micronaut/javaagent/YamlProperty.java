@@ -0,0 +1,67 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.micronaut.javaagent;
+
+import java.io.BufferedReader;
+import java.io.IOException;
+import java.io.InputStream;
+import java.io.InputStreamReader;
+import java.nio.charset.StandardCharsets;
+import java.util.ArrayList;
+import java.util.List;
+
+/**
+ * Minimal lookup of a scalar value in a yaml file, supporting nested and dotted keys like
+ * {@code micronaut: { application: { name: orders } } }. Lists, multi-line values and documents after the first one
+ * are not supported, as only simple properties like the application name are read.
+ */
+class YamlProperty {
+    private YamlProperty() {
+    }
+
+    static String read(InputStream is, String key) throws IOException {
+        BufferedReader reader = new BufferedReader(new InputStreamReader(is, StandardCharsets.UTF_8));
+        List<Integer> indents = new ArrayList<>();
+        List<String> keys = new ArrayList<>();
+        String line;
+        while ((line = reader.readLine()) != null) {
+            String trimmed = line.trim();
+            if (trimmed.equals("---") && !keys.isEmpty()) {
+                break;
+            }
+            if (trimmed.isEmpty() || trimmed.startsWith("#") || trimmed.startsWith("-") || !trimmed.contains(":")) {
+                continue;
+            }
+
+            int indent = line.length() - line.replaceAll("^\\s+", "").length();
+            while (!indents.isEmpty() && indents.get(indents.size() - 1) >= indent) {
+                indents.remove(indents.size() - 1);
+                keys.remove(keys.size() - 1);
+            }
+
+            int separator = trimmed.indexOf(':');
+            indents.add(indent);
+            keys.add(unquote(trimmed.substring(0, separator).trim()));
+
+            String value = trimmed.substring(separator + 1).trim();
+            if (!value.isEmpty() && String.join(".", keys).equals(key)) {
+                return unquote(stripComment(value));
+            }
+        }
+        return null;
+    }
+
+    private static String stripComment(String value) {
+        int comment = value.indexOf(" #");
+        return comment != -1 ? value.substring(0, comment).trim() : value;
+    }
+
+    private static String unquote(String value) {
+        if (value.length() >= 2 && (value.startsWith("\"") && value.endsWith("\"") || value.startsWith("'") && value.endsWith("'"))) {
+            return value.substring(1, value.length() - 1);
+        }
+        return value;
+    }
+}
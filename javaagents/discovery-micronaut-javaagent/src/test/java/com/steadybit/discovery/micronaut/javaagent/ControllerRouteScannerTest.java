@@ -0,0 +1,80 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.micronaut.javaagent;
+
+import io.micronaut.http.annotation.Body;
+import io.micronaut.http.annotation.Consumes;
+import io.micronaut.http.annotation.Controller;
+import io.micronaut.http.annotation.Get;
+import io.micronaut.http.annotation.Post;
+import io.micronaut.http.annotation.Produces;
+import org.json.JSONObject;
+import org.junit.jupiter.api.Test;
+
+import java.util.List;
+
+import static org.assertj.core.api.Assertions.assertThat;
+
+class ControllerRouteScannerTest {
+    private final ControllerRouteScanner scanner = new ControllerRouteScanner();
+
+    @Test
+    void should_find_controller_routes() {
+        List<JSONObject> routes = this.scanner.scan(new Class<?>[] { OrderController.class, String.class });
+
+        assertThat(routes).hasSize(3);
+
+        JSONObject order = find(routes, "order");
+        assertThat(order.getJSONArray("patterns").toList()).containsExactly("/orders/{id}");
+        assertThat(order.getJSONArray("methods").toList()).containsExactly("GET");
+        assertThat(order.getJSONArray("produces").toList()).containsExactly("text/plain");
+        assertThat(order.getString("handlerClass")).isEqualTo(OrderController.class.getName());
+
+        JSONObject list = find(routes, "list");
+        assertThat(list.getJSONArray("patterns").toList()).containsExactly("/orders");
+
+        JSONObject create = find(routes, "create");
+        assertThat(create.getJSONArray("patterns").toList()).containsExactly("/orders/new");
+        assertThat(create.getJSONArray("methods").toList()).containsExactly("POST");
+        assertThat(create.getJSONArray("consumes").toList()).containsExactly("application/xml");
+    }
+
+    @Test
+    void should_join_paths() {
+        assertThat(ControllerRouteScanner.joinPaths("/orders/", "/{id}")).isEqualTo("/orders/{id}");
+        assertThat(ControllerRouteScanner.joinPaths("/", "/")).isEqualTo("/");
+    }
+
+    private static JSONObject find(List<JSONObject> routes, String handlerName) {
+        for (JSONObject route : routes) {
+            if (route.getString("handlerName").equals(handlerName)) {
+                return route;
+            }
+        }
+        throw new AssertionError("No route for " + handlerName);
+    }
+
+    @Controller("/orders")
+    @Produces("text/plain")
+    public static class OrderController {
+        @Get("/{id}")
+        public String order(String id) {
+            return id;
+        }
+
+        @Get
+        public String list() {
+            return "";
+        }
+
+        @Post(uri = "/new")
+        @Consumes("application/xml")
+        public void create(@Body String order) {
+        }
+
+        public void helper() {
+        }
+    }
+}
@@ -0,0 +1,47 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.micronaut.javaagent;
+
+import org.junit.jupiter.api.Test;
+
+import java.io.ByteArrayInputStream;
+import java.io.IOException;
+import java.nio.charset.StandardCharsets;
+
+import static org.assertj.core.api.Assertions.assertThat;
+
+class YamlPropertyTest {
+    @Test
+    void should_read_nested_property() throws IOException {
+        String yaml = "# config\n"
+                + "micronaut:\n"
+                + "  server:\n"
+                + "    port: 8080\n"
+                + "  application:\n"
+                + "    name: 'orders' # the name\n"
+                + "datasources:\n"
+                + "  default:\n"
+                + "    url: jdbc:h2:mem:db\n";
+
+        assertThat(read(yaml, "micronaut.application.name")).isEqualTo("orders");
+        assertThat(read(yaml, "micronaut.server.port")).isEqualTo("8080");
+        assertThat(read(yaml, "datasources.default.url")).isEqualTo("jdbc:h2:mem:db");
+    }
+
+    @Test
+    void should_read_dotted_keys() throws IOException {
+        assertThat(read("micronaut.application.name: orders\n", "micronaut.application.name")).isEqualTo("orders");
+        assertThat(read("micronaut:\n  application.name: \"orders\"\n", "micronaut.application.name")).isEqualTo("orders");
+    }
+
+    @Test
+    void should_return_null_for_missing_property() throws IOException {
+        assertThat(read("micronaut:\n  application:\n    version: 1\n", "micronaut.application.name")).isNull();
+    }
+
+    private static String read(String yaml, String key) throws IOException {
+        return YamlProperty.read(new ByteArrayInputStream(yaml.getBytes(StandardCharsets.UTF_8)), key);
+    }
+}
@@ -0,0 +1,73 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.quarkus.javaagent;
+
+import com.steadybit.discovery.jaxrs.javaagent.JaxRsEndpointScanner;
+import com.steadybit.javaagent.AgentPlugin;
+import com.steadybit.javaagent.CommandHandler;
+import com.steadybit.javaagent.log.Logger;
+import com.steadybit.javaagent.log.RemoteAgentLogger;
+import org.json.JSONArray;
+
+import java.io.OutputStream;
+import java.io.OutputStreamWriter;
+import java.io.PrintWriter;
+import java.lang.instrument.Instrumentation;
+import java.lang.reflect.Method;
+import java.nio.charset.StandardCharsets;
+import java.util.Optional;
+
+/**
+ * AgentPlugin to discover the RESTEasy Reactive endpoints of a Quarkus application
+ */
+public class QuarkusAgentPlugin implements AgentPlugin, CommandHandler {
+    private static final Logger log = RemoteAgentLogger.getLogger(QuarkusAgentPlugin.class);
+    private static final char BYTE_ORDER_MARK = '\ufeff';
+    private static final String MARKER_CLASS = "io.quarkus.runtime.Application";
+    private final Instrumentation instrumentation;
+    private final JaxRsEndpointScanner scanner = new JaxRsEndpointScanner();
+
+    public QuarkusAgentPlugin(Instrumentation instrumentation) {
+        this.instrumentation = instrumentation;
+    }
+
+    @Override
+    public boolean canHandle(String command) {
+        return command.equals("quarkus-endpoints") || command.equals("quarkus-application-name");
+    }
+
+    @Override
+    public void handle(String command, String argument, OutputStream os) {
+        if (command.equals("quarkus-endpoints")) {
+            PrintWriter writer = new PrintWriter(new OutputStreamWriter(os, StandardCharsets.UTF_8));
+            writer.write(RC_OK);
+            writer.write(BYTE_ORDER_MARK);
+            new JSONArray(this.scanner.scan(this.instrumentation.getAllLoadedClasses())).write(writer);
+            writer.flush();
+        } else {
+            PrintWriter writer = new PrintWriter(new OutputStreamWriter(os, StandardCharsets.UTF_8), true);
+            writer.write(RC_OK);
+            writer.println(this.getApplicationName());
+        }
+    }
+
+    /**
+     * Reads quarkus.application.name via MicroProfile Config of the application's classloader.
+     */
+    private String getApplicationName() {
+        try {
+            ClassLoader classLoader = Class.forName(MARKER_CLASS).getClassLoader();
+            Class<?> configProviderClass = Class.forName("org.eclipse.microprofile.config.ConfigProvider", true, classLoader);
+            Class<?> configClass = Class.forName("org.eclipse.microprofile.config.Config", true, classLoader);
+            Object config = configProviderClass.getMethod("getConfig", ClassLoader.class).invoke(null, classLoader);
+            Method getOptionalValue = configClass.getMethod("getOptionalValue", String.class, Class.class);
+            Optional<?> name = (Optional<?>) getOptionalValue.invoke(config, "quarkus.application.name", String.class);
+            return name.isPresent() ? name.get().toString() : "";
+        } catch (Exception e) {
+            log.debug("Could not read quarkus.application.name: " + e.getClass() + ": " + e.getMessage());
+            return "";
+        }
+    }
+}
@@ -5,9 +5,13 @@
 package com.steadybit.discovery.springboot.javaagent;
 
 import com.steadybit.discovery.springboot.javaagent.handlers.beans.BeanCommandHandler;
+import com.steadybit.discovery.springboot.javaagent.handlers.context.ApplicationContextLocator;
+import com.steadybit.discovery.springboot.javaagent.handlers.environment.EnvironmentCommandHandler;
 import com.steadybit.discovery.springboot.javaagent.handlers.httpclient.HttpClientCommandHandler;
 import com.steadybit.discovery.springboot.javaagent.handlers.httpclient.HttpClientRequestScanner;
 import com.steadybit.discovery.springboot.javaagent.handlers.mvc.HttpMappingsCommandHandler;
+import com.steadybit.discovery.springboot.javaagent.handlers.resilience.ResilienceCommandHandler;
+import com.steadybit.discovery.springboot.javaagent.handlers.resilience.ResilienceScanner;
 import com.steadybit.javaagent.AgentPlugin;
 import com.steadybit.javaagent.CommandHandler;
 
@@ -22,20 +26,27 @@ import java.util.List;
 public class SpringBootAgentPlugin implements AgentPlugin, CommandHandler {
     private final List<CommandHandler> commandHandlers;
     private final HttpClientRequestScanner httpClientRequestScanner;
+    private final ResilienceScanner resilienceScanner;
 
     public SpringBootAgentPlugin(Instrumentation instrumentation) {
         this.httpClientRequestScanner = new HttpClientRequestScanner(instrumentation);
-        this.commandHandlers = Arrays.asList(new HttpMappingsCommandHandler(), new BeanCommandHandler(), new HttpClientCommandHandler(this.httpClientRequestScanner::getRequests));
+        this.resilienceScanner = new ResilienceScanner(instrumentation);
+        ApplicationContextLocator applicationContextLocator = new ApplicationContextLocator();
+        this.commandHandlers = Arrays.asList(new HttpMappingsCommandHandler(applicationContextLocator), new BeanCommandHandler(applicationContextLocator),
+                new HttpClientCommandHandler(this.httpClientRequestScanner::getRequests),
+                new ResilienceCommandHandler(this.resilienceScanner::getComponents), new EnvironmentCommandHandler(applicationContextLocator));
     }
 
     @Override
     public void start() {
         this.httpClientRequestScanner.install();
+        this.resilienceScanner.install();
     }
 
     @Override
     public void destroy() {
         this.httpClientRequestScanner.reset();
+        this.resilienceScanner.reset();
     }
 
     @Override
@@ -0,0 +1,67 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.springboot.javaagent.handlers.beans;
+
+import java.util.ArrayList;
+import java.util.Arrays;
+import java.util.LinkedHashMap;
+import java.util.List;
+import java.util.Map;
+
+/**
+ * Well-known categories of beans, determined by the types they are assignable to. Types not present in the application
+ * are skipped.
+ */
+class BeanCategories {
+    private static final Map<String, List<String>> CATEGORIES = new LinkedHashMap<>();
+
+    static {
+        CATEGORIES.put("cache", Arrays.asList("org.springframework.cache.CacheManager", "org.springframework.cache.Cache"));
+        CATEGORIES.put("executor", Arrays.asList("org.springframework.scheduling.concurrent.ThreadPoolTaskExecutor", "java.util.concurrent.ThreadPoolExecutor"));
+        CATEGORIES.put("scheduler", Arrays.asList("org.springframework.scheduling.TaskScheduler", "java.util.concurrent.ScheduledExecutorService"));
+        CATEGORIES.put("message-listener", Arrays.asList("org.springframework.jms.listener.MessageListenerContainer",
+                "org.springframework.jms.config.JmsListenerEndpointRegistry", "org.springframework.kafka.listener.MessageListenerContainer",
+                "org.springframework.kafka.config.KafkaListenerEndpointRegistry", "org.springframework.amqp.rabbit.listener.MessageListenerContainer",
+                "org.springframework.amqp.rabbit.listener.RabbitListenerEndpointRegistry"));
+        CATEGORIES.put("http-client", Arrays.asList("org.springframework.web.client.RestTemplate", "org.springframework.boot.web.client.RestTemplateBuilder",
+                "org.springframework.web.client.RestClient", "org.springframework.web.reactive.function.client.WebClient",
+                "org.springframework.web.reactive.function.client.WebClient$Builder"));
+        CATEGORIES.put("jdbc-client", Arrays.asList("org.springframework.jdbc.core.JdbcTemplate",
+                "org.springframework.jdbc.core.namedparam.NamedParameterJdbcTemplate", "org.springframework.jdbc.core.simple.JdbcClient"));
+        CATEGORIES.put("messaging-client", Arrays.asList("org.springframework.kafka.core.KafkaOperations", "org.springframework.amqp.rabbit.core.RabbitOperations",
+                "org.springframework.jms.core.JmsOperations"));
+    }
+
+    private final Map<String, List<Class<?>>> categories = new LinkedHashMap<>();
+
+    BeanCategories(ClassLoader classLoader) {
+        for (Map.Entry<String, List<String>> category : CATEGORIES.entrySet()) {
+            List<Class<?>> types = new ArrayList<>();
+            for (String typeName : category.getValue()) {
+                try {
+                    types.add(Class.forName(typeName, false, classLoader));
+                } catch (ClassNotFoundException | LinkageError e) {
+                    //not used by the application
+                }
+            }
+            if (!types.isEmpty()) {
+                this.categories.put(category.getKey(), types);
+            }
+        }
+    }
+
+    List<String> categorize(Class<?> beanType) {
+        List<String> result = new ArrayList<>();
+        for (Map.Entry<String, List<Class<?>>> category : this.categories.entrySet()) {
+            for (Class<?> type : category.getValue()) {
+                if (type.isAssignableFrom(beanType)) {
+                    result.add(category.getKey());
+                    break;
+                }
+            }
+        }
+        return result;
+    }
+}
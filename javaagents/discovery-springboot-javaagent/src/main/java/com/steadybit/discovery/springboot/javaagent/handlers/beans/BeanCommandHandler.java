@@ -4,30 +4,47 @@
 
 package com.steadybit.discovery.springboot.javaagent.handlers.beans;
 
+import com.steadybit.discovery.springboot.javaagent.handlers.context.ApplicationContextLocator;
 import com.steadybit.javaagent.CommandHandler;
 import com.steadybit.javaagent.log.Logger;
 import com.steadybit.javaagent.log.RemoteAgentLogger;
+import org.json.JSONArray;
+import org.json.JSONObject;
 
 import java.io.OutputStream;
 import java.io.OutputStreamWriter;
 import java.io.PrintWriter;
 import java.nio.charset.StandardCharsets;
+import java.util.Collections;
+import java.util.List;
 
 public class BeanCommandHandler implements CommandHandler {
     private static final Logger log = RemoteAgentLogger.getLogger(BeanCommandHandler.class);
+    private static final char BYTE_ORDER_MARK = '\ufeff';
     private final JmxBeanReader jmxBeanReader;
+    private final ContextBeanReader contextBeanReader;
 
-    public BeanCommandHandler() {
+    public BeanCommandHandler(ApplicationContextLocator applicationContextLocator) {
         this.jmxBeanReader = new JmxBeanReader();
+        this.contextBeanReader = new ContextBeanReader(applicationContextLocator);
     }
 
     @Override
     public boolean canHandle(String command) {
-        return command.equals("spring-bean") || command.equals("spring-main-context");
+        return command.equals("spring-bean") || command.equals("spring-main-context") || command.equals("spring-bean-inventory");
     }
 
     @Override
     public void handle(String command, String argument, OutputStream os) {
+        if (command.equals("spring-bean-inventory")) {
+            PrintWriter writer = new PrintWriter(new OutputStreamWriter(os, StandardCharsets.UTF_8));
+            writer.write(RC_OK);
+            writer.write(BYTE_ORDER_MARK);
+            this.getBeanInventory().write(writer);
+            writer.flush();
+            return;
+        }
+
         Object result;
         if (command.equals("spring-bean")) {
             result = this.hasBeanOfType(argument);
@@ -57,4 +74,39 @@ public class BeanCommandHandler implements CommandHandler {
             return false;
         }
     }
+
+    /**
+     * Returns name, type, scope and categories of all beans, read from the actuator beans endpoint or, if not exposed,
+     * from the application context.
+     */
+    private JSONArray getBeanInventory() {
+        JSONArray result = new JSONArray();
+        BeanCategories categories = new BeanCategories(BeanCommandHandler.class.getClassLoader());
+        boolean actuator = this.jmxBeanReader.forEachBean((name, bean) -> {
+            String typeName = (String) bean.get("type");
+            result.put(describeBean(name, typeName, typeName != null ? loadClass(typeName) : null, (String) bean.get("scope"), categories));
+        });
+        if (!actuator) {
+            this.contextBeanReader.forEachBean((name, type, scope) -> result.put(describeBean(name, type != null ? type.getName() : null, type, scope, categories)));
+        }
+        return result;
+    }
+
+    private static JSONObject describeBean(String name, String typeName, Class<?> type, String scope, BeanCategories categories) {
+        JSONObject json = new JSONObject().put("name", name).putOpt("type", typeName).putOpt("scope", scope);
+        List<String> beanCategories = type != null ? categories.categorize(type) : Collections.emptyList();
+        if (!beanCategories.isEmpty()) {
+            json.put("categories", beanCategories);
+        }
+        return json;
+    }
+
+    private static Class<?> loadClass(String className) {
+        try {
+            return Class.forName(className, false, BeanCommandHandler.class.getClassLoader());
+        } catch (ClassNotFoundException | LinkageError e) {
+            log.trace("Could not find class " + className + " when categorizing bean: " + e.getMessage());
+            return null;
+        }
+    }
 }
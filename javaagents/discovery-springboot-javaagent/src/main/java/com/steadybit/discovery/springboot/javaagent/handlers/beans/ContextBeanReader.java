@@ -0,0 +1,51 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.springboot.javaagent.handlers.beans;
+
+import com.steadybit.discovery.springboot.javaagent.handlers.context.ApplicationContextLocator;
+import com.steadybit.javaagent.log.Logger;
+import com.steadybit.javaagent.log.RemoteAgentLogger;
+import org.springframework.beans.factory.config.BeanDefinition;
+import org.springframework.beans.factory.config.ConfigurableListableBeanFactory;
+import org.springframework.context.ApplicationContext;
+import org.springframework.context.ConfigurableApplicationContext;
+
+/**
+ * Reads the beans from the application contexts, for applications without actuator. Like the actuator beans endpoint,
+ * infrastructure beans and lazy beans not yet created are skipped.
+ */
+class ContextBeanReader {
+    private static final Logger log = RemoteAgentLogger.getLogger(ContextBeanReader.class);
+    private final ApplicationContextLocator applicationContextLocator;
+
+    ContextBeanReader(ApplicationContextLocator applicationContextLocator) {
+        this.applicationContextLocator = applicationContextLocator;
+    }
+
+    /**
+     * Passes name, type and scope of all beans of all contexts to the consumer.
+     */
+    void forEachBean(BeanConsumer consumer) {
+        for (ApplicationContext context : this.applicationContextLocator.getApplicationContexts()) {
+            try {
+                ConfigurableListableBeanFactory beanFactory = ((ConfigurableApplicationContext) context).getBeanFactory();
+                for (String name : beanFactory.getBeanDefinitionNames()) {
+                    BeanDefinition definition = beanFactory.getBeanDefinition(name);
+                    if (definition.getRole() == BeanDefinition.ROLE_INFRASTRUCTURE || (definition.isLazyInit() && !beanFactory.containsSingleton(name))) {
+                        continue;
+                    }
+                    String scope = definition.getScope() == null || definition.getScope().isEmpty() ? BeanDefinition.SCOPE_SINGLETON : definition.getScope();
+                    consumer.accept(name, beanFactory.getType(name, false), scope);
+                }
+            } catch (Exception e) {
+                log.debug("Could not read beans of application context " + context.getId() + ": " + e.getMessage());
+            }
+        }
+    }
+
+    interface BeanConsumer {
+        void accept(String name, Class<?> type, String scope);
+    }
+}
@@ -13,6 +13,7 @@ import javax.management.MBeanServer;
 import javax.management.ObjectName;
 import java.lang.management.ManagementFactory;
 import java.util.Map;
+import java.util.function.BiConsumer;
 
 public class JmxBeanReader {
     private static final Logger log = RemoteAgentLogger.getLogger(JmxBeanReader.class);
@@ -112,4 +113,37 @@ public class JmxBeanReader {
             return null;
         }
     }
+
+    /**
+     * Passes name and description of all beans of all contexts to the consumer.
+     *
+     * @return {@code false} if the actuator beans endpoint is not exposed via jmx.
+     */
+    public boolean forEachBean(BiConsumer<String, Map<?, ?>> consumer) {
+        try {
+            Map<?, ?> result = (Map<?, ?>) this.mBeanServer.invoke(this.objectName, "beans", new Object[0], new String[0]);
+            Map<?, ?> contexts = result != null ? (Map<?, ?>) result.get("contexts") : null;
+            if (contexts == null) {
+                return true;
+            }
+
+            for (Object context : contexts.values()) {
+                Map<?, ?> beans = (Map<?, ?>) ((Map<?, ?>) context).get("beans");
+                if (beans == null) {
+                    continue;
+                }
+
+                for (Map.Entry<?, ?> beanEntry : beans.entrySet()) {
+                    consumer.accept((String) beanEntry.getKey(), (Map<?, ?>) beanEntry.getValue());
+                }
+            }
+            return true;
+        } catch (InstanceNotFoundException ex) {
+            log.trace("Could not read beans: MBean {} not found", this.objectName);
+            return false;
+        } catch (Exception e) {
+            log.debug("Could not read beans: " + e.getClass() + ": " + e.getMessage());
+            return true;
+        }
+    }
 }
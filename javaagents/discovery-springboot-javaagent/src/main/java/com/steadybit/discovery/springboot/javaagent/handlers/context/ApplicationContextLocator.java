@@ -0,0 +1,58 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.springboot.javaagent.handlers.context;
+
+import com.steadybit.javaagent.log.Logger;
+import com.steadybit.javaagent.log.RemoteAgentLogger;
+import org.springframework.context.ApplicationContext;
+import org.springframework.context.ConfigurableApplicationContext;
+
+import java.lang.reflect.Field;
+import java.util.ArrayList;
+import java.util.Collection;
+import java.util.Collections;
+import java.util.List;
+
+/**
+ * Locates the application contexts of a running Spring Boot application, for applications not exposing the actuator
+ * endpoints.
+ * <p>
+ * The plugin is loaded after the application has been started, so the contexts can't be captured on creation. Instead,
+ * they are read from the shutdown hook Spring Boot (2.5+) registers them with, unless it is disabled via
+ * {@code spring.main.register-shutdown-hook=false}.
+ */
+public class ApplicationContextLocator {
+    private static final Logger log = RemoteAgentLogger.getLogger(ApplicationContextLocator.class);
+
+    public List<ApplicationContext> getApplicationContexts() {
+        try {
+            Class<?> springApplication = Class.forName("org.springframework.boot.SpringApplication", false, ApplicationContext.class.getClassLoader());
+            Object shutdownHook = readField(springApplication, null, "shutdownHook");
+            if (shutdownHook == null) {
+                return Collections.emptyList();
+            }
+
+            List<ApplicationContext> result = new ArrayList<>();
+            // the hook guards the registered contexts by its class
+            synchronized (shutdownHook.getClass()) {
+                for (Object context : (Collection<?>) readField(shutdownHook.getClass(), shutdownHook, "contexts")) {
+                    if (context instanceof ConfigurableApplicationContext && ((ConfigurableApplicationContext) context).isActive()) {
+                        result.add((ApplicationContext) context);
+                    }
+                }
+            }
+            return result;
+        } catch (Exception | LinkageError e) {
+            log.trace("Could not locate spring application contexts", e);
+            return Collections.emptyList();
+        }
+    }
+
+    private static Object readField(Class<?> type, Object target, String name) throws ReflectiveOperationException {
+        Field field = type.getDeclaredField(name);
+        field.setAccessible(true);
+        return field.get(target);
+    }
+}
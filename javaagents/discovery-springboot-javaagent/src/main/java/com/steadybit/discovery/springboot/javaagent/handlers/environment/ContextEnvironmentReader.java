@@ -0,0 +1,54 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.springboot.javaagent.handlers.environment;
+
+import com.steadybit.discovery.springboot.javaagent.handlers.context.ApplicationContextLocator;
+import com.steadybit.javaagent.log.Logger;
+import com.steadybit.javaagent.log.RemoteAgentLogger;
+import org.springframework.context.ApplicationContext;
+
+import java.util.ArrayList;
+import java.util.Collections;
+import java.util.LinkedHashSet;
+import java.util.List;
+import java.util.Set;
+
+/**
+ * Reads the active profiles and properties from the environment of the application contexts. Unlike the Env actuator
+ * endpoint, the values are not sanitized.
+ */
+public class ContextEnvironmentReader {
+    private static final Logger log = RemoteAgentLogger.getLogger(ContextEnvironmentReader.class);
+    private final ApplicationContextLocator applicationContextLocator;
+
+    public ContextEnvironmentReader(ApplicationContextLocator applicationContextLocator) {
+        this.applicationContextLocator = applicationContextLocator;
+    }
+
+    public List<String> getActiveProfiles() {
+        Set<String> profiles = new LinkedHashSet<>();
+        for (ApplicationContext context : this.applicationContextLocator.getApplicationContexts()) {
+            Collections.addAll(profiles, context.getEnvironment().getActiveProfiles());
+        }
+        return new ArrayList<>(profiles);
+    }
+
+    /**
+     * Returns the value of the property from the first application context it is set in.
+     */
+    public String getProperty(String name) {
+        for (ApplicationContext context : this.applicationContextLocator.getApplicationContexts()) {
+            try {
+                String value = context.getEnvironment().getProperty(name);
+                if (value != null) {
+                    return value;
+                }
+            } catch (Exception e) {
+                log.debug("Could not read property " + name + " of application context " + context.getId() + ": " + e.getMessage());
+            }
+        }
+        return null;
+    }
+}
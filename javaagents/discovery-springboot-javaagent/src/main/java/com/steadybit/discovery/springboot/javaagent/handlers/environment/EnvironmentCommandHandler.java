@@ -0,0 +1,97 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.springboot.javaagent.handlers.environment;
+
+import com.steadybit.discovery.springboot.javaagent.handlers.context.ApplicationContextLocator;
+import com.steadybit.javaagent.CommandHandler;
+import com.steadybit.javaagent.log.Logger;
+import com.steadybit.javaagent.log.RemoteAgentLogger;
+import org.json.JSONObject;
+
+import java.io.OutputStream;
+import java.io.OutputStreamWriter;
+import java.io.PrintWriter;
+import java.nio.charset.StandardCharsets;
+import java.util.Map;
+
+/**
+ * Returns the versions, active profiles, ports and build info of the application. Profiles and ports are read from the
+ * environment of the application contexts, the build info from the Info actuator endpoint.
+ */
+public class EnvironmentCommandHandler implements CommandHandler {
+    private static final Logger log = RemoteAgentLogger.getLogger(EnvironmentCommandHandler.class);
+    private static final char BYTE_ORDER_MARK = '\ufeff';
+    private final ContextEnvironmentReader environmentReader;
+    private final JmxInfoReader infoReader;
+
+    public EnvironmentCommandHandler(ApplicationContextLocator applicationContextLocator) {
+        this(new ContextEnvironmentReader(applicationContextLocator), new JmxInfoReader());
+    }
+
+    EnvironmentCommandHandler(ContextEnvironmentReader environmentReader, JmxInfoReader infoReader) {
+        this.environmentReader = environmentReader;
+        this.infoReader = infoReader;
+    }
+
+    @Override
+    public boolean canHandle(String command) {
+        return command.equals("spring-environment");
+    }
+
+    @Override
+    public void handle(String command, String argument, OutputStream os) {
+        PrintWriter writer = new PrintWriter(new OutputStreamWriter(os, StandardCharsets.UTF_8));
+        writer.write(RC_OK);
+        writer.write(BYTE_ORDER_MARK);
+        this.getEnvironment().write(writer);
+        writer.flush();
+    }
+
+    private JSONObject getEnvironment() {
+        JSONObject result = new JSONObject();
+        result.putOpt("bootVersion", getVersion("org.springframework.boot.SpringBootVersion"));
+        result.putOpt("frameworkVersion", getVersion("org.springframework.core.SpringVersion"));
+
+        result.put("activeProfiles", this.environmentReader.getActiveProfiles());
+        // the local.* properties contain the actual ports, e.g. when started with port 0
+        result.putOpt("serverPort", firstNonNull(this.environmentReader.getProperty("local.server.port"),
+                this.environmentReader.getProperty("server.port")));
+        result.putOpt("managementPort", firstNonNull(this.environmentReader.getProperty("local.management.port"),
+                this.environmentReader.getProperty("management.server.port")));
+
+        Map<?, ?> info = this.infoReader.getInfo();
+        result.putOpt("gitCommit", getGitCommit(info));
+        Object buildVersion = JmxInfoReader.getInfoValue(info, "build.version");
+        result.putOpt("buildVersion", buildVersion != null ? buildVersion.toString() : null);
+        return result;
+    }
+
+    static String getGitCommit(Map<?, ?> info) {
+        Object id = JmxInfoReader.getInfoValue(info, "git.commit.id");
+        if (id instanceof Map) {
+            // with management.info.git.mode=full the id is split into abbrev, full and describe
+            Object abbrev = ((Map<?, ?>) id).get("abbrev");
+            return abbrev != null ? abbrev.toString() : null;
+        }
+        return id != null ? id.toString() : null;
+    }
+
+    private static String getVersion(String className) {
+        try {
+            Object version = Class.forName(className).getMethod("getVersion").invoke(null);
+            return version != null ? version.toString() : null;
+        } catch (ClassNotFoundException e) {
+            log.trace("Could not find class " + className + " when reading version");
+            return null;
+        } catch (Exception e) {
+            log.debug("Could not read version from " + className + ": " + e.getClass() + ": " + e.getMessage());
+            return null;
+        }
+    }
+
+    private static String firstNonNull(String a, String b) {
+        return a != null ? a : b;
+    }
+}
@@ -0,0 +1,62 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.springboot.javaagent.handlers.environment;
+
+import com.steadybit.javaagent.log.Logger;
+import com.steadybit.javaagent.log.RemoteAgentLogger;
+
+import javax.management.InstanceNotFoundException;
+import javax.management.MBeanServer;
+import javax.management.MalformedObjectNameException;
+import javax.management.ObjectName;
+import java.lang.management.ManagementFactory;
+import java.util.Map;
+
+/**
+ * Reads the info of the application via the Info actuator endpoint.
+ */
+public class JmxInfoReader {
+    private static final Logger log = RemoteAgentLogger.getLogger(JmxInfoReader.class);
+    private final MBeanServer mBeanServer = ManagementFactory.getPlatformMBeanServer();
+    private final ObjectName infoObjectName;
+
+    public JmxInfoReader() {
+        try {
+            this.infoObjectName = new ObjectName("org.springframework.boot:type=Endpoint,name=Info");
+        } catch (MalformedObjectNameException e) {
+            throw new RuntimeException("Could not create ObjectName for MBeans", e);
+        }
+    }
+
+    public Map<?, ?> getInfo() {
+        try {
+            Map<?, ?> result = (Map<?, ?>) this.mBeanServer.invoke(this.infoObjectName, "info", new Object[0], new String[0]);
+            if (log.isTraceEnabled()) {
+                log.trace("{}#info() result: {}", this.infoObjectName, result);
+            }
+            return result;
+        } catch (InstanceNotFoundException ex) {
+            log.trace("Could not read info: MBean {} not found", this.infoObjectName);
+            return null;
+        } catch (Exception e) {
+            log.debug("Could not read info: " + e.getClass() + ": " + e.getMessage());
+            return null;
+        }
+    }
+
+    /**
+     * Returns the value of a nested key of the info, e.g. "build.version".
+     */
+    public static Object getInfoValue(Map<?, ?> info, String path) {
+        Object value = info;
+        for (String key : path.split("\\.")) {
+            if (!(value instanceof Map)) {
+                return null;
+            }
+            value = ((Map<?, ?>) value).get(key);
+        }
+        return value;
+    }
+}
@@ -0,0 +1,102 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.springboot.javaagent.handlers.mvc;
+
+import com.steadybit.discovery.springboot.javaagent.handlers.context.ApplicationContextLocator;
+import com.steadybit.javaagent.log.Logger;
+import com.steadybit.javaagent.log.RemoteAgentLogger;
+import org.json.JSONArray;
+import org.json.JSONObject;
+import org.springframework.asm.Type;
+import org.springframework.context.ApplicationContext;
+import org.springframework.web.method.HandlerMethod;
+import org.springframework.web.servlet.mvc.condition.MediaTypeExpression;
+import org.springframework.web.servlet.mvc.condition.NameValueExpression;
+import org.springframework.web.servlet.mvc.method.RequestMappingInfo;
+import org.springframework.web.servlet.mvc.method.annotation.RequestMappingHandlerMapping;
+
+import java.util.Collection;
+import java.util.List;
+import java.util.Map;
+import java.util.stream.Collectors;
+
+/**
+ * Describes the Spring MVC mappings by reading the {@code RequestMappingHandlerMapping} beans from the application
+ * contexts, in the same format as {@link JmxMappingDescriptionProvider}. Used for applications without actuator.
+ */
+public class ContextMappingDescriptionProvider {
+    private static final Logger log = RemoteAgentLogger.getLogger(ContextMappingDescriptionProvider.class);
+    private static final String HANDLER_MAPPING_CLASS = "org.springframework.web.servlet.mvc.method.annotation.RequestMappingHandlerMapping";
+    private final ApplicationContextLocator applicationContextLocator;
+
+    public ContextMappingDescriptionProvider(ApplicationContextLocator applicationContextLocator) {
+        this.applicationContextLocator = applicationContextLocator;
+    }
+
+    /**
+     * @return whether an application context has been found.
+     */
+    public boolean describeMappings(JSONArray result) {
+        List<ApplicationContext> contexts = this.applicationContextLocator.getApplicationContexts();
+        if (contexts.isEmpty()) {
+            return false;
+        }
+
+        if (!isWebMvcPresent()) {
+            return true;
+        }
+
+        for (ApplicationContext context : contexts) {
+            try {
+                for (RequestMappingHandlerMapping handlerMapping : context.getBeansOfType(RequestMappingHandlerMapping.class).values()) {
+                    for (Map.Entry<RequestMappingInfo, HandlerMethod> entry : handlerMapping.getHandlerMethods().entrySet()) {
+                        result.put(describeMapping(entry.getKey(), entry.getValue()));
+                    }
+                }
+            } catch (Exception | LinkageError e) {
+                log.trace("Could not read spring mvc mappings from application context " + context.getId(), e);
+            }
+        }
+        return true;
+    }
+
+    static JSONObject describeMapping(RequestMappingInfo info, HandlerMethod handlerMethod) {
+        JSONObject json = new JSONObject();
+        json.put("handlerClass", handlerMethod.getMethod().getDeclaringClass().getName());
+        json.put("handlerName", handlerMethod.getMethod().getName());
+        json.put("handlerDescriptor", Type.getMethodDescriptor(handlerMethod.getMethod()));
+
+        JmxMappingDescriptionProvider.putNotEmpty(json, "consumes", mediaTypeAsStringList(info.getConsumesCondition().getExpressions()));
+        JmxMappingDescriptionProvider.putNotEmpty(json, "headers", nameValueAsStringList(info.getHeadersCondition().getExpressions()));
+        JmxMappingDescriptionProvider.putNotEmpty(json, "methods", info.getMethodsCondition().getMethods().stream().map(Enum::name).collect(Collectors.toList()));
+        JmxMappingDescriptionProvider.putNotEmpty(json, "params", nameValueAsStringList(info.getParamsCondition().getExpressions()));
+        JmxMappingDescriptionProvider.putNotEmpty(json, "patterns", info.getPatternValues());
+        JmxMappingDescriptionProvider.putNotEmpty(json, "produces", mediaTypeAsStringList(info.getProducesCondition().getExpressions()));
+        return json;
+    }
+
+    private static Collection<String> mediaTypeAsStringList(Collection<? extends MediaTypeExpression> expressions) {
+        return expressions.stream().map(e -> (e.isNegated() ? "!" : "") + e.getMediaType()).collect(Collectors.toList());
+    }
+
+    private static Collection<String> nameValueAsStringList(Collection<? extends NameValueExpression<String>> expressions) {
+        return expressions.stream().map(e -> {
+            if (e.getValue() != null) {
+                return e.getName() + (e.isNegated() ? "!=" : "=") + e.getValue();
+            } else {
+                return (e.isNegated() ? "!" : "") + e.getName();
+            }
+        }).collect(Collectors.toList());
+    }
+
+    private static boolean isWebMvcPresent() {
+        try {
+            Class.forName(HANDLER_MAPPING_CLASS, false, ApplicationContext.class.getClassLoader());
+            return true;
+        } catch (ClassNotFoundException | LinkageError e) {
+            return false;
+        }
+    }
+}
@@ -4,24 +4,31 @@
 
 package com.steadybit.discovery.springboot.javaagent.handlers.mvc;
 
-import com.steadybit.discovery.springboot.javaagent.handlers.mvc.JmxMappingDescriptionProvider;
+import com.steadybit.discovery.springboot.javaagent.handlers.context.ApplicationContextLocator;
 import com.steadybit.javaagent.CommandHandler;
 import org.json.JSONArray;
-import org.springframework.context.ApplicationContext;
+import org.json.JSONObject;
 
 import java.io.OutputStream;
 import java.io.OutputStreamWriter;
 import java.io.PrintWriter;
 import java.nio.charset.StandardCharsets;
-import java.util.Collection;
-import java.util.function.Supplier;
 
+/**
+ * Reports the mappings from the actuator mappings endpoint or, if not exposed, from the application context. The mode
+ * used is reported along with the mappings.
+ */
 public class HttpMappingsCommandHandler implements CommandHandler {
+    static final String MODE_ACTUATOR = "actuator";
+    static final String MODE_APPLICATION_CONTEXT = "application-context";
+    static final String MODE_UNAVAILABLE = "unavailable";
     private static final char BYTE_ORDER_MARK = '\ufeff';
     private final JmxMappingDescriptionProvider jmxMappingDescriptionProvider;
+    private final ContextMappingDescriptionProvider contextMappingDescriptionProvider;
 
-    public HttpMappingsCommandHandler() {
+    public HttpMappingsCommandHandler(ApplicationContextLocator applicationContextLocator) {
         this.jmxMappingDescriptionProvider = new JmxMappingDescriptionProvider();
+        this.contextMappingDescriptionProvider = new ContextMappingDescriptionProvider(applicationContextLocator);
     }
 
     @Override
@@ -33,12 +40,20 @@ public class HttpMappingsCommandHandler implements CommandHandler {
     public void handle(String command, String argument, OutputStream os) {
         JSONArray mappings = new JSONArray();
 
-        this.jmxMappingDescriptionProvider.describeMappings(mappings);
+        String mode;
+        if (this.jmxMappingDescriptionProvider.isAvailable()) {
+            mode = MODE_ACTUATOR;
+            this.jmxMappingDescriptionProvider.describeMappings(mappings);
+        } else if (this.contextMappingDescriptionProvider.describeMappings(mappings)) {
+            mode = MODE_APPLICATION_CONTEXT;
+        } else {
+            mode = MODE_UNAVAILABLE;
+        }
 
         PrintWriter writer = new PrintWriter(new OutputStreamWriter(os, StandardCharsets.UTF_8));
         writer.write(RC_OK);
         writer.write(BYTE_ORDER_MARK);
-        mappings.write(writer);
+        new JSONObject().put("mode", mode).put("mappings", mappings).write(writer);
         writer.flush();
     }
 }
@@ -31,6 +31,13 @@ public class JmxMappingDescriptionProvider {
         }
     }
 
+    /**
+     * @return whether the actuator mappings endpoint is exposed via jmx.
+     */
+    public boolean isAvailable() {
+        return this.mBeanServer.isRegistered(this.objectName);
+    }
+
     @SuppressWarnings("unchecked")
     public void describeMappings(JSONArray result) {
         try {
@@ -90,6 +97,16 @@ public class JmxMappingDescriptionProvider {
                 json.put("handlerDescriptor", handlerMethod.get("descriptor"));
             }
 
+            Map<?, ?> handlerFunction = (Map<?, ?>) details.get("handlerFunction");
+            if (handlerFunction != null) {
+                // webflux router functions only describe their conditions as predicate string, e.g. "(GET && /users)"
+                RouterFunctionPredicate predicate = RouterFunctionPredicate.parse((String) mapping.get("predicate"));
+                json.put("handlerClass", handlerFunction.get("className"));
+                json.put("routerFunction", true);
+                putNotEmpty(json, "methods", predicate.getMethods());
+                putNotEmpty(json, "patterns", predicate.getPatterns());
+            }
+
             Map<?, ?> requestMappingConditions = (Map<?, ?>) details.get("requestMappingConditions");
             if (requestMappingConditions != null) {
                 putNotEmpty(json, "consumes", this.mediaTypeAsStringList((Collection<Map<?, ?>>) requestMappingConditions.get("consumes")));
@@ -126,7 +143,7 @@ public class JmxMappingDescriptionProvider {
         }).collect(Collectors.toList());
     }
 
-    private static void putNotEmpty(JSONObject jsonObject, String key, Object value) {
+    static void putNotEmpty(JSONObject jsonObject, String key, Object value) {
         if (value != null && (!(value instanceof Collection) || !((Collection<?>) value).isEmpty())) {
             jsonObject.put(key, value);
         }
@@ -0,0 +1,56 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.springboot.javaagent.handlers.mvc;
+
+import java.util.ArrayList;
+import java.util.Arrays;
+import java.util.List;
+
+/**
+ * Extracts the http methods and path patterns of a WebFlux router function from the string representation of its
+ * {@code RequestPredicate}, as reported by the actuator mappings endpoint, e.g. {@code ((GET && /users/{id}) && Accept:
+ * application/json)}. Negated conditions and conditions other than methods and paths are ignored.
+ */
+class RouterFunctionPredicate {
+    private static final List<String> HTTP_METHODS = Arrays.asList("GET", "HEAD", "POST", "PUT", "PATCH", "DELETE", "OPTIONS", "TRACE");
+    private final List<String> methods = new ArrayList<>();
+    private final List<String> patterns = new ArrayList<>();
+
+    static RouterFunctionPredicate parse(String predicate) {
+        RouterFunctionPredicate result = new RouterFunctionPredicate();
+        if (predicate == null) {
+            return result;
+        }
+
+        for (String condition : predicate.replace('(', ' ').replace(')', ' ').split("&&|\\|\\|")) {
+            condition = condition.trim();
+            if (condition.startsWith("/")) {
+                addIfMissing(result.patterns, condition);
+            } else if (!condition.startsWith("!")) {
+                // multiple methods are reported as set, e.g. "[GET, HEAD]"
+                for (String method : condition.replace('[', ' ').replace(']', ' ').split(",")) {
+                    if (HTTP_METHODS.contains(method.trim())) {
+                        addIfMissing(result.methods, method.trim());
+                    }
+                }
+            }
+        }
+        return result;
+    }
+
+    private static void addIfMissing(List<String> list, String value) {
+        if (!list.contains(value)) {
+            list.add(value);
+        }
+    }
+
+    List<String> getMethods() {
+        return this.methods;
+    }
+
+    List<String> getPatterns() {
+        return this.patterns;
+    }
+}
@@ -0,0 +1,17 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.springboot.javaagent.handlers.resilience;
+
+import com.steadybit.javaagent.instrumentation.InstrumentationPluginDispatcher;
+import com.steadybit.javaagent.instrumentation.Registration;
+import com.steadybit.shaded.net.bytebuddy.asm.Advice;
+
+public class Resilience4jAspectAdvice {
+    @Advice.OnMethodEnter(suppress = Throwable.class)
+    static void enter(@Registration int registration, @Advice.This Object aspect, @Advice.Argument(0) Object joinPoint,
+                      @Advice.Argument(1) Object annotation) {
+        InstrumentationPluginDispatcher.find(registration).exec(1, aspect, joinPoint, annotation);
+    }
+}
@@ -0,0 +1,63 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.springboot.javaagent.handlers.resilience;
+
+import com.steadybit.javaagent.CommandHandler;
+import org.json.JSONArray;
+import org.json.JSONObject;
+
+import java.io.OutputStream;
+import java.io.OutputStreamWriter;
+import java.io.PrintWriter;
+import java.nio.charset.StandardCharsets;
+import java.util.Collection;
+import java.util.function.Supplier;
+import java.util.stream.Collectors;
+
+public class ResilienceCommandHandler implements CommandHandler {
+    private static final char BYTE_ORDER_MARK = '\ufeff';
+
+    private final Supplier<Collection<ResilienceComponent>> componentSupplier;
+
+    public ResilienceCommandHandler(Supplier<Collection<ResilienceComponent>> componentSupplier) {
+        this.componentSupplier = componentSupplier;
+    }
+
+    @Override
+    public boolean canHandle(String command) {
+        return command.equals("spring-resilience");
+    }
+
+    @Override
+    public void handle(String command, String argument, OutputStream os) {
+        JSONArray json = new JSONArray(this.componentSupplier.get().stream().map(this::toJson).collect(Collectors.toList()));
+
+        PrintWriter writer = new PrintWriter(new OutputStreamWriter(os, StandardCharsets.UTF_8));
+        writer.write(RC_OK);
+        writer.write(BYTE_ORDER_MARK);
+        json.write(writer);
+        writer.flush();
+    }
+
+    private JSONObject toJson(ResilienceComponent component) {
+        JSONObject json = new JSONObject();
+        json.put("type", component.getType());
+        json.put("name", component.getName());
+        json.put("library", component.getLibrary());
+        if (component.getMethod() != null) {
+            json.put("method", component.getMethod());
+        }
+        if (component.getFallback() != null) {
+            json.put("fallback", component.getFallback());
+        }
+        if (component.getMaxAttempts() != null) {
+            json.put("maxAttempts", component.getMaxAttempts());
+        }
+        if (component.getTimeout() != null) {
+            json.put("timeout", component.getTimeout());
+        }
+        return json;
+    }
+}
@@ -0,0 +1,57 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.springboot.javaagent.handlers.resilience;
+
+/**
+ * A resilience pattern (circuit breaker, retry, bulkhead, time limiter or rate limiter) applied to a method of the
+ * application.
+ */
+public class ResilienceComponent {
+    private final String type;
+    private final String name;
+    private final String library;
+    private final String method;
+    private final String fallback;
+    private final Integer maxAttempts;
+    private final Long timeout;
+
+    public ResilienceComponent(String type, String name, String library, String method, String fallback, Integer maxAttempts, Long timeout) {
+        this.type = type;
+        this.name = name;
+        this.library = library;
+        this.method = method;
+        this.fallback = fallback;
+        this.maxAttempts = maxAttempts;
+        this.timeout = timeout;
+    }
+
+    public String getType() {
+        return this.type;
+    }
+
+    public String getName() {
+        return this.name;
+    }
+
+    public String getLibrary() {
+        return this.library;
+    }
+
+    public String getMethod() {
+        return this.method;
+    }
+
+    public String getFallback() {
+        return this.fallback;
+    }
+
+    public Integer getMaxAttempts() {
+        return this.maxAttempts;
+    }
+
+    public Long getTimeout() {
+        return this.timeout;
+    }
+}
@@ -0,0 +1,184 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.springboot.javaagent.handlers.resilience;
+
+import com.steadybit.discovery.springboot.javaagent.instrumentation.ClassTransformationPlugin;
+import com.steadybit.javaagent.instrumentation.Registration;
+import com.steadybit.javaagent.log.Logger;
+import com.steadybit.javaagent.log.RemoteAgentLogger;
+import com.steadybit.shaded.net.bytebuddy.agent.builder.AgentBuilder;
+import com.steadybit.shaded.net.bytebuddy.asm.Advice;
+import com.steadybit.shaded.net.bytebuddy.description.method.MethodDescription;
+import com.steadybit.shaded.net.bytebuddy.matcher.ElementMatcher;
+
+import java.lang.instrument.Instrumentation;
+import java.lang.reflect.Field;
+import java.lang.reflect.Method;
+import java.time.Duration;
+import java.util.Collection;
+import java.util.concurrent.ConcurrentHashMap;
+
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.declaresMethod;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.isAbstract;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.nameEndsWith;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.nameStartsWith;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.named;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.not;
+import static com.steadybit.shaded.net.bytebuddy.matcher.ElementMatchers.takesArguments;
+
+/**
+ * Records the resilience patterns applied by the Resilience4j annotations ({@code @CircuitBreaker}, {@code @Retry},
+ * {@code @Bulkhead}, {@code @TimeLimiter} and {@code @RateLimiter}) and by Spring Retry.
+ * <p>
+ * The plugin is loaded after the application has been started, so the patterns are recorded when used instead of when
+ * created.
+ */
+public class ResilienceScanner extends ClassTransformationPlugin {
+    private static final Logger log = RemoteAgentLogger.getLogger(ResilienceScanner.class);
+    private final ConcurrentHashMap<String, ResilienceComponent> components = new ConcurrentHashMap<>();
+    private final ElementMatcher<MethodDescription> aroundAdviceMethod = nameEndsWith("AroundAdvice").and(takesArguments(2)).and(not(isAbstract()));
+    private final ElementMatcher<MethodDescription> doExecuteMethod = named("doExecute").and(takesArguments(3)).and(not(isAbstract()));
+
+    public ResilienceScanner(Instrumentation instrumentation) {
+        super(instrumentation);
+    }
+
+    @Override
+    protected AgentBuilder doInstall(AgentBuilder agentBuilder) {
+        return agentBuilder
+                //For the Resilience4j annotations
+                .type(nameStartsWith("io.github.resilience4j.").and(nameEndsWith("Aspect")).and(declaresMethod(this.aroundAdviceMethod)))
+                .transform(new AgentBuilder.Transformer.ForAdvice(Advice.withCustomMapping()//
+                        .bind(Registration.class, this.getRegistration()))//
+                        .include(Resilience4jAspectAdvice.class.getClassLoader()) //
+                        .advice(this.aroundAdviceMethod, Resilience4jAspectAdvice.class.getName()))
+
+                //For Spring Retry, used by @Retryable as well
+                .type(named("org.springframework.retry.support.RetryTemplate"))
+                .transform(new AgentBuilder.Transformer.ForAdvice(Advice.withCustomMapping()//
+                        .bind(Registration.class, this.getRegistration()))//
+                        .include(SpringRetryAdvice.class.getClassLoader()) //
+                        .advice(this.doExecuteMethod, SpringRetryAdvice.class.getName()));
+    }
+
+    public Collection<ResilienceComponent> getComponents() {
+        return this.components.values();
+    }
+
+    @Override
+    public Object exec(int code, Object arg1, Object arg2, Object arg3) {
+        try {
+            if (code == 1 && arg3 != null) {
+                this.scanResilience4jAnnotation(arg1, arg2, arg3);
+            } else if (code == 2) {
+                this.scanRetryTemplate(arg1, arg2, arg3);
+            }
+        } catch (Exception e) {
+            log.debug("Could not read resilience pattern: " + e.getMessage());
+        }
+        return null;
+    }
+
+    void scanResilience4jAnnotation(Object aspect, Object joinPoint, Object annotation) throws ReflectiveOperationException {
+        String type = toType(annotation.annotationType().getSimpleName());
+        String name = (String) annotation.annotationType().getMethod("name").invoke(annotation);
+        String key = "resilience4j/" + type + "/" + name;
+        if (this.components.containsKey(key)) {
+            return;
+        }
+
+        String fallback = null;
+        try {
+            fallback = emptyToNull((String) annotation.annotationType().getMethod("fallbackMethod").invoke(annotation));
+        } catch (NoSuchMethodException e) {
+            //not all annotations support fallbacks
+        }
+
+        Integer maxAttempts = null;
+        Long timeout = null;
+        if ("retry".equals(type)) {
+            Object config = invoke(invoke(readField(aspect, "retryRegistry"), "retry", name), "getRetryConfig");
+            maxAttempts = config != null ? (Integer) invoke(config, "getMaxAttempts") : null;
+        } else if ("time-limiter".equals(type)) {
+            Object config = invoke(invoke(readField(aspect, "timeLimiterRegistry"), "timeLimiter", name), "getTimeLimiterConfig");
+            Object duration = config != null ? invoke(config, "getTimeoutDuration") : null;
+            timeout = duration instanceof Duration ? ((Duration) duration).toMillis() : null;
+        }
+
+        this.components.put(key, new ResilienceComponent(type, name, "resilience4j", describeJoinPoint(joinPoint), fallback, maxAttempts, timeout));
+    }
+
+    void scanRetryTemplate(Object retryTemplate, Object retryCallback, Object recoveryCallback) {
+        String name = retryCallback != null ? (String) invoke(retryCallback, "getLabel") : null;
+        if (name == null) {
+            name = retryCallback != null ? retryCallback.getClass().getName() : retryTemplate.getClass().getName();
+        }
+        String key = "spring-retry/" + name;
+        if (this.components.containsKey(key)) {
+            return;
+        }
+
+        Object maxAttempts = invoke(readField(retryTemplate, "retryPolicy"), "getMaxAttempts");
+        this.components.put(key, new ResilienceComponent("retry", name, "spring-retry", null, recoveryCallback != null ? "recovery callback" : null,
+                maxAttempts instanceof Integer ? (Integer) maxAttempts : null, null));
+    }
+
+    private static String toType(String annotation) {
+        switch (annotation) {
+            case "CircuitBreaker":
+                return "circuit-breaker";
+            case "TimeLimiter":
+                return "time-limiter";
+            case "RateLimiter":
+                return "rate-limiter";
+            default:
+                return annotation.toLowerCase();
+        }
+    }
+
+    private static String describeJoinPoint(Object joinPoint) {
+        Object signature = invoke(joinPoint, "getSignature");
+        if (signature == null) {
+            return null;
+        }
+        return invoke(signature, "getDeclaringTypeName") + "#" + invoke(signature, "getName");
+    }
+
+    private static String emptyToNull(String s) {
+        return s == null || s.isEmpty() ? null : s;
+    }
+
+    private static Object readField(Object target, String name) {
+        for (Class<?> type = target.getClass(); type != null; type = type.getSuperclass()) {
+            try {
+                Field field = type.getDeclaredField(name);
+                field.setAccessible(true);
+                return field.get(target);
+            } catch (NoSuchFieldException e) {
+                //try the superclass
+            } catch (IllegalAccessException e) {
+                return null;
+            }
+        }
+        return null;
+    }
+
+    private static Object invoke(Object target, String name, Object... args) {
+        if (target == null) {
+            return null;
+        }
+        try {
+            Class<?>[] types = new Class<?>[args.length];
+            for (int i = 0; i < args.length; i++) {
+                types[i] = args[i].getClass();
+            }
+            Method method = target.getClass().getMethod(name, types);
+            method.setAccessible(true);
+            return method.invoke(target, args);
+        } catch (Exception e) {
+            return null;
+        }
+    }
+}
@@ -0,0 +1,17 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.springboot.javaagent.handlers.resilience;
+
+import com.steadybit.javaagent.instrumentation.InstrumentationPluginDispatcher;
+import com.steadybit.javaagent.instrumentation.Registration;
+import com.steadybit.shaded.net.bytebuddy.asm.Advice;
+
+public class SpringRetryAdvice {
+    @Advice.OnMethodEnter(suppress = Throwable.class)
+    static void enter(@Registration int registration, @Advice.This Object retryTemplate, @Advice.Argument(0) Object retryCallback,
+                      @Advice.Argument(1) Object recoveryCallback) {
+        InstrumentationPluginDispatcher.find(registration).exec(2, retryTemplate, retryCallback, recoveryCallback);
+    }
+}
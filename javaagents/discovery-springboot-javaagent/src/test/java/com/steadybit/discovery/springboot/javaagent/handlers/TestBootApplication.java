@@ -5,8 +5,14 @@
 package com.steadybit.discovery.springboot.javaagent.handlers;
 
 import org.springframework.boot.autoconfigure.SpringBootApplication;
+import org.springframework.context.annotation.Bean;
 import org.springframework.web.bind.annotation.GetMapping;
 import org.springframework.web.bind.annotation.RestController;
+import org.springframework.web.reactive.function.server.RouterFunction;
+import org.springframework.web.reactive.function.server.RouterFunctions;
+import org.springframework.web.reactive.function.server.ServerResponse;
+
+import static org.springframework.web.reactive.function.server.RequestPredicates.GET;
 
 @SpringBootApplication
 @RestController
@@ -16,4 +22,9 @@ public class TestBootApplication {
         return "Test";
     }
 
+    @Bean
+    public RouterFunction<ServerResponse> routes() {
+        return RouterFunctions.route(GET("/route"), request -> ServerResponse.ok().bodyValue("Route"));
+    }
+
 }
\ No newline at end of file
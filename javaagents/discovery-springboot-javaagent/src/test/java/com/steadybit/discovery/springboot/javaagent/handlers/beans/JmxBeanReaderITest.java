@@ -5,14 +5,23 @@
 package com.steadybit.discovery.springboot.javaagent.handlers.beans;
 
 import com.steadybit.discovery.springboot.javaagent.handlers.TestBootApplication;
+import com.steadybit.discovery.springboot.javaagent.handlers.context.ApplicationContextLocator;
 import com.steadybit.javaagent.CommandHandler;
 import org.junit.jupiter.api.AfterEach;
 import org.junit.jupiter.api.BeforeEach;
+import org.json.JSONArray;
+import org.json.JSONObject;
 import org.junit.jupiter.api.Test;
 import org.springframework.boot.SpringApplication;
 import org.springframework.boot.web.context.ConfigurableWebServerApplicationContext;
+import org.springframework.context.annotation.Bean;
+import org.springframework.scheduling.concurrent.ThreadPoolTaskExecutor;
+import org.springframework.scheduling.concurrent.ThreadPoolTaskScheduler;
+import org.springframework.web.client.RestTemplate;
 
 import java.io.ByteArrayOutputStream;
+import java.util.stream.Collectors;
+import java.util.stream.StreamSupport;
 
 import static org.assertj.core.api.Assertions.assertThat;
 
@@ -23,9 +32,9 @@ class JmxBeanReaderITest {
     @BeforeEach
     void setUp() {
         //TODO: Replace with testcontainer and spring-boot-sample?
-        this.context = (ConfigurableWebServerApplicationContext) SpringApplication.run(TestBootApplication.class, "--spring.jmx.enabled=true",
-                "--server.port=0");
-        this.handler = new BeanCommandHandler();
+        this.context = (ConfigurableWebServerApplicationContext) SpringApplication.run(new Class<?>[]{TestBootApplication.class, InventoryBeans.class},
+                new String[]{"--spring.jmx.enabled=true", "--server.port=0"});
+        this.handler = new BeanCommandHandler(new ApplicationContextLocator());
     }
 
     @AfterEach
@@ -57,6 +66,38 @@ class JmxBeanReaderITest {
         assertThat(response).isEqualTo("application\n");
     }
 
+    @Test
+    void should_return_bean_inventory() {
+        JSONArray beans = new JSONArray(this.command("spring-bean-inventory", "").substring(1));
+
+        assertThat(findBean(beans, "testBootApplication").getString("type")).isEqualTo(TestBootApplication.class.getName());
+        assertThat(findBean(beans, "testBootApplication").getString("scope")).isEqualTo("singleton");
+        assertThat(findBean(beans, "inventoryScheduler").getJSONArray("categories").toList()).containsExactly("scheduler");
+        assertThat(findBean(beans, "inventoryExecutor").getJSONArray("categories").toList()).containsExactly("executor");
+        assertThat(findBean(beans, "inventoryRestTemplate").getJSONArray("categories").toList()).containsExactly("http-client");
+        assertThat(findBean(beans, "testBootApplication").has("categories")).isFalse();
+    }
+
+    @Test
+    void should_return_bean_inventory_without_actuator() {
+        this.context.close();
+        this.context = (ConfigurableWebServerApplicationContext) SpringApplication.run(new Class<?>[]{TestBootApplication.class, InventoryBeans.class},
+                new String[]{"--spring.jmx.enabled=false", "--server.port=0"});
+
+        JSONArray beans = new JSONArray(this.command("spring-bean-inventory", "").substring(1));
+
+        assertThat(findBean(beans, "testBootApplication").getString("scope")).isEqualTo("singleton");
+        assertThat(findBean(beans, "inventoryRestTemplate").getJSONArray("categories").toList()).containsExactly("http-client");
+    }
+
+    private static JSONObject findBean(JSONArray beans, String name) {
+        return StreamSupport.stream(beans.spliterator(), false)
+                .map(JSONObject.class::cast)
+                .filter(bean -> name.equals(bean.getString("name")))
+                .collect(Collectors.toList())
+                .get(0);
+    }
+
     private String command(String command, String arg) {
         ByteArrayOutputStream os = new ByteArrayOutputStream();
         this.handler.handle(command, arg, os);
@@ -64,4 +105,21 @@ class JmxBeanReaderITest {
         assertThat(buf[0]).isEqualTo(CommandHandler.RC_OK);
         return new String(buf, 1, buf.length - 1);
     }
-}
\ No newline at end of file
+
+    static class InventoryBeans {
+        @Bean
+        RestTemplate inventoryRestTemplate() {
+            return new RestTemplate();
+        }
+
+        @Bean
+        ThreadPoolTaskExecutor inventoryExecutor() {
+            return new ThreadPoolTaskExecutor();
+        }
+
+        @Bean
+        ThreadPoolTaskScheduler inventoryScheduler() {
+            return new ThreadPoolTaskScheduler();
+        }
+    }
+}
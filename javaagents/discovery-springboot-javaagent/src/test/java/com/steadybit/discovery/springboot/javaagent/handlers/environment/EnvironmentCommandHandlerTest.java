@@ -0,0 +1,99 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.springboot.javaagent.handlers.environment;
+
+import com.steadybit.discovery.springboot.javaagent.handlers.context.ApplicationContextLocator;
+import com.steadybit.javaagent.CommandHandler;
+import org.junit.jupiter.api.Test;
+import org.springframework.context.ApplicationContext;
+import org.springframework.context.support.GenericApplicationContext;
+import org.springframework.core.env.MapPropertySource;
+import org.springframework.core.env.StandardEnvironment;
+
+import java.io.ByteArrayOutputStream;
+import java.util.Collections;
+import java.util.HashMap;
+import java.util.List;
+import java.util.Map;
+
+import static org.assertj.core.api.Assertions.assertThat;
+
+class EnvironmentCommandHandlerTest {
+    @Test
+    void should_return_environment() {
+        StandardEnvironment environment = new StandardEnvironment();
+        environment.setActiveProfiles("canary", "kubernetes");
+        environment.getPropertySources().addFirst(new MapPropertySource("server.ports", Collections.<String, Object>singletonMap("local.server.port", 34567)));
+        Map<String, Object> applicationConfig = new HashMap<>();
+        applicationConfig.put("server.port", "0");
+        applicationConfig.put("management.server.port", "9090");
+        environment.getPropertySources().addLast(new MapPropertySource("applicationConfig", applicationConfig));
+        GenericApplicationContext context = new GenericApplicationContext();
+        context.setEnvironment(environment);
+
+        Map<String, Object> info = new HashMap<>();
+        info.put("git", Collections.singletonMap("commit", Collections.singletonMap("id", "1a2b3c4")));
+        info.put("build", Collections.singletonMap("version", "1.4.2"));
+
+        String response = this.command(handler(Collections.singletonList(context), info));
+
+        assertThat(response).startsWith("\ufeff{")
+                .contains("\"activeProfiles\":[\"canary\",\"kubernetes\"]")
+                .contains("\"serverPort\":\"34567\"")
+                .contains("\"managementPort\":\"9090\"")
+                .contains("\"gitCommit\":\"1a2b3c4\"")
+                .contains("\"buildVersion\":\"1.4.2\"");
+    }
+
+    @Test
+    void should_return_empty_environment_without_application_context() {
+        String response = this.command(handler(Collections.emptyList(), null));
+
+        assertThat(response).contains("\"activeProfiles\":[]")
+                .doesNotContain("serverPort")
+                .doesNotContain("gitCommit");
+    }
+
+    @Test
+    void should_return_abbreviated_git_commit_in_full_mode() {
+        Map<String, Object> id = new HashMap<>();
+        id.put("abbrev", "1a2b3c4");
+        id.put("full", "1a2b3c4d5e6f");
+        Map<String, Object> info = Collections.singletonMap("git", Collections.singletonMap("commit", Collections.singletonMap("id", id)));
+
+        assertThat(EnvironmentCommandHandler.getGitCommit(info)).isEqualTo("1a2b3c4");
+    }
+
+    private static EnvironmentCommandHandler handler(List<ApplicationContext> contexts, Map<?, ?> info) {
+        ApplicationContextLocator locator = new ApplicationContextLocator() {
+            @Override
+            public List<ApplicationContext> getApplicationContexts() {
+                return contexts;
+            }
+        };
+        return new EnvironmentCommandHandler(new ContextEnvironmentReader(locator), new StaticInfoReader(info));
+    }
+
+    private String command(CommandHandler handler) {
+        ByteArrayOutputStream os = new ByteArrayOutputStream();
+        handler.handle("spring-environment", "", os);
+        byte[] buf = os.toByteArray();
+        assertThat(buf[0]).isEqualTo(CommandHandler.RC_OK);
+        return new String(buf, 1, buf.length - 1);
+    }
+
+    private static class StaticInfoReader extends JmxInfoReader {
+        private final Map<?, ?> info;
+
+        StaticInfoReader(Map<?, ?> info) {
+            this.info = info;
+        }
+
+        @Override
+        public Map<?, ?> getInfo() {
+            return this.info;
+        }
+    }
+}
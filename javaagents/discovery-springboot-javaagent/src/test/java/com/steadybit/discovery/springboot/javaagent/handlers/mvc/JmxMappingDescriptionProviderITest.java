@@ -5,6 +5,7 @@
 package com.steadybit.discovery.springboot.javaagent.handlers.mvc;
 
 import com.steadybit.discovery.springboot.javaagent.handlers.TestBootApplication;
+import com.steadybit.discovery.springboot.javaagent.handlers.context.ApplicationContextLocator;
 import com.steadybit.javaagent.CommandHandler;
 import org.junit.jupiter.api.AfterEach;
 import org.junit.jupiter.api.Test;
@@ -18,7 +19,7 @@ import static org.assertj.core.api.Assertions.assertThat;
 
 class JmxMappingDescriptionProviderITest {
     private ConfigurableWebServerApplicationContext context;
-    private CommandHandler handler = new HttpMappingsCommandHandler();
+    private CommandHandler handler = new HttpMappingsCommandHandler(new ApplicationContextLocator());
 
     @AfterEach
     void tearDown() {
@@ -37,6 +38,22 @@ class JmxMappingDescriptionProviderITest {
         String response = os.toString("UTF-8");
         assertThat(response).contains(
                 "{\"handlerClass\":\"com.steadybit.discovery.springboot.javaagent.handlers.TestBootApplication\",\"handlerDescriptor\":\"()Ljava/lang/String;\",\"methods\":[\"GET\"],\"patterns\":[\"/test\"],\"handlerName\":\"test\"}");
+        assertThat(response).contains("\"mode\":\"actuator\"");
+    }
+
+    @Test
+    void should_return_mappings_without_actuator() throws UnsupportedEncodingException {
+        this.context = (ConfigurableWebServerApplicationContext) SpringApplication.run(
+                TestBootApplication.class,
+                "--spring.jmx.enabled=false",
+                "--server.port=0");
+
+        ByteArrayOutputStream os = new ByteArrayOutputStream();
+        this.handler.handle("spring-mvc-mappings", null, os);
+        String response = os.toString("UTF-8");
+        assertThat(response).contains(
+                "{\"handlerClass\":\"com.steadybit.discovery.springboot.javaagent.handlers.TestBootApplication\",\"handlerDescriptor\":\"()Ljava/lang/String;\",\"methods\":[\"GET\"],\"patterns\":[\"/test\"],\"handlerName\":\"test\"}");
+        assertThat(response).contains("\"mode\":\"application-context\"");
     }
 
     @Test
@@ -53,4 +70,18 @@ class JmxMappingDescriptionProviderITest {
         assertThat(response).contains(
                 "{\"handlerClass\":\"com.steadybit.discovery.springboot.javaagent.handlers.TestBootApplication\",\"handlerDescriptor\":\"()Ljava/lang/String;\",\"methods\":[\"GET\"],\"patterns\":[\"/test\"],\"handlerName\":\"test\"}");
     }
-}
\ No newline at end of file
+
+    @Test
+    void should_return_router_functions_for_webflux() throws UnsupportedEncodingException {
+        this.context = (ConfigurableWebServerApplicationContext) SpringApplication.run(
+                TestBootApplication.class,
+                "--spring.jmx.enabled=true",
+                "--spring.main.web-application-type=reactive",
+                "--server.port=0");
+
+        ByteArrayOutputStream os = new ByteArrayOutputStream();
+        this.handler.handle("spring-mvc-mappings", null, os);
+        String response = os.toString("UTF-8");
+        assertThat(response).contains("\"routerFunction\":true", "\"methods\":[\"GET\"]", "\"patterns\":[\"/route\"]");
+    }
+}
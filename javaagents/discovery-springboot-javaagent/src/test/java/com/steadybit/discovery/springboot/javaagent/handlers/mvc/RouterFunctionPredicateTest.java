@@ -0,0 +1,52 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.springboot.javaagent.handlers.mvc;
+
+import org.junit.jupiter.api.Test;
+
+import static org.assertj.core.api.Assertions.assertThat;
+
+class RouterFunctionPredicateTest {
+
+    @Test
+    void should_parse_method_and_pattern() {
+        RouterFunctionPredicate predicate = RouterFunctionPredicate.parse("(GET && /users/{id})");
+
+        assertThat(predicate.getMethods()).containsExactly("GET");
+        assertThat(predicate.getPatterns()).containsExactly("/users/{id}");
+    }
+
+    @Test
+    void should_parse_multiple_methods_and_ignore_other_conditions() {
+        RouterFunctionPredicate predicate = RouterFunctionPredicate.parse("(([GET, HEAD] && /users) && Accept: [application/json])");
+
+        assertThat(predicate.getMethods()).containsExactly("GET", "HEAD");
+        assertThat(predicate.getPatterns()).containsExactly("/users");
+    }
+
+    @Test
+    void should_parse_alternatives() {
+        RouterFunctionPredicate predicate = RouterFunctionPredicate.parse("((POST && /orders) || (PUT && /orders))");
+
+        assertThat(predicate.getMethods()).containsExactly("POST", "PUT");
+        assertThat(predicate.getPatterns()).containsExactly("/orders");
+    }
+
+    @Test
+    void should_ignore_negated_conditions() {
+        RouterFunctionPredicate predicate = RouterFunctionPredicate.parse("(/health && !DELETE)");
+
+        assertThat(predicate.getMethods()).isEmpty();
+        assertThat(predicate.getPatterns()).containsExactly("/health");
+    }
+
+    @Test
+    void should_handle_missing_predicate() {
+        RouterFunctionPredicate predicate = RouterFunctionPredicate.parse(null);
+
+        assertThat(predicate.getMethods()).isEmpty();
+        assertThat(predicate.getPatterns()).isEmpty();
+    }
+}
@@ -0,0 +1,48 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.springboot.javaagent.handlers.resilience;
+
+import com.steadybit.javaagent.CommandHandler;
+import org.junit.jupiter.api.Test;
+
+import java.io.ByteArrayOutputStream;
+import java.util.Collections;
+
+import static org.assertj.core.api.Assertions.assertThat;
+
+class ResilienceCommandHandlerTest {
+    @Test
+    void should_return_components() {
+        CommandHandler handler = new ResilienceCommandHandler(() -> Collections.singletonList(
+                new ResilienceComponent("time-limiter", "inventory", "resilience4j", "com.example.Service#fetch", null, null, 2000L)));
+
+        String response = this.command(handler, "spring-resilience");
+
+        assertThat(response).startsWith("\ufeff[{")
+                .contains("\"type\":\"time-limiter\"")
+                .contains("\"name\":\"inventory\"")
+                .contains("\"library\":\"resilience4j\"")
+                .contains("\"method\":\"com.example.Service#fetch\"")
+                .contains("\"timeout\":2000")
+                .doesNotContain("fallback")
+                .doesNotContain("maxAttempts");
+    }
+
+    @Test
+    void should_return_empty_components() {
+        CommandHandler handler = new ResilienceCommandHandler(Collections::emptyList);
+
+        String response = this.command(handler, "spring-resilience");
+        assertThat(response).isEqualTo("\ufeff[]");
+    }
+
+    private String command(CommandHandler handler, String command) {
+        ByteArrayOutputStream os = new ByteArrayOutputStream();
+        handler.handle(command, "", os);
+        byte[] buf = os.toByteArray();
+        assertThat(buf[0]).isEqualTo(CommandHandler.RC_OK);
+        return new String(buf, 1, buf.length - 1);
+    }
+}
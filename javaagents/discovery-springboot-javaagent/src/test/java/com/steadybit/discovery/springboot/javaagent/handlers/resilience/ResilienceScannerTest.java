@@ -0,0 +1,134 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.discovery.springboot.javaagent.handlers.resilience;
+
+import org.junit.jupiter.api.Test;
+
+import java.lang.annotation.Retention;
+import java.lang.annotation.RetentionPolicy;
+
+import static org.assertj.core.api.Assertions.assertThat;
+
+class ResilienceScannerTest {
+    private final ResilienceScanner scanner = new ResilienceScanner(null);
+
+    @Test
+    void should_record_resilience4j_retry() throws Exception {
+        Retry annotation = Service.class.getMethod("fetch").getAnnotation(Retry.class);
+
+        this.scanner.exec(1, new RetryAspect(), new TestJoinPoint(), annotation);
+        this.scanner.exec(1, new RetryAspect(), new TestJoinPoint(), annotation);
+
+        assertThat(this.scanner.getComponents()).singleElement().satisfies(component -> {
+            assertThat(component.getType()).isEqualTo("retry");
+            assertThat(component.getName()).isEqualTo("inventory");
+            assertThat(component.getLibrary()).isEqualTo("resilience4j");
+            assertThat(component.getMethod()).isEqualTo("com.example.Service#fetch");
+            assertThat(component.getFallback()).isEqualTo("fetchFallback");
+            assertThat(component.getMaxAttempts()).isEqualTo(3);
+        });
+    }
+
+    @Test
+    void should_record_resilience4j_circuit_breaker_without_fallback() throws Exception {
+        CircuitBreaker annotation = Service.class.getMethod("fetch").getAnnotation(CircuitBreaker.class);
+
+        this.scanner.exec(1, new Object(), new TestJoinPoint(), annotation);
+
+        assertThat(this.scanner.getComponents()).singleElement().satisfies(component -> {
+            assertThat(component.getType()).isEqualTo("circuit-breaker");
+            assertThat(component.getName()).isEqualTo("inventory");
+            assertThat(component.getFallback()).isNull();
+        });
+    }
+
+    @Test
+    void should_record_spring_retry() {
+        this.scanner.exec(2, new RetryTemplate(), new TestRetryCallback(), new Object());
+
+        assertThat(this.scanner.getComponents()).singleElement().satisfies(component -> {
+            assertThat(component.getType()).isEqualTo("retry");
+            assertThat(component.getName()).isEqualTo("public void com.example.Service.fetch()");
+            assertThat(component.getLibrary()).isEqualTo("spring-retry");
+            assertThat(component.getFallback()).isEqualTo("recovery callback");
+            assertThat(component.getMaxAttempts()).isEqualTo(5);
+        });
+    }
+
+    @Retention(RetentionPolicy.RUNTIME)
+    public @interface Retry {
+        String name();
+
+        String fallbackMethod() default "";
+    }
+
+    @Retention(RetentionPolicy.RUNTIME)
+    public @interface CircuitBreaker {
+        String name();
+
+        String fallbackMethod() default "";
+    }
+
+    public static class Service {
+        @Retry(name = "inventory", fallbackMethod = "fetchFallback")
+        @CircuitBreaker(name = "inventory")
+        public void fetch() {
+        }
+    }
+
+    public static class RetryAspect {
+        private final RetryRegistry retryRegistry = new RetryRegistry();
+    }
+
+    public static class RetryRegistry {
+        public RetryInstance retry(String name) {
+            return new RetryInstance();
+        }
+    }
+
+    public static class RetryInstance {
+        public RetryConfig getRetryConfig() {
+            return new RetryConfig();
+        }
+    }
+
+    public static class RetryConfig {
+        public int getMaxAttempts() {
+            return 3;
+        }
+    }
+
+    public static class TestJoinPoint {
+        public TestSignature getSignature() {
+            return new TestSignature();
+        }
+    }
+
+    public static class TestSignature {
+        public String getDeclaringTypeName() {
+            return "com.example.Service";
+        }
+
+        public String getName() {
+            return "fetch";
+        }
+    }
+
+    public static class RetryTemplate {
+        private final RetryPolicy retryPolicy = new RetryPolicy();
+    }
+
+    public static class RetryPolicy {
+        public int getMaxAttempts() {
+            return 5;
+        }
+    }
+
+    public static class TestRetryCallback {
+        public String getLabel() {
+            return "public void com.example.Service.fetch()";
+        }
+    }
+}
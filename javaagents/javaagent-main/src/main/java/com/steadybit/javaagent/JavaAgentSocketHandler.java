@@ -6,6 +6,7 @@ package com.steadybit.javaagent;
 
 import com.steadybit.javaagent.handler.ClassLoadedCommandHandler;
 import com.steadybit.javaagent.handler.LoadAgentPluginCommandHandler;
+import com.steadybit.javaagent.handler.MethodReturnTypesCommandHandler;
 import com.steadybit.javaagent.handler.SetLoglevelCommandHandler;
 import com.steadybit.javaagent.log.Logger;
 import com.steadybit.javaagent.log.RemoteAgentLogger;
@@ -36,6 +37,7 @@ public class JavaAgentSocketHandler {
         this.commandHandlers = Arrays.asList(
                 new ClassLoadedCommandHandler(this.loadedClassesCache),
                 new LoadAgentPluginCommandHandler(instrumentation, this.loadedClassesCache),
+                new MethodReturnTypesCommandHandler(this.loadedClassesCache),
                 new SetLoglevelCommandHandler()
         );
     }
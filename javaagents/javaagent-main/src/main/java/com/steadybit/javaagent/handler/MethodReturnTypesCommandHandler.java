@@ -0,0 +1,55 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.javaagent.handler;
+
+import com.steadybit.javaagent.CommandHandler;
+import com.steadybit.javaagent.LoadedClassesCache;
+
+import java.io.OutputStream;
+import java.io.OutputStreamWriter;
+import java.io.PrintWriter;
+import java.lang.reflect.Method;
+import java.nio.charset.StandardCharsets;
+import java.util.LinkedHashSet;
+import java.util.Set;
+
+/**
+ * Returns the comma separated return types of the methods declared with the given name by a loaded class. The argument
+ * has the format {@code className#methodName}; the result is empty if the class is not loaded.
+ */
+public class MethodReturnTypesCommandHandler implements CommandHandler {
+    private final LoadedClassesCache loadedClassesCache;
+
+    public MethodReturnTypesCommandHandler(LoadedClassesCache loadedClassesCache) {
+        this.loadedClassesCache = loadedClassesCache;
+    }
+
+    @Override
+    public boolean canHandle(String command) {
+        return "method-return-types".equals(command);
+    }
+
+    @Override
+    public void handle(String command, String argument, OutputStream os) {
+        PrintWriter writer = new PrintWriter(new OutputStreamWriter(os, StandardCharsets.UTF_8), true);
+        writer.write(RC_OK);
+        writer.println(String.join(",", this.getReturnTypes(argument)));
+    }
+
+    private Set<String> getReturnTypes(String argument) {
+        Set<String> returnTypes = new LinkedHashSet<>();
+        String[] tokens = argument.split("#", 2);
+        Class<?> clazz = this.loadedClassesCache.findClass(tokens[0]);
+        if (clazz == null || tokens.length < 2) {
+            return returnTypes;
+        }
+        for (Method method : clazz.getDeclaredMethods()) {
+            if (method.getName().equals(tokens[1]) && !method.isSynthetic()) {
+                returnTypes.add(method.getReturnType().getName());
+            }
+        }
+        return returnTypes;
+    }
+}
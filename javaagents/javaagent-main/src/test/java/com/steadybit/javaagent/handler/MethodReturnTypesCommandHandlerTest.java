@@ -0,0 +1,81 @@
+/*
+ * Copyright 2026 steadybit GmbH. All rights reserved.
+ */
+
+package com.steadybit.javaagent.handler;
+
+import com.steadybit.javaagent.CommandHandler;
+import com.steadybit.javaagent.LoadedClassesCache;
+import org.junit.jupiter.api.Test;
+
+import java.io.BufferedReader;
+import java.io.ByteArrayInputStream;
+import java.io.ByteArrayOutputStream;
+import java.io.IOException;
+import java.io.InputStreamReader;
+import java.util.List;
+
+import static org.assertj.core.api.Assertions.assertThat;
+import static org.mockito.Mockito.mock;
+import static org.mockito.Mockito.when;
+
+class MethodReturnTypesCommandHandlerTest {
+    private final LoadedClassesCache classesCache = mock(LoadedClassesCache.class);
+    private final CommandHandler handler = new MethodReturnTypesCommandHandler(this.classesCache);
+
+    @Test
+    void should_return_return_types_of_overloaded_methods() throws IOException {
+        //given
+        when(this.classesCache.findClass(TestService.class.getName())).thenAnswer(invocation -> TestService.class);
+
+        //when
+        String result = this.command("method-return-types", TestService.class.getName() + "#find");
+
+        //then
+        assertThat(result).contains("java.util.List").contains("int").contains("[Ljava.lang.String;");
+    }
+
+    @Test
+    void should_return_empty_result_for_unknown_method() throws IOException {
+        //given
+        when(this.classesCache.findClass(TestService.class.getName())).thenAnswer(invocation -> TestService.class);
+
+        //when
+        String result = this.command("method-return-types", TestService.class.getName() + "#unknown");
+
+        //then
+        assertThat(result).isEmpty();
+    }
+
+    @Test
+    void should_return_empty_result_for_class_not_loaded() throws IOException {
+        //when
+        String result = this.command("method-return-types", "com.example.NotLoaded#find");
+
+        //then
+        assertThat(result).isEmpty();
+    }
+
+    private String command(String command, String argument) throws IOException {
+        ByteArrayOutputStream os = new ByteArrayOutputStream();
+        this.handler.handle(command, argument, os);
+        byte[] buf = os.toByteArray();
+        assertThat(buf[0]).isEqualTo(CommandHandler.RC_OK);
+        return new BufferedReader(new InputStreamReader(new ByteArrayInputStream(buf, 1, buf.length - 1))).readLine();
+    }
+
+    @SuppressWarnings("unused")
+    static class TestService {
+        List<String> find() {
+            return null;
+        }
+
+        int find(String name) {
+            return 0;
+        }
+
+        String[] find(int index) {
+            return null;
+        }
+    }
+}
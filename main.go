@@ -49,7 +49,7 @@ func main() {
 	// This call registers a handler for the extension's root path. This is the path initially accessed
 	// by the Steadybit agent to obtain the extension's capabilities.
 	// The registration of HTTP handlers for the extension.
-	stop, facade, datasource, spring := extjvm.StartJvmInfrastructure()
+	stop, facade, datasource, agentCommands, spring, frameworks := extjvm.StartJvmInfrastructure()
 
 	//This will install a signal handler, that will stop active actions when receiving a SIGURS1, SIGTERM or SIGINT
 	extsignals.AddSignalHandler(extsignals.SignalHandler{
@@ -61,17 +61,47 @@ func main() {
 	})
 	extsignals.ActivateSignalHandlers()
 
-	discovery_kit_sdk.Register(extjvm.NewJvmDiscovery(facade, datasource, spring))
-	action_kit_sdk.RegisterAction(extjvm.NewControllerDelay(facade, spring))
-	action_kit_sdk.RegisterAction(extjvm.NewControllerException(facade, spring))
+	discovery_kit_sdk.Register(extjvm.NewJvmDiscovery(facade, datasource, agentCommands, spring, frameworks))
+	discovery_kit_sdk.Register(extjvm.NewJvmDataSourceDiscovery(facade, datasource, spring))
+	discovery_kit_sdk.Register(extjvm.NewSpringEndpointDiscovery(facade, spring))
+	action_kit_sdk.RegisterAction(extjvm.NewControllerDelay(facade, spring, frameworks))
+	action_kit_sdk.RegisterAction(extjvm.NewControllerException(facade, spring, frameworks))
 	action_kit_sdk.RegisterAction(extjvm.NewJdbcTemplateException(facade))
 	action_kit_sdk.RegisterAction(extjvm.NewJdbcTemplateDelay(facade))
 	action_kit_sdk.RegisterAction(extjvm.NewHttpClientStatus(facade))
 	action_kit_sdk.RegisterAction(extjvm.NewHttpClientDelay(facade))
 	action_kit_sdk.RegisterAction(extjvm.NewJavaMethodDelay(facade))
 	action_kit_sdk.RegisterAction(extjvm.NewJavaMethodException(facade))
+	action_kit_sdk.RegisterAction(extjvm.NewJavaMethodReturnValue(facade))
+	action_kit_sdk.RegisterAction(extjvm.NewJavaHeapFill(facade))
+	action_kit_sdk.RegisterAction(extjvm.NewJavaCpuBurn(facade))
+	action_kit_sdk.RegisterAction(extjvm.NewJavaThreadPoolExhaustion(facade))
+	action_kit_sdk.RegisterAction(extjvm.NewJavaMethodLock(facade))
+	action_kit_sdk.RegisterAction(extjvm.NewJdbcConnectionPoolExhaustion(facade))
+	action_kit_sdk.RegisterAction(extjvm.NewJdbcStatementDelay(facade))
+	action_kit_sdk.RegisterAction(extjvm.NewJdbcStatementException(facade))
+	action_kit_sdk.RegisterAction(extjvm.NewJavaHttpClientStatus(facade))
+	action_kit_sdk.RegisterAction(extjvm.NewJavaHttpClientDelay(facade))
+	action_kit_sdk.RegisterAction(extjvm.NewKafkaProducerDelay(facade))
+	action_kit_sdk.RegisterAction(extjvm.NewKafkaProducerException(facade))
+	action_kit_sdk.RegisterAction(extjvm.NewKafkaConsumerPause(facade))
+	action_kit_sdk.RegisterAction(extjvm.NewKafkaConsumerCommitDelay(facade))
+	action_kit_sdk.RegisterAction(extjvm.NewRedisCommandDelay(facade))
+	action_kit_sdk.RegisterAction(extjvm.NewRedisCommandException(facade))
+	action_kit_sdk.RegisterAction(extjvm.NewGrpcClientDelay(facade))
+	action_kit_sdk.RegisterAction(extjvm.NewGrpcClientStatus(facade))
+	action_kit_sdk.RegisterAction(extjvm.NewGrpcServerDelay(facade))
+	action_kit_sdk.RegisterAction(extjvm.NewGrpcServerStatus(facade))
+	action_kit_sdk.RegisterAction(extjvm.NewSpringEndpointDelay(facade, spring))
+	action_kit_sdk.RegisterAction(extjvm.NewSpringEndpointException(facade, spring))
+	action_kit_sdk.RegisterAction(extjvm.NewDataSourceJdbcTemplateException(facade))
+	action_kit_sdk.RegisterAction(extjvm.NewDataSourceJdbcTemplateDelay(facade))
+	action_kit_sdk.RegisterAction(extjvm.NewDataSourceConnectionPoolExhaustion(facade))
+	action_kit_sdk.RegisterAction(extjvm.NewDataSourceStatementDelay(facade))
+	action_kit_sdk.RegisterAction(extjvm.NewDataSourceStatementException(facade))
 
 	exthttp.RegisterRevisionedHandler("/", getExtensionList)
+	extjvm.RegisterDebugHandlers(spring, datasource)
 
 	//This will switch the readiness state of the application to true.
 	exthealth.SetReady(true)
@@ -22,7 +22,7 @@ func main() {
 	config.ParseConfiguration()
 	config.Config.JavaAgentLogLevel = "TRACE"
 
-	stop, facade, _, _ := extjvm.StartJvmInfrastructure()
+	stop, facade, _, _, _, _ := extjvm.StartJvmInfrastructure()
 	defer stop()
 
 	reader := bufio.NewReader(os.Stdin)